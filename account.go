@@ -0,0 +1,210 @@
+package parasut
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AccountTransactionFilter hesap hareketlerini tarih aralığı ve türe göre süzer
+type AccountTransactionFilter struct {
+	// From ve To aralığın ilk ve son günüdür (dahil); sıfır değer sınır koymaz
+	From time.Time
+	To   time.Time
+	// Types istenen transaction_type değerleri; boşsa tüm türler
+	Types []string
+}
+
+// ListParams filtreyi API'ye gönderilecek liste parametrelerine ekler:
+// tarih aralığı filter[date]=2024-05-01..2024-05-31 (açık uçlar boş bırakılır),
+// türler filter[transaction_type]=collection,payment biçiminde yazılır.
+// params nil ise yeni parametreler oluşturulur; verilen params değiştirilmez.
+func (f AccountTransactionFilter) ListParams(params *ListParams) *ListParams {
+	result := ListParams{}
+	if params != nil {
+		result = *params
+	}
+	filter := map[string]string{}
+	for key, value := range result.Filter {
+		filter[key] = value
+	}
+	if !f.From.IsZero() || !f.To.IsZero() {
+		var from, to string
+		if !f.From.IsZero() {
+			from = TruncateDate(f.From).Format(dateLayout)
+		}
+		if !f.To.IsZero() {
+			to = TruncateDate(f.To).Format(dateLayout)
+		}
+		filter["date"] = from + ".." + to
+	}
+	if len(f.Types) > 0 {
+		filter["transaction_type"] = strings.Join(f.Types, ",")
+	}
+	if len(filter) > 0 {
+		result.Filter = filter
+	}
+	return &result
+}
+
+// validate tarih aralığını denetler
+func (f AccountTransactionFilter) validate() error {
+	if !f.From.IsZero() && !f.To.IsZero() && f.To.Before(f.From) {
+		return fmt.Errorf("bitiş tarihi başlangıç tarihinden önce olamaz")
+	}
+	return nil
+}
+
+// matches hareketin filtreye uyup uymadığını döndürür
+func (f AccountTransactionFilter) matches(transaction AccountTransaction) bool {
+	if len(f.Types) > 0 {
+		found := false
+		for _, t := range f.Types {
+			if t == transaction.Attributes.TransactionType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return withinDates(transaction.Attributes.Date, f.From, f.To)
+}
+
+// withinDates tarihin from ile to arasında (dahil) olup olmadığını döndürür.
+// Sıfır değerli sınırlar uygulanmaz; sınır varken tarih okunamazsa false döner.
+func withinDates(value string, from, to time.Time) bool {
+	if from.IsZero() && to.IsZero() {
+		return true
+	}
+
+	date, err := ParseDate(value)
+	if err != nil {
+		return false
+	}
+	if !from.IsZero() && date.Before(TruncateDate(from)) {
+		return false
+	}
+	return to.IsZero() || !date.After(TruncateDate(to))
+}
+
+// ListTransactionsPage hesabın filtreye uyan hareketlerinden params ile
+// istenen sayfayı döndürür. Tarih aralığı ve türler API'ye filtre olarak
+// gönderilir; sunucunun desteklemediği durumlara karşı sayfa istemci
+// tarafında da süzülür. Bu yüzden boş dönen sayfa son sayfa değildir;
+// sayfalar Meta.TotalPages'e kadar okunmalıdır.
+func (s *AccountsService) ListTransactionsPage(ctx context.Context, accountID string, filter AccountTransactionFilter, params *ListParams) ([]AccountTransaction, *Meta, error) {
+	if err := filter.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	page, meta, err := s.GetTransactions(ctx, accountID, filter.ListParams(params))
+	if err != nil {
+		return nil, nil, err
+	}
+	transactions := make([]AccountTransaction, 0, len(page))
+	for _, transaction := range page {
+		if filter.matches(transaction) {
+			transactions = append(transactions, transaction)
+		}
+	}
+	return transactions, meta, nil
+}
+
+// ListTransactions hesabın filtreye uyan tüm hareketlerini tarih sırasıyla
+// döndürür. Filtre API'ye gönderildiğinden yalnızca aralıktaki sayfalar
+// okunur; sunucu filtreyi yok saysa da tüm sayfalar okunup sonuç istemci
+// tarafında süzülür. Büyük hesaplarda sayfa sayfa okumak için
+// ListTransactionsPage kullanılabilir.
+func (s *AccountsService) ListTransactions(ctx context.Context, accountID string, filter AccountTransactionFilter) ([]AccountTransaction, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	// Süzme sayfalar birleştirildikten sonra yapılır; süzülen sayfa boş kalsa
+	// bile sonraki sayfalar okunur
+	all, err := ListAll(ctx, func(ctx context.Context, params *ListParams) ([]AccountTransaction, *Meta, error) {
+		return s.GetTransactions(ctx, accountID, params)
+	}, filter.ListParams(&ListParams{Sort: "date"}))
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]AccountTransaction, 0, len(all))
+	for _, transaction := range all {
+		if filter.matches(transaction) {
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].Attributes.Date < transactions[j].Attributes.Date
+	})
+	return transactions, nil
+}
+
+// AccountTransfer iki hesap arasındaki virmanın hareketleri
+type AccountTransfer struct {
+	// Debit kaynak hesaptan para çıkışı
+	Debit *AccountTransaction `json:"debit"`
+	// Credit hedef hesaba para girişi; tutarı amount * exchangeRate'tir
+	Credit *AccountTransaction `json:"credit"`
+}
+
+// Transfer fromID hesabından toID hesabına virman yapar: kaynak hesaba amount
+// tutarında para çıkışı, hedef hesaba amount * exchangeRate tutarında para
+// girişi hareketi oluşturur. Aynı dövizdeki hesaplar arasında exchangeRate 1
+// olmalıdır. Giriş hareketi oluşturulamazsa çıkış hareketi silinir; böylece
+// hesaplarda tek taraflı hareket kalmaz.
+func (s *AccountsService) Transfer(ctx context.Context, fromID, toID string, amount float64, date string, exchangeRate float64) (*AccountTransfer, error) {
+	switch {
+	case fromID == "" || toID == "":
+		return nil, fmt.Errorf("virman için kaynak ve hedef hesap zorunludur")
+	case fromID == toID:
+		return nil, fmt.Errorf("virmanda kaynak ve hedef hesap aynı olamaz")
+	case amount <= 0:
+		return nil, fmt.Errorf("virman tutarı sıfırdan büyük olmalıdır")
+	case exchangeRate <= 0:
+		return nil, fmt.Errorf("virman kuru sıfırdan büyük olmalıdır; aynı dövizde 1 verin")
+	}
+	if _, err := ParseDate(date); err != nil {
+		return nil, fmt.Errorf("geçersiz virman tarihi: %q", date)
+	}
+
+	from, err := s.Get(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.Get(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+	if CurrencyOrDefault(from.Attributes.Currency) == CurrencyOrDefault(to.Attributes.Currency) && exchangeRate != 1 {
+		return nil, fmt.Errorf("aynı dövizdeki (%s) hesaplar arasında kur 1 olmalıdır", CurrencyOrDefault(from.Attributes.Currency))
+	}
+
+	description := fmt.Sprintf("Virman: %s → %s", from.Attributes.Name, to.Attributes.Name)
+	debit, err := s.CreateDebitTransaction(ctx, fromID, AccountTransactionInput{
+		Date:        date,
+		Amount:      RoundAmount(amount),
+		Description: description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credit, err := s.CreateCreditTransaction(ctx, toID, AccountTransactionInput{
+		Date:        date,
+		Amount:      RoundAmount(amount * exchangeRate),
+		Description: description,
+	})
+	if err != nil {
+		if rollbackErr := s.client.Transactions.Delete(ctx, debit.ID); rollbackErr != nil {
+			return &AccountTransfer{Debit: debit}, fmt.Errorf("virman giriş hareketi oluşturulamadı: %w; %s numaralı çıkış hareketi geri alınamadı: %v", err, debit.ID, rollbackErr)
+		}
+		return nil, fmt.Errorf("virman giriş hareketi oluşturulamadı, çıkış hareketi geri alındı: %w", err)
+	}
+	return &AccountTransfer{Debit: debit, Credit: credit}, nil
+}
@@ -0,0 +1,223 @@
+package parasut
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccountsService_ListTransactions(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/v4/123/accounts/5/transactions" {
+			t.Errorf("Path = %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("page[number]") {
+		case "1":
+			w.Write([]byte(`{"data": [
+				{"id": "1", "type": "transactions", "attributes": {"date": "2024-05-03", "amount": 10, "transaction_type": "account_debit"}},
+				{"id": "2", "type": "transactions", "attributes": {"date": "2024-04-30", "amount": 20, "transaction_type": "collection"}}
+			], "meta": {"current_page": 1, "total_pages": 2, "total_count": 4}}`))
+		case "2":
+			w.Write([]byte(`{"data": [
+				{"id": "3", "type": "transactions", "attributes": {"date": "2024-05-01", "amount": 30, "transaction_type": "collection"}},
+				{"id": "4", "type": "transactions", "attributes": {"date": "2024-06-01", "amount": 40, "transaction_type": "collection"}}
+			], "meta": {"current_page": 2, "total_pages": 2, "total_count": 4}}`))
+		default:
+			t.Errorf("Beklenmeyen sayfa: %s", r.URL.RawQuery)
+		}
+	})
+
+	transactions, err := client.Accounts.ListTransactions(context.Background(), "5", AccountTransactionFilter{
+		From: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, 5, 31, 23, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("ListTransactions hata döndü: %v", err)
+	}
+	if len(transactions) != 2 || transactions[0].ID != "3" || transactions[1].ID != "1" {
+		t.Errorf("Hareketler = %+v", transactions)
+	}
+
+	transactions, err = client.Accounts.ListTransactions(context.Background(), "5", AccountTransactionFilter{Types: []string{"collection"}})
+	if err != nil || len(transactions) != 3 || transactions[0].ID != "2" {
+		t.Errorf("Türe göre hareketler = %+v, %v", transactions, err)
+	}
+
+	_, err = client.Accounts.ListTransactions(context.Background(), "5", AccountTransactionFilter{
+		From: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err == nil {
+		t.Error("Ters tarih aralığı için hata bekleniyordu")
+	}
+}
+
+func TestAccountsService_ListTransactions_FilterIgnored(t *testing.T) {
+	// Sunucu filtreyi yok sayar: ilk sayfada yalnızca tahsilat vardır
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page[number]") {
+		case "1":
+			w.Write([]byte(`{"data": [
+				{"id": "1", "type": "transactions", "attributes": {"date": "2024-05-01", "amount": 10, "transaction_type": "collection"}}
+			], "meta": {"current_page": 1, "total_pages": 2, "total_count": 2}}`))
+		case "2":
+			w.Write([]byte(`{"data": [
+				{"id": "2", "type": "transactions", "attributes": {"date": "2024-05-02", "amount": 20, "transaction_type": "payment"}}
+			], "meta": {"current_page": 2, "total_pages": 2, "total_count": 2}}`))
+		default:
+			t.Errorf("Beklenmeyen sayfa: %s", r.URL.RawQuery)
+		}
+	})
+
+	transactions, err := client.Accounts.ListTransactions(context.Background(), "5", AccountTransactionFilter{Types: []string{"payment"}})
+	if err != nil {
+		t.Fatalf("ListTransactions hata döndü: %v", err)
+	}
+	if len(transactions) != 1 || transactions[0].ID != "2" {
+		t.Errorf("Hareketler = %+v", transactions)
+	}
+}
+
+func TestAccountsService_ListTransactionsPage(t *testing.T) {
+	var query url.Values
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [
+			{"id": "1", "type": "transactions", "attributes": {"date": "2024-05-03", "amount": 10, "transaction_type": "collection"}},
+			{"id": "2", "type": "transactions", "attributes": {"date": "2024-06-03", "amount": 20, "transaction_type": "collection"}}
+		], "meta": {"current_page": 2, "total_pages": 7, "total_count": 130}}`))
+	})
+
+	params := &ListParams{Page: 2, PageSize: 20, Filter: map[string]string{"description": "EFT"}}
+	transactions, meta, err := client.Accounts.ListTransactionsPage(context.Background(), "5", AccountTransactionFilter{
+		From:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		To:    time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+		Types: []string{"collection", "payment"},
+	}, params)
+	if err != nil {
+		t.Fatalf("ListTransactionsPage hata döndü: %v", err)
+	}
+	if query.Get("filter[date]") != "2024-05-01..2024-05-31" || query.Get("filter[transaction_type]") != "collection,payment" ||
+		query.Get("filter[description]") != "EFT" || query.Get("page[number]") != "2" || query.Get("page[size]") != "20" {
+		t.Errorf("Sorgu = %v", query)
+	}
+	if len(params.Filter) != 1 {
+		t.Errorf("Verilen parametreler değiştirildi: %v", params.Filter)
+	}
+	// Sunucunun süzmediği hareket istemcide ayıklanır
+	if len(transactions) != 1 || transactions[0].ID != "1" || meta.TotalPages != 7 {
+		t.Errorf("Sayfa = %+v, %+v", transactions, meta)
+	}
+
+	if got := (AccountTransactionFilter{To: time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)}).ListParams(nil); got.Filter["date"] != "..2024-05-31" {
+		t.Errorf("Açık uçlu aralık = %v", got.Filter)
+	}
+}
+
+func TestAccountsService_Transfer(t *testing.T) {
+	var created []string
+	var amounts []float64
+	deleted := ""
+	failCredit := false
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/accounts/1":
+			w.Write([]byte(`{"data": {"id": "1", "type": "accounts", "attributes": {"name": "Kasa", "currency": "TRL"}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/accounts/2":
+			w.Write([]byte(`{"data": {"id": "2", "type": "accounts", "attributes": {"name": "Dolar", "currency": "USD"}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/accounts/3":
+			w.Write([]byte(`{"data": {"id": "3", "type": "accounts", "attributes": {"name": "Banka"}}}`))
+		case r.Method == http.MethodPost:
+			if failCredit && strings.HasSuffix(r.URL.Path, "/credit_transactions") {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"errors": [{"title": "Hesap arşivlenmiş"}]}`))
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			var payload struct {
+				Data struct {
+					Attributes AccountTransactionInput `json:"attributes"`
+				} `json:"data"`
+			}
+			json.Unmarshal(body, &payload)
+			created = append(created, r.URL.Path)
+			amounts = append(amounts, payload.Data.Attributes.Amount)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data": {"id": "9%d", "type": "transactions", "attributes": {}}}`, len(created))
+		case r.Method == http.MethodDelete:
+			deleted = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Beklenmeyen istek: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	transfer, err := client.Accounts.Transfer(ctx, "2", "1", 100, "2024-05-01", 32.255)
+	if err != nil {
+		t.Fatalf("Transfer hata döndü: %v", err)
+	}
+	if transfer.Debit.ID != "91" || transfer.Credit.ID != "92" {
+		t.Errorf("Virman = %+v", transfer)
+	}
+	if created[0] != "/v4/123/accounts/2/debit_transactions" || created[1] != "/v4/123/accounts/1/credit_transactions" {
+		t.Errorf("Oluşturulan hareketler = %v", created)
+	}
+	if amounts[0] != 100 || amounts[1] != 3225.5 {
+		t.Errorf("Tutarlar = %v", amounts)
+	}
+
+	if _, err := client.Accounts.Transfer(ctx, "1", "3", 100, "2024-05-01", 2); err == nil || !strings.Contains(err.Error(), "kur 1") {
+		t.Errorf("Aynı dövizde kur hatası = %v", err)
+	}
+
+	failCredit = true
+	created = nil
+	transfer, err = client.Accounts.Transfer(ctx, "1", "3", 100, "2024-05-01", 1)
+	if err == nil || transfer != nil || !strings.Contains(err.Error(), "geri alındı") {
+		t.Errorf("Başarısız giriş = %+v, %v", transfer, err)
+	}
+	if deleted != "/v4/123/transactions/91" {
+		t.Errorf("Geri alınan hareket = %q", deleted)
+	}
+}
+
+func TestAccountsService_Transfer_Validation(t *testing.T) {
+	requests := 0
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+	tests := []struct {
+		name          string
+		from, to      string
+		amount, rate  float64
+		date, wantErr string
+	}{
+		{"Aynı hesap", "1", "1", 10, 1, "2024-05-01", "aynı olamaz"},
+		{"Tutar sıfır", "1", "2", 0, 1, "2024-05-01", "tutarı"},
+		{"Kur sıfır", "1", "2", 10, 0, "2024-05-01", "kuru"},
+		{"Tarih", "1", "2", 10, 1, "01.05.2024", "tarihi"},
+		{"Hesap yok", "", "2", 10, 1, "2024-05-01", "zorunludur"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Accounts.Transfer(context.Background(), tt.from, tt.to, tt.amount, tt.date, tt.rate)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Transfer() = %v, beklenen %q", err, tt.wantErr)
+			}
+		})
+	}
+	if requests != 0 {
+		t.Errorf("Geçersiz virman için %d istek gönderildi", requests)
+	}
+}
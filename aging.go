@@ -0,0 +1,303 @@
+package parasut
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// AgingBucket vade yaşlandırma aralığı
+type AgingBucket string
+
+const (
+	AgingCurrent AgingBucket = "current"
+	Aging1To30   AgingBucket = "1-30"
+	Aging31To60  AgingBucket = "31-60"
+	Aging61To90  AgingBucket = "61-90"
+	AgingOver90  AgingBucket = "90+"
+)
+
+// AgingReportType yaşlandırma raporunun türü
+type AgingReportType string
+
+const (
+	AgingReceivables AgingReportType = "receivables"
+	AgingPayables    AgingReportType = "payables"
+)
+
+// AgingRow bir müşteri/tedarikçinin vade aralıklarına göre kalan TRL tutarları
+type AgingRow struct {
+	ContactID   string  `json:"contact_id"`
+	ContactName string  `json:"contact_name"`
+	Current     float64 `json:"current"`
+	Days1To30   float64 `json:"days_1_30"`
+	Days31To60  float64 `json:"days_31_60"`
+	Days61To90  float64 `json:"days_61_90"`
+	Over90      float64 `json:"over_90"`
+	Total       float64 `json:"total"`
+}
+
+// Amount verilen aralıktaki tutarı döndürür
+func (r *AgingRow) Amount(bucket AgingBucket) float64 {
+	switch bucket {
+	case AgingCurrent:
+		return r.Current
+	case Aging1To30:
+		return r.Days1To30
+	case Aging31To60:
+		return r.Days31To60
+	case Aging61To90:
+		return r.Days61To90
+	case AgingOver90:
+		return r.Over90
+	}
+	return 0
+}
+
+func (r *AgingRow) add(bucket AgingBucket, amount float64) {
+	switch bucket {
+	case AgingCurrent:
+		r.Current = RoundAmount(r.Current + amount)
+	case Aging1To30:
+		r.Days1To30 = RoundAmount(r.Days1To30 + amount)
+	case Aging31To60:
+		r.Days31To60 = RoundAmount(r.Days31To60 + amount)
+	case Aging61To90:
+		r.Days61To90 = RoundAmount(r.Days61To90 + amount)
+	case AgingOver90:
+		r.Over90 = RoundAmount(r.Over90 + amount)
+	}
+	r.Total = RoundAmount(r.Total + amount)
+}
+
+// AgingReport alacak veya borç yaşlandırma raporu
+type AgingReport struct {
+	Type   AgingReportType `json:"type"`
+	AsOf   time.Time       `json:"as_of"`
+	Rows   []AgingRow      `json:"rows"`
+	Totals AgingRow        `json:"totals"`
+}
+
+// WriteCSV raporu müşteri/tedarikçi satırları ve toplam satırıyla CSV olarak yazar
+func (r *AgingReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"contact_id", "contact_name", "current", "1-30", "31-60", "61-90", "90+", "total"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	rows := append(append([]AgingRow{}, r.Rows...), r.Totals)
+	for _, row := range rows {
+		record := []string{
+			row.ContactID,
+			row.ContactName,
+			formatAmount(row.Current),
+			formatAmount(row.Days1To30),
+			formatAmount(row.Days31To60),
+			formatAmount(row.Days61To90),
+			formatAmount(row.Over90),
+			formatAmount(row.Total),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON raporu JSON olarak yazar
+func (r *AgingReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// agingItem rapora girecek açık belge
+type agingItem struct {
+	contact   RelationshipData
+	dueDate   string
+	issueDate string
+	remaining float64
+}
+
+// Aging ödenmemiş ve kısmen ödenmiş satış faturalarından asOf tarihine göre
+// alacak yaşlandırma raporu oluşturur. Tutarlar RemainingInTRL üzerinden toplanır.
+func (s *SalesInvoicesService) Aging(ctx context.Context, asOf time.Time) (*AgingReport, error) {
+	var items []agingItem
+	included := map[RelationshipData]IncludedResource{}
+
+	params := &ListParams{
+		Sort:    "due_date",
+		Filter:  map[string]string{"item_type": "invoice"},
+		Include: "contact",
+	}
+	err := EachPage(ctx, func(ctx context.Context, params *ListParams) ([]SalesInvoice, *Meta, error) {
+		invoices, resources, meta, err := listIncluded[SalesInvoice](s.client, ctx, "/sales_invoices", params)
+		collectIncluded(included, resources)
+		return invoices, meta, err
+	}, params, func(invoices []SalesInvoice, meta *Meta) error {
+		for _, invoice := range invoices {
+			if invoice.Attributes.ItemType != "invoice" || invoice.Attributes.PaymentStatus == "paid" {
+				continue
+			}
+			item := agingItem{
+				dueDate:   invoice.Attributes.DueDate,
+				issueDate: invoice.Attributes.IssueDate,
+				remaining: remainingInTRL(invoice.Attributes.RemainingInTRL, invoice.Attributes.Remaining, invoice.Attributes.Currency),
+			}
+			if invoice.Relationships.Contact != nil {
+				item.contact = *invoice.Relationships.Contact
+			}
+			items = append(items, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAgingReport(AgingReceivables, asOf, items, included)
+}
+
+// Aging ödenmemiş ve kısmen ödenmiş alış faturalarından asOf tarihine göre
+// borç yaşlandırma raporu oluşturur. Tutarlar RemainingInTRL üzerinden toplanır.
+func (s *PurchaseBillsService) Aging(ctx context.Context, asOf time.Time) (*AgingReport, error) {
+	var items []agingItem
+	included := map[RelationshipData]IncludedResource{}
+
+	params := &ListParams{
+		Sort:    "due_date",
+		Include: "supplier",
+	}
+	err := EachPage(ctx, func(ctx context.Context, params *ListParams) ([]PurchaseBill, *Meta, error) {
+		bills, resources, meta, err := listIncluded[PurchaseBill](s.client, ctx, "/purchase_bills", params)
+		collectIncluded(included, resources)
+		return bills, meta, err
+	}, params, func(bills []PurchaseBill, meta *Meta) error {
+		for _, bill := range bills {
+			if bill.Attributes.ItemType == "cancelled" || bill.Attributes.ItemType == "refund" || bill.Attributes.PaymentStatus == "paid" {
+				continue
+			}
+			item := agingItem{
+				dueDate:   bill.Attributes.DueDate,
+				issueDate: bill.Attributes.IssueDate,
+				remaining: remainingInTRL(bill.Attributes.RemainingInTRL, bill.Attributes.Remaining, bill.Attributes.Currency),
+			}
+			if bill.Relationships.Supplier != nil {
+				item.contact = *bill.Relationships.Supplier
+			}
+			items = append(items, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAgingReport(AgingPayables, asOf, items, included)
+}
+
+// buildAgingReport açık belgeleri müşteri/tedarikçi ve vade aralığına göre gruplar
+func buildAgingReport(reportType AgingReportType, asOf time.Time, items []agingItem, included map[RelationshipData]IncludedResource) (*AgingReport, error) {
+	asOf = TruncateDate(asOf)
+	rows := map[string]*AgingRow{}
+
+	for _, item := range items {
+		if item.remaining <= 0 {
+			continue
+		}
+
+		due := item.dueDate
+		if due == "" {
+			due = item.issueDate
+		}
+		dueDate, err := ParseDate(due)
+		if err != nil {
+			return nil, fmt.Errorf("vade tarihi çözülemedi: %w", err)
+		}
+
+		row, ok := rows[item.contact.ID]
+		if !ok {
+			row = &AgingRow{
+				ContactID:   item.contact.ID,
+				ContactName: includedContactName(included, item.contact),
+			}
+			rows[item.contact.ID] = row
+		}
+		row.add(AgingBucketFor(dueDate, asOf), item.remaining)
+	}
+
+	report := &AgingReport{
+		Type:   reportType,
+		AsOf:   asOf,
+		Totals: AgingRow{ContactName: "TOPLAM"},
+	}
+
+	for _, row := range rows {
+		report.Rows = append(report.Rows, *row)
+		for _, bucket := range []AgingBucket{AgingCurrent, Aging1To30, Aging31To60, Aging61To90, AgingOver90} {
+			report.Totals.add(bucket, row.Amount(bucket))
+		}
+	}
+
+	sort.Slice(report.Rows, func(i, j int) bool {
+		if report.Rows[i].Total != report.Rows[j].Total {
+			return report.Rows[i].Total > report.Rows[j].Total
+		}
+		return report.Rows[i].ContactName < report.Rows[j].ContactName
+	})
+
+	return report, nil
+}
+
+// AgingBucketFor vade tarihinin asOf tarihine göre hangi aralığa düştüğünü döndürür
+func AgingBucketFor(dueDate, asOf time.Time) AgingBucket {
+	days := int(TruncateDate(asOf).Sub(TruncateDate(dueDate)).Hours() / 24)
+	switch {
+	case days <= 0:
+		return AgingCurrent
+	case days <= 30:
+		return Aging1To30
+	case days <= 60:
+		return Aging31To60
+	case days <= 90:
+		return Aging61To90
+	default:
+		return AgingOver90
+	}
+}
+
+// remainingInTRL belgenin TRL cinsinden kalan tutarını döndürür
+func remainingInTRL(remainingTRL, remaining float64, currency string) float64 {
+	if remainingTRL == 0 && CurrencyOrDefault(currency) == "TRL" {
+		return remaining
+	}
+	return remainingTRL
+}
+
+// collectIncluded included kaynaklarını tip ve id ile indeksler
+func collectIncluded(index map[RelationshipData]IncludedResource, resources []IncludedResource) {
+	for _, resource := range resources {
+		index[RelationshipData{ID: resource.ID, Type: resource.Type}] = resource
+	}
+}
+
+// includedContactName included kaynaklarından müşteri/tedarikçi adını bulur
+func includedContactName(index map[RelationshipData]IncludedResource, ref RelationshipData) string {
+	resource, ok := index[ref]
+	if !ok {
+		return ""
+	}
+	var contact Contact
+	if err := decodeIncluded(&resource, &contact); err != nil {
+		return ""
+	}
+	return contact.Attributes.Name
+}
@@ -0,0 +1,163 @@
+package parasut
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAgingBucketFor(t *testing.T) {
+	asOf := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		dueDate time.Time
+		want    AgingBucket
+	}{
+		{time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC), AgingCurrent},
+		{asOf, AgingCurrent},
+		{time.Date(2024, 4, 29, 0, 0, 0, 0, time.UTC), Aging1To30},
+		{time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), Aging1To30},
+		{time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC), Aging31To60},
+		{time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), Aging61To90},
+		{time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), AgingOver90},
+	}
+
+	for _, tt := range tests {
+		if got := AgingBucketFor(tt.dueDate, asOf); got != tt.want {
+			t.Errorf("AgingBucketFor(%s) = %s, beklenen %s", tt.dueDate.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestSalesInvoicesService_Aging(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "contact" {
+			t.Errorf("include = %s, beklenen contact", r.URL.Query().Get("include"))
+		}
+
+		if r.URL.Query().Get("filter[item_type]") != "invoice" {
+			t.Errorf("filter[item_type] = %s, beklenen invoice", r.URL.Query().Get("filter[item_type]"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"data": [
+				{"id": "1", "type": "sales_invoices",
+				 "attributes": {"item_type": "invoice", "issue_date": "2024-03-01", "due_date": "2024-03-15", "remaining": 100, "remaining_in_trl": 100, "payment_status": "partially_paid"},
+				 "relationships": {"contact": {"data": {"id": "7", "type": "contacts"}}}},
+				{"id": "2", "type": "sales_invoices",
+				 "attributes": {"item_type": "invoice", "issue_date": "2024-04-20", "due_date": "2024-05-20", "remaining": 50, "remaining_in_trl": 1600, "currency": "USD", "payment_status": "unpaid"},
+				 "relationships": {"contact": {"data": {"id": "7", "type": "contacts"}}}},
+				{"id": "3", "type": "sales_invoices",
+				 "attributes": {"item_type": "invoice", "issue_date": "2023-12-01", "due_date": "2024-01-01", "remaining": 250, "payment_status": "unpaid"},
+				 "relationships": {"contact": {"data": {"id": "8", "type": "contacts"}}}},
+				{"id": "4", "type": "sales_invoices",
+				 "attributes": {"item_type": "invoice", "issue_date": "2024-01-01", "due_date": "2024-01-01", "remaining": 0, "payment_status": "paid"},
+				 "relationships": {"contact": {"data": {"id": "8", "type": "contacts"}}}}
+			],
+			"included": [
+				{"id": "7", "type": "contacts", "attributes": {"name": "Alfa Ltd."}},
+				{"id": "8", "type": "contacts", "attributes": {"name": "Beta A.Ş."}}
+			],
+			"meta": {"current_page": 1, "total_pages": 1, "total_count": 4}
+		}`))
+	})
+
+	asOf := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	report, err := client.SalesInvoices.Aging(context.Background(), asOf)
+	if err != nil {
+		t.Fatalf("SalesInvoices.Aging hata döndü: %v", err)
+	}
+
+	if report.Type != AgingReceivables {
+		t.Errorf("Type = %s, beklenen %s", report.Type, AgingReceivables)
+	}
+
+	if len(report.Rows) != 2 {
+		t.Fatalf("Satır sayısı = %d, beklenen 2", len(report.Rows))
+	}
+
+	alfa := report.Rows[0]
+	if alfa.ContactName != "Alfa Ltd." || alfa.Current != 1600 || alfa.Days31To60 != 100 || alfa.Total != 1700 {
+		t.Errorf("Alfa satırı = %+v", alfa)
+	}
+
+	beta := report.Rows[1]
+	if beta.ContactName != "Beta A.Ş." || beta.Over90 != 250 {
+		t.Errorf("Beta satırı = %+v", beta)
+	}
+
+	if report.Totals.Total != 1950 {
+		t.Errorf("Toplam = %f, beklenen 1950", report.Totals.Total)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV hata döndü: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV okunamadı: %v", err)
+	}
+
+	if len(records) != 4 || records[3][7] != "1950.00" {
+		t.Errorf("CSV = %v", records)
+	}
+
+	buf.Reset()
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON hata döndü: %v", err)
+	}
+
+	var decoded AgingReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("JSON çözülemedi: %v", err)
+	}
+
+	if decoded.Totals.Over90 != 250 {
+		t.Errorf("JSON Over90 = %f, beklenen 250", decoded.Totals.Over90)
+	}
+}
+
+func TestPurchaseBillsService_Aging(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "supplier" {
+			t.Errorf("include = %s, beklenen supplier", r.URL.Query().Get("include"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"data": [
+				{"id": "1", "type": "purchase_bills",
+				 "attributes": {"item_type": "purchase_bill", "issue_date": "2024-04-01", "due_date": "2024-04-10", "remaining": 300, "remaining_in_trl": 300},
+				 "relationships": {"supplier": {"data": {"id": "9", "type": "contacts"}}}},
+				{"id": "2", "type": "purchase_bills",
+				 "attributes": {"item_type": "cancelled", "issue_date": "2024-04-01", "due_date": "2024-04-10", "remaining": 300, "remaining_in_trl": 300},
+				 "relationships": {"supplier": {"data": {"id": "9", "type": "contacts"}}}}
+			],
+			"included": [
+				{"id": "9", "type": "contacts", "attributes": {"name": "Tedarikçi"}}
+			],
+			"meta": {"current_page": 1, "total_pages": 1, "total_count": 2}
+		}`))
+	})
+
+	asOf := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	report, err := client.PurchaseBills.Aging(context.Background(), asOf)
+	if err != nil {
+		t.Fatalf("PurchaseBills.Aging hata döndü: %v", err)
+	}
+
+	if report.Type != AgingPayables {
+		t.Errorf("Type = %s, beklenen %s", report.Type, AgingPayables)
+	}
+
+	if len(report.Rows) != 1 || report.Rows[0].Days1To30 != 300 || report.Rows[0].ContactName != "Tedarikçi" {
+		t.Errorf("Satırlar = %+v", report.Rows)
+	}
+}
@@ -0,0 +1,373 @@
+// Package backup şirketin tüm muhasebe verisini yerel dosyalara yedekler.
+// Her kaynak, API'den geldiği haliyle (modellerde karşılığı olmayan
+// nitelikler dahil) tip başına bir JSON Lines dosyasına yazılır; include ile
+// gelen fatura kalemleri ve ödemeler kendi dosyalarına ayrılır. İsteğe bağlı
+// olarak her tip için sütunlu CSV dosyaları ve belge PDF'leri de üretilir.
+//
+//	manifest, err := backup.Run(ctx, client, backup.Options{
+//		Dir:         "yedek",
+//		Incremental: true,
+//		PDF:         true,
+//	})
+//
+// Dizin yapısı:
+//
+//	yedek/manifest.json            kaynak başına kayıt sayısı, imleç ve hatalar
+//	yedek/sales_invoices.jsonl     satır başına bir kaynak
+//	yedek/sales_invoice_details.jsonl
+//	yedek/payments.jsonl
+//	yedek/pdf/sales_invoices/42.pdf
+//
+// Yarıda kalan çalıştırma checkpoint.json dosyasından, kaldığı kaynak ve
+// sayfadan devam eder. Artımlı modda yalnızca son çalıştırmadan sonra
+// UpdatedAt'i değişen kayıtlar alınır ve mevcut dosyalarla id üzerinden
+// birleştirilir. Silinen kayıtlar artımlı modda tespit edilemez; bunun için
+// düzenli aralıklarla tam yedek alınmalıdır.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+const (
+	manifestFile   = "manifest.json"
+	checkpointFile = "checkpoint.json"
+	partialDir     = ".partial"
+	pdfDir         = "pdf"
+)
+
+// Resource yedeklenen kaynak tanımı
+type Resource struct {
+	// Name API yolu ve dosya adı (ör. "sales_invoices")
+	Name string
+	// Include kaynakla birlikte alınan ilişkiler; gelen kaynaklar kendi tiplerinin dosyasına yazılır
+	Include string
+	// PDF belgenin PDF'i indirilebilir
+	PDF bool
+}
+
+// Resources varsayılan olarak yedeklenen kaynaklar. Ödemeler ayrı bir
+// listeleme ucu olmadığından faturalar, maaşlar ve vergilerle birlikte alınır.
+var Resources = []Resource{
+	{Name: "accounts"},
+	{Name: "contacts"},
+	{Name: "products"},
+	{Name: "sales_invoices", Include: "details,payments", PDF: true},
+	{Name: "purchase_bills", Include: "details,payments", PDF: true},
+	{Name: "employees"},
+	{Name: "salaries", Include: "payments"},
+	{Name: "taxes", Include: "payments"},
+	{Name: "tags"},
+	{Name: "warehouses"},
+	{Name: "stock_movements"},
+	{Name: "sales_offers", Include: "details", PDF: true},
+	{Name: "shipment_documents"},
+	{Name: "recurrence_plans"},
+}
+
+// Options yedekleme ayarları
+type Options struct {
+	// Dir yedek dizini; yoksa oluşturulur
+	Dir string
+	// Resources yedeklenecek kaynak adları; boşsa tüm Resources
+	Resources []string
+	// Incremental yalnızca önceki yedekten sonra değişen kayıtları alır.
+	// Önceki yedekte imleci olmayan kaynaklar tam alınır.
+	Incremental bool
+	// Overlap artımlı modda saat farkı ve aynı zaman damgalı kayıtlar için
+	// imleçten geriye doğru tekrar okunan süre; 0 ise 5 dakika
+	Overlap time.Duration
+	// PDF fatura, alış faturası ve tekliflerin PDF'lerini indirir
+	PDF bool
+	// CSV her tip için JSON Lines dosyasının yanına sütunlu bir CSV yazar
+	CSV bool
+	// PageSize sayfa başına kayıt sayısı; 0 ise API varsayılanı
+	PageSize int
+}
+
+// Manifest yedeğin içeriğini tanımlar
+type Manifest struct {
+	CompanyID   int                          `json:"company_id"`
+	StartedAt   time.Time                    `json:"started_at"`
+	CompletedAt *time.Time                   `json:"completed_at,omitempty"`
+	Incremental bool                         `json:"incremental"`
+	Resources   map[string]*ResourceManifest `json:"resources"`
+}
+
+// ResourceManifest tek bir kaynak tipinin yedek bilgisi
+type ResourceManifest struct {
+	File string `json:"file"`
+	// Count dosyadaki kayıt sayısı
+	Count int `json:"count"`
+	// Changed son çalıştırmada yazılan veya güncellenen kayıt sayısı
+	Changed int `json:"changed"`
+	// Cursor alınan kayıtlardaki en yeni UpdatedAt; artımlı yedek bu değerden devam eder
+	Cursor    *time.Time `json:"cursor,omitempty"`
+	PDFs      int        `json:"pdfs,omitempty"`
+	PDFErrors []string   `json:"pdf_errors,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// entry tipin manifest kaydını döndürür. Kayıt bu çalıştırmada ilk kez
+// kullanılıyorsa çalıştırmaya özel sayaçlar sıfırlanır.
+func (m *Manifest) entry(name string) *ResourceManifest {
+	entry := m.Resources[name]
+	if entry == nil {
+		entry = &ResourceManifest{File: name + ".jsonl"}
+		m.Resources[name] = entry
+	}
+	if entry.UpdatedAt.Before(m.StartedAt) {
+		entry.Changed, entry.PDFs, entry.PDFErrors = 0, 0, nil
+		entry.UpdatedAt = time.Now().UTC()
+	}
+	return entry
+}
+
+// checkpoint yarıda kalan çalıştırmanın durumu
+type checkpoint struct {
+	StartedAt   time.Time  `json:"started_at"`
+	Incremental bool       `json:"incremental"`
+	Done        []string   `json:"done"`
+	Current     string     `json:"current,omitempty"`
+	Page        int        `json:"page,omitempty"`
+	Cursor      *time.Time `json:"cursor,omitempty"`
+	PDFs        int        `json:"pdfs,omitempty"`
+	PDFErrors   []string   `json:"pdf_errors,omitempty"`
+}
+
+// errStop artımlı modda imlece ulaşıldığında sayfalamayı durdurur
+var errStop = errors.New("imlece ulaşıldı")
+
+// Run yedeği alır ve güncel manifesti döndürür. Dizinde checkpoint.json
+// varsa önceki çalıştırmaya kaldığı yerden devam edilir.
+func Run(ctx context.Context, client *parasut.Client, opts Options) (*Manifest, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("yedek dizini belirtilmeli")
+	}
+	if opts.Overlap == 0 {
+		opts.Overlap = 5 * time.Minute
+	}
+	resources, err := selectResources(opts.Resources)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(opts.Dir, partialDir), 0o755); err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{Resources: map[string]*ResourceManifest{}}
+	if err := readJSON(filepath.Join(opts.Dir, manifestFile), manifest); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("manifest okunamadı: %w", err)
+	}
+
+	state := &checkpoint{}
+	err = readJSON(filepath.Join(opts.Dir, checkpointFile), state)
+	switch {
+	case os.IsNotExist(err):
+		state = &checkpoint{StartedAt: time.Now().UTC(), Incremental: opts.Incremental}
+		if !opts.Incremental {
+			if err := clean(opts.Dir, manifest); err != nil {
+				return nil, err
+			}
+			manifest.Resources = map[string]*ResourceManifest{}
+		}
+	case err != nil:
+		return nil, fmt.Errorf("checkpoint okunamadı: %w", err)
+	case state.Incremental != opts.Incremental:
+		return nil, errors.New("yarım kalan yedek farklı bir modda başlatılmış; checkpoint.json silinmeli veya aynı modla devam edilmeli")
+	}
+
+	manifest.CompanyID = client.GetCompanyID()
+	manifest.StartedAt = state.StartedAt
+	manifest.CompletedAt = nil
+	manifest.Incremental = opts.Incremental
+
+	done := map[string]bool{}
+	for _, name := range state.Done {
+		done[name] = true
+	}
+
+	for _, resource := range resources {
+		if done[resource.Name] {
+			continue
+		}
+		if state.Current != resource.Name {
+			state.Current, state.Page, state.Cursor, state.PDFs, state.PDFErrors = resource.Name, 1, nil, 0, nil
+			os.Remove(partialPath(opts.Dir, resource.Name))
+		}
+
+		if err := exportResource(ctx, client, opts, resource, manifest, state); err != nil {
+			return nil, fmt.Errorf("%s yedeklenemedi: %w", resource.Name, err)
+		}
+
+		state.Done = append(state.Done, resource.Name)
+		state.Current, state.Page, state.Cursor, state.PDFs, state.PDFErrors = "", 0, nil, 0, nil
+		if err := writeJSON(filepath.Join(opts.Dir, manifestFile), manifest); err != nil {
+			return nil, err
+		}
+		if err := writeJSON(filepath.Join(opts.Dir, checkpointFile), state); err != nil {
+			return nil, err
+		}
+	}
+
+	completed := time.Now().UTC()
+	manifest.CompletedAt = &completed
+	if err := writeJSON(filepath.Join(opts.Dir, manifestFile), manifest); err != nil {
+		return nil, err
+	}
+	os.Remove(filepath.Join(opts.Dir, checkpointFile))
+	os.RemoveAll(filepath.Join(opts.Dir, partialDir))
+	return manifest, nil
+}
+
+// exportResource kaynağı sayfa sayfa geçici dosyaya yazar, her sayfadan sonra
+// checkpoint kaydeder ve sonunda kayıtları tip dosyalarıyla birleştirir
+func exportResource(ctx context.Context, client *parasut.Client, opts Options, resource Resource, manifest *Manifest, state *checkpoint) error {
+	var since *time.Time
+	if previous := manifest.Resources[resource.Name]; opts.Incremental && previous != nil && previous.Cursor != nil {
+		t := previous.Cursor.Add(-opts.Overlap)
+		since = &t
+	}
+
+	params := &parasut.ListParams{Page: state.Page, PageSize: opts.PageSize, Include: resource.Include}
+	if since != nil {
+		params.Sort = "-updated_at"
+	}
+
+	err := parasut.EachPage(ctx, func(ctx context.Context, params *parasut.ListParams) ([]parasut.IncludedResource, *parasut.Meta, error) {
+		items, included, meta, err := client.ListResources(ctx, "/"+resource.Name, params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reachedCursor := false
+		var page []parasut.IncludedResource
+		for _, item := range items {
+			updatedAt := updatedAt(item)
+			if since != nil && updatedAt != nil && updatedAt.Before(*since) {
+				reachedCursor = true
+				continue
+			}
+			if updatedAt != nil && (state.Cursor == nil || updatedAt.After(*state.Cursor)) {
+				state.Cursor = updatedAt
+			}
+			page = append(page, item)
+		}
+
+		if err := appendLines(partialPath(opts.Dir, resource.Name), append(page, included...)); err != nil {
+			return nil, nil, err
+		}
+		if opts.PDF && resource.PDF {
+			if err := downloadPDFs(ctx, client, opts.Dir, resource.Name, page, state); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		state.Page++
+		if err := writeJSON(filepath.Join(opts.Dir, checkpointFile), state); err != nil {
+			return nil, nil, err
+		}
+		if reachedCursor {
+			return nil, nil, errStop
+		}
+		return items, meta, nil
+	}, params, func([]parasut.IncludedResource, *parasut.Meta) error { return nil })
+	if err != nil && !errors.Is(err, errStop) {
+		return err
+	}
+
+	if err := mergePartial(opts, resource.Name, manifest); err != nil {
+		return err
+	}
+
+	entry := manifest.entry(resource.Name)
+	if state.Cursor != nil && (entry.Cursor == nil || state.Cursor.After(*entry.Cursor)) {
+		entry.Cursor = state.Cursor
+	}
+	entry.PDFs = state.PDFs
+	entry.PDFErrors = state.PDFErrors
+	return nil
+}
+
+// downloadPDFs sayfadaki belgelerin PDF'lerini indirir. İndirilemeyen
+// PDF'ler (ör. henüz resmileşmemiş belgeler) yedeği durdurmaz, manifeste yazılır.
+func downloadPDFs(ctx context.Context, client *parasut.Client, dir, name string, items []parasut.IncludedResource, state *checkpoint) error {
+	var fetch func(context.Context, string) ([]byte, error)
+	switch name {
+	case "sales_invoices":
+		fetch = client.SalesInvoices.GetPDF
+	case "purchase_bills":
+		fetch = client.PurchaseBills.GetPDF
+	case "sales_offers":
+		fetch = client.SalesOffers.GetPDF
+	default:
+		return nil
+	}
+
+	target := filepath.Join(dir, pdfDir, name)
+	if err := os.MkdirAll(target, 0o755); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		data, err := fetch(ctx, item.ID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			state.PDFErrors = append(state.PDFErrors, fmt.Sprintf("%s: %v", item.ID, err))
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(target, item.ID+".pdf"), data, 0o644); err != nil {
+			return err
+		}
+		state.PDFs++
+	}
+	return nil
+}
+
+// selectResources ada göre kaynak tanımlarını Resources sırasıyla döndürür
+func selectResources(names []string) ([]Resource, error) {
+	if len(names) == 0 {
+		return Resources, nil
+	}
+
+	known := map[string]bool{}
+	for _, resource := range Resources {
+		known[resource.Name] = true
+	}
+	wanted := map[string]bool{}
+	for _, name := range names {
+		if !known[name] {
+			return nil, fmt.Errorf("bilinmeyen kaynak: %s", name)
+		}
+		wanted[name] = true
+	}
+
+	var selected []Resource
+	for _, resource := range Resources {
+		if wanted[resource.Name] {
+			selected = append(selected, resource)
+		}
+	}
+	return selected, nil
+}
+
+// updatedAt kaynağın updated_at niteliğini döndürür
+func updatedAt(resource parasut.IncludedResource) *time.Time {
+	var attributes struct {
+		UpdatedAt *time.Time `json:"updated_at"`
+	}
+	if len(resource.Attributes) == 0 || json.Unmarshal(resource.Attributes, &attributes) != nil {
+		return nil
+	}
+	return attributes.UpdatedAt
+}
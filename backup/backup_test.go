@@ -0,0 +1,223 @@
+package backup
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/parasuttest"
+	"golang.org/x/oauth2"
+)
+
+// clock sahte sunucunun zamanını elle ilerletmek için kullanılır
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newServer(t *testing.T) (*parasut.Client, *parasuttest.Server, *clock) {
+	t.Helper()
+	client, server := parasuttest.NewClient(t)
+	c := &clock{now: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	server.Now = c.Now
+	return client, server, c
+}
+
+func readFile(t *testing.T, path string) []parasut.IncludedResource {
+	t.Helper()
+	resources, err := readLines(path)
+	if err != nil {
+		t.Fatalf("%s okunamadı: %v", path, err)
+	}
+	return resources
+}
+
+func TestRun_Full(t *testing.T) {
+	client, server, _ := newServer(t)
+	server.Seed("contacts", map[string]interface{}{"name": "Alfa Ltd.", "yeni_alan": "korunur"}, nil)
+	server.Seed("contacts", map[string]interface{}{"name": "Beta A.Ş."}, nil)
+	contact := server.Seed("contacts", map[string]interface{}{"name": "Gama Ltd."}, nil)
+	detail := server.Seed("sales_invoice_details", map[string]interface{}{"quantity": 2, "unit_price": 50}, nil)
+	invoice := server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01", "net_total": 100}, map[string]interface{}{
+		"contact": parasut.RelationshipData{ID: contact, Type: "contacts"},
+		"details": []parasut.RelationshipData{{ID: detail, Type: "sales_invoice_details"}},
+	})
+	if _, err := client.SalesInvoices.CreatePayment(context.Background(), invoice, parasut.PaymentInput{AccountID: "9", Date: "2024-05-01", Amount: 40, ExchangeRate: 1}); err != nil {
+		t.Fatalf("CreatePayment hata döndü: %v", err)
+	}
+
+	dir := t.TempDir()
+	manifest, err := Run(context.Background(), client, Options{
+		Dir:       dir,
+		Resources: []string{"contacts", "sales_invoices"},
+		PDF:       true,
+		CSV:       true,
+		PageSize:  2,
+	})
+	if err != nil {
+		t.Fatalf("Run hata döndü: %v", err)
+	}
+
+	contacts := readFile(t, filepath.Join(dir, "contacts.jsonl"))
+	if len(contacts) != 3 || !strings.Contains(string(contacts[0].Attributes), `"yeni_alan":"korunur"`) {
+		t.Errorf("Müşteriler = %+v", contacts)
+	}
+	if payments := readFile(t, filepath.Join(dir, "payments.jsonl")); len(payments) != 1 {
+		t.Errorf("Ödemeler = %+v", payments)
+	}
+	if details := readFile(t, filepath.Join(dir, "sales_invoice_details.jsonl")); len(details) != 1 || details[0].ID != detail {
+		t.Errorf("Fatura kalemleri = %+v", details)
+	}
+
+	if pdf, err := os.ReadFile(filepath.Join(dir, "pdf", "sales_invoices", invoice+".pdf")); err != nil || !strings.HasPrefix(string(pdf), "%PDF") {
+		t.Errorf("PDF = %q, %v", pdf, err)
+	}
+
+	file, _ := os.Open(filepath.Join(dir, "sales_invoices.csv"))
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV okunamadı: %v", err)
+	}
+	header := strings.Join(records[0], ",")
+	if !strings.HasPrefix(header, "id,") || !strings.Contains(header, "net_total") || !strings.HasSuffix(header, "ilişki.contact,ilişki.details,ilişki.payments") {
+		t.Errorf("CSV başlığı = %s", header)
+	}
+	if last := records[1][len(records[1])-3:]; last[0] != contact || last[1] != detail {
+		t.Errorf("CSV ilişkileri = %q", last)
+	}
+
+	if manifest.CompanyID != parasuttest.DefaultCompanyID || manifest.CompletedAt == nil {
+		t.Errorf("Manifest = %+v", manifest)
+	}
+	if entry := manifest.Resources["contacts"]; entry.Count != 3 || entry.Changed != 3 || entry.Cursor == nil {
+		t.Errorf("contacts manifesti = %+v", entry)
+	}
+	if entry := manifest.Resources["sales_invoices"]; entry.Count != 1 || entry.PDFs != 1 {
+		t.Errorf("sales_invoices manifesti = %+v", entry)
+	}
+	if entry := manifest.Resources["payments"]; entry == nil || entry.Count != 1 {
+		t.Errorf("payments manifesti = %+v", entry)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, checkpointFile)); !os.IsNotExist(err) {
+		t.Error("Tamamlanan yedekte checkpoint kalmamalı")
+	}
+}
+
+func TestRun_Incremental(t *testing.T) {
+	client, server, clock := newServer(t)
+	for i := 0; i < 5; i++ {
+		server.Seed("contacts", map[string]interface{}{"name": "Eski"}, nil)
+	}
+	clock.now = clock.now.Add(30 * time.Minute)
+	changed := server.Seed("contacts", map[string]interface{}{"name": "Değişecek"}, nil)
+
+	dir := t.TempDir()
+	opts := Options{Dir: dir, Resources: []string{"contacts"}, PageSize: 3, Overlap: time.Minute}
+	if _, err := Run(context.Background(), client, opts); err != nil {
+		t.Fatalf("Tam yedek hata döndü: %v", err)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	if _, err := client.Contacts.Update(context.Background(), changed, parasut.ContactInput{Name: "Değişti"}, nil); err != nil {
+		t.Fatalf("Update hata döndü: %v", err)
+	}
+	added := server.Seed("contacts", map[string]interface{}{"name": "Yeni"}, nil)
+	before := len(server.Requests())
+
+	opts.Incremental = true
+	manifest, err := Run(context.Background(), client, opts)
+	if err != nil {
+		t.Fatalf("Artımlı yedek hata döndü: %v", err)
+	}
+
+	requests := server.Requests()[before:]
+	if len(requests) != 1 || requests[0].Query.Get("sort") != "-updated_at" {
+		t.Errorf("İstekler = %+v, imlece ulaşınca durulmalı", requests)
+	}
+
+	contacts := readFile(t, filepath.Join(dir, "contacts.jsonl"))
+	if len(contacts) != 7 {
+		t.Fatalf("Müşteri sayısı = %d, beklenen 7", len(contacts))
+	}
+	for _, contact := range contacts {
+		if contact.ID == changed && !strings.Contains(string(contact.Attributes), "Değişti") {
+			t.Errorf("Güncellenen müşteri = %s", contact.Attributes)
+		}
+	}
+	if contacts[6].ID != added {
+		t.Errorf("Yeni müşteri sona eklenmeli: %+v", contacts[6])
+	}
+
+	entry := manifest.Resources["contacts"]
+	if entry.Count != 7 || entry.Changed != 2 || !entry.Cursor.Equal(clock.now) {
+		t.Errorf("Manifest = %+v", entry)
+	}
+}
+
+// failingTransport page[number] sorgusu verilen sayfa olan ilk isteği başarısız yapar
+type failingTransport struct {
+	page   string
+	failed bool
+}
+
+func (f *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !f.failed && req.URL.Query().Get("page[number]") == f.page {
+		f.failed = true
+		return nil, errors.New("bağlantı koptu")
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRun_Resume(t *testing.T) {
+	server := parasuttest.NewServer()
+	t.Cleanup(server.Close)
+	for i := 0; i < 5; i++ {
+		server.Seed("contacts", map[string]interface{}{"name": "Müşteri"}, nil)
+	}
+	server.Seed("accounts", map[string]interface{}{"name": "Kasa"}, nil)
+
+	config := server.Config()
+	config.Transport = &failingTransport{page: "2"}
+	client := parasut.NewClient(config)
+	client.SetToken(&oauth2.Token{AccessToken: server.Token, TokenType: "Bearer"})
+
+	dir := t.TempDir()
+	opts := Options{Dir: dir, Resources: []string{"accounts", "contacts"}, PageSize: 2}
+	if _, err := Run(context.Background(), client, opts); err == nil {
+		t.Fatal("Run bağlantı hatasında hata döndürmeli")
+	}
+
+	var state checkpoint
+	if err := readJSON(filepath.Join(dir, checkpointFile), &state); err != nil {
+		t.Fatalf("checkpoint okunamadı: %v", err)
+	}
+	if state.Current != "contacts" || state.Page != 2 || len(state.Done) != 1 {
+		t.Errorf("Checkpoint = %+v", state)
+	}
+
+	before := len(server.Requests())
+	manifest, err := Run(context.Background(), client, opts)
+	if err != nil {
+		t.Fatalf("Devam eden yedek hata döndü: %v", err)
+	}
+
+	for _, request := range server.Requests()[before:] {
+		if strings.HasSuffix(request.Path, "/accounts") || request.Query.Get("page[number]") == "1" {
+			t.Errorf("Tamamlanan sayfa tekrar istendi: %s?%s", request.Path, request.Query.Encode())
+		}
+	}
+	if contacts := readFile(t, filepath.Join(dir, "contacts.jsonl")); len(contacts) != 5 {
+		t.Errorf("Müşteri sayısı = %d, beklenen 5", len(contacts))
+	}
+	if manifest.Resources["accounts"].Count != 1 || manifest.Resources["contacts"].Count != 5 {
+		t.Errorf("Manifest = %+v", manifest.Resources)
+	}
+}
@@ -0,0 +1,289 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// partialPath kaynağın tamamlanmamış sayfalarının yazıldığı geçici dosya
+func partialPath(dir, name string) string {
+	return filepath.Join(dir, partialDir, name+".jsonl")
+}
+
+// appendLines kaynakları JSON Lines dosyasının sonuna ekler
+func appendLines(path string, resources []parasut.IncludedResource) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, resource := range resources {
+		if err := encoder.Encode(resource); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// readLines JSON Lines dosyasını okur; dosya yoksa boş döner
+func readLines(path string) ([]parasut.IncludedResource, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []parasut.IncludedResource
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var resource parasut.IncludedResource
+		if err := json.Unmarshal(line, &resource); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// mergePartial geçici dosyadaki kaynakları tiplerine göre ayırır ve tip
+// dosyalarına id üzerinden ekler veya mevcut satırı günceller
+func mergePartial(opts Options, name string, manifest *Manifest) error {
+	partial := partialPath(opts.Dir, name)
+	resources, err := readLines(partial)
+	if err != nil {
+		return err
+	}
+
+	byType := map[string][]parasut.IncludedResource{name: nil}
+	for _, resource := range resources {
+		byType[resource.Type] = append(byType[resource.Type], resource)
+	}
+
+	types := make([]string, 0, len(byType))
+	for resourceType := range byType {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+
+	for _, resourceType := range types {
+		entry := manifest.entry(resourceType)
+		path := filepath.Join(opts.Dir, entry.File)
+
+		existing, err := readLines(path)
+		if err != nil {
+			return err
+		}
+		merged, changed := upsert(existing, byType[resourceType])
+
+		if err := writeLines(path, merged); err != nil {
+			return err
+		}
+		if opts.CSV {
+			if err := writeCSV(strings.TrimSuffix(path, ".jsonl")+".csv", merged); err != nil {
+				return err
+			}
+		}
+
+		entry.Count = len(merged)
+		entry.Changed += changed
+		entry.UpdatedAt = time.Now().UTC()
+	}
+
+	return os.Remove(partial)
+}
+
+// upsert yeni kaynakları id üzerinden mevcut listeye ekler veya günceller.
+// Aynı kaynak birden çok kez geldiyse sonuncusu geçerlidir.
+func upsert(existing, incoming []parasut.IncludedResource) ([]parasut.IncludedResource, int) {
+	index := map[string]int{}
+	for i, resource := range existing {
+		index[resource.ID] = i
+	}
+
+	changed := map[string]bool{}
+	for _, resource := range incoming {
+		if i, ok := index[resource.ID]; ok {
+			existing[i] = resource
+		} else {
+			index[resource.ID] = len(existing)
+			existing = append(existing, resource)
+		}
+		changed[resource.ID] = true
+	}
+	return existing, len(changed)
+}
+
+// writeLines dosyayı geçici dosya üzerinden atomik olarak yeniden yazar
+func writeLines(path string, resources []parasut.IncludedResource) error {
+	tmp := path + ".tmp"
+	os.Remove(tmp)
+	if err := appendLines(tmp, resources); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// writeCSV kaynakları sütunlu CSV olarak yazar. Sütunlar id, nitelikler
+// (alfabetik) ve ilişkilerdir; ilişki sütunları "ilişki.ad" biçimindedir ve
+// birden çok id noktalı virgülle ayrılır.
+func writeCSV(path string, resources []parasut.IncludedResource) error {
+	rows := make([]map[string]string, 0, len(resources))
+	attributeSet := map[string]bool{}
+	relationshipSet := map[string]bool{}
+
+	for _, resource := range resources {
+		row := map[string]string{"id": resource.ID}
+
+		var attributes map[string]json.RawMessage
+		if len(resource.Attributes) > 0 {
+			if err := json.Unmarshal(resource.Attributes, &attributes); err != nil {
+				return fmt.Errorf("%s/%s nitelikleri çözülemedi: %w", resource.Type, resource.ID, err)
+			}
+		}
+		for key, value := range attributes {
+			attributeSet[key] = true
+			row[key] = cell(value)
+		}
+
+		var relationships map[string]struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if len(resource.Relationships) > 0 {
+			if err := json.Unmarshal(resource.Relationships, &relationships); err != nil {
+				return fmt.Errorf("%s/%s ilişkileri çözülemedi: %w", resource.Type, resource.ID, err)
+			}
+		}
+		for key, relationship := range relationships {
+			column := "ilişki." + key
+			relationshipSet[column] = true
+			row[column] = relationshipIDs(relationship.Data)
+		}
+		rows = append(rows, row)
+	}
+
+	header := append([]string{"id"}, sortedKeys(attributeSet)...)
+	header = append(header, sortedKeys(relationshipSet)...)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Write(header)
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for i, column := range header {
+			record[i] = row[column]
+		}
+		writer.Write(record)
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// cell JSON değerini CSV hücresine çevirir; metinler tırnaksız, diğer değerler JSON olarak yazılır
+func cell(value json.RawMessage) string {
+	var text string
+	if json.Unmarshal(value, &text) == nil {
+		return text
+	}
+	if string(value) == "null" {
+		return ""
+	}
+	return string(value)
+}
+
+// relationshipIDs tekil veya çoğul ilişki verisindeki id'leri döndürür
+func relationshipIDs(data json.RawMessage) string {
+	var many []parasut.RelationshipData
+	if json.Unmarshal(data, &many) == nil {
+		ids := make([]string, len(many))
+		for i, ref := range many {
+			ids[i] = ref.ID
+		}
+		return strings.Join(ids, ";")
+	}
+
+	var one parasut.RelationshipData
+	if json.Unmarshal(data, &one) == nil {
+		return one.ID
+	}
+	return ""
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// clean tam yedekten önce önceki yedeğin veri dosyalarını ve PDF'lerini siler.
+// Yalnızca manifestte kayıtlı dosyalar silinir.
+func clean(dir string, manifest *Manifest) error {
+	for _, entry := range manifest.Resources {
+		path := filepath.Join(dir, entry.File)
+		for _, file := range []string{path, strings.TrimSuffix(path, ".jsonl") + ".csv"} {
+			if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	if err := os.RemoveAll(filepath.Join(dir, pdfDir)); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, partialDir))
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.Remove(filepath.Join(dir, partialDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeJSON dosyayı geçici dosya üzerinden atomik olarak yazar
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
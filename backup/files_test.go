@@ -0,0 +1,69 @@
+package backup
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/parevo-lab/parasut"
+)
+
+func TestUpsert(t *testing.T) {
+	existing := []parasut.IncludedResource{{ID: "1"}, {ID: "2"}}
+	incoming := []parasut.IncludedResource{
+		{ID: "2", Attributes: json.RawMessage(`{"v":1}`)},
+		{ID: "3"},
+		{ID: "2", Attributes: json.RawMessage(`{"v":2}`)},
+	}
+
+	merged, changed := upsert(existing, incoming)
+	if len(merged) != 3 || changed != 2 {
+		t.Fatalf("upsert = %+v, %d", merged, changed)
+	}
+	if merged[1].ID != "2" || string(merged[1].Attributes) != `{"v":2}` || merged[2].ID != "3" {
+		t.Errorf("Birleştirilen kayıtlar = %+v", merged)
+	}
+}
+
+func TestCellAndRelationshipIDs(t *testing.T) {
+	tests := map[string]string{`"metin"`: "metin", `12.5`: "12.5", `true`: "true", `null`: "", `{"a":1}`: `{"a":1}`}
+	for raw, want := range tests {
+		if got := cell(json.RawMessage(raw)); got != want {
+			t.Errorf("cell(%s) = %q, beklenen %q", raw, got, want)
+		}
+	}
+
+	if got := relationshipIDs(json.RawMessage(`[{"id":"1","type":"tags"},{"id":"2","type":"tags"}]`)); got != "1;2" {
+		t.Errorf("Çoğul ilişki = %q", got)
+	}
+	if got := relationshipIDs(json.RawMessage(`{"id":"7","type":"contacts"}`)); got != "7" {
+		t.Errorf("Tekil ilişki = %q", got)
+	}
+	if got := relationshipIDs(json.RawMessage(`null`)); got != "" {
+		t.Errorf("Boş ilişki = %q", got)
+	}
+}
+
+func TestClean_OnlyManifestFiles(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, partialDir), 0o755)
+	os.MkdirAll(filepath.Join(dir, pdfDir, "sales_invoices"), 0o755)
+	for _, name := range []string{"contacts.jsonl", "contacts.csv", "notlar.txt", filepath.Join(partialDir, "tags.jsonl")} {
+		os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o644)
+	}
+
+	manifest := &Manifest{Resources: map[string]*ResourceManifest{"contacts": {File: "contacts.jsonl"}}}
+	if err := clean(dir, manifest); err != nil {
+		t.Fatalf("clean hata döndü: %v", err)
+	}
+
+	for _, name := range []string{"contacts.jsonl", "contacts.csv", pdfDir, filepath.Join(partialDir, "tags.jsonl")} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s silinmeli", name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "notlar.txt")); err != nil {
+		t.Error("Manifestte olmayan dosya silinmemeli")
+	}
+}
@@ -0,0 +1,248 @@
+package parasut
+
+import (
+	"bytes"
+	linkedlist "container/list"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTLs seyrek değişen referans verileri için varsayılan önbellek
+// süreleri. Anahtarlar firma numarasından sonraki ilk path parçasıdır; /me
+// için "me" kullanılır.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"me":              time.Hour,
+	"taxes":           10 * time.Minute,
+	"tags":            10 * time.Minute,
+	"item_categories": 10 * time.Minute,
+	"warehouses":      10 * time.Minute,
+	"accounts":        time.Minute,
+}
+
+// CacheConfig GET yanıtlarının önbelleğe alınma ayarları
+type CacheConfig struct {
+	// Backend yanıtların saklandığı yer. Boşsa 1000 kayıtlık MemoryCache kullanılır.
+	Backend Cache
+
+	// TTLs kaynak başına önbellek süreleri. Boşsa DefaultCacheTTLs kullanılır;
+	// listede olmayan kaynaklar önbelleğe alınmaz.
+	TTLs map[string]time.Duration
+}
+
+// CacheEntry önbellekteki bir GET yanıtı
+type CacheEntry struct {
+	Resource  string
+	Body      []byte
+	ETag      string
+	ExpiresAt time.Time
+}
+
+// Cache önbellek arka ucu. Redis, memcached vb. için kendi uygulamanızı
+// yazabilirsiniz; metodlar eşzamanlı çağrılabilir olmalıdır.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	// DeleteResource kaynağa ait tüm kayıtları siler
+	DeleteResource(resource string)
+}
+
+// MemoryCache en son kullanılan kayıtları tutan bellek içi LRU önbellek
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *linkedlist.List
+	entries    map[string]*linkedlist.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache en fazla maxEntries kayıt tutan MemoryCache oluşturur.
+// maxEntries 0 veya negatifse sınır uygulanmaz.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{maxEntries: maxEntries, order: linkedlist.New(), entries: map[string]*linkedlist.Element{}}
+}
+
+func (c *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*memoryCacheItem).entry, true
+}
+
+func (c *MemoryCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*memoryCacheItem).entry = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&memoryCacheItem{key: key, entry: entry})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+func (c *MemoryCache) DeleteResource(resource string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, element := range c.entries {
+		if element.Value.(*memoryCacheItem).entry.Resource == resource {
+			c.order.Remove(element)
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Len önbellekteki kayıt sayısını döndürür
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// responseCache istemcinin önbellek durumu
+type responseCache struct {
+	backend Cache
+	ttls    map[string]time.Duration
+	now     func() time.Time
+}
+
+func newResponseCache(config *CacheConfig) *responseCache {
+	cache := &responseCache{backend: config.Backend, ttls: config.TTLs, now: time.Now}
+	if cache.backend == nil {
+		cache.backend = NewMemoryCache(1000)
+	}
+	if cache.ttls == nil {
+		cache.ttls = DefaultCacheTTLs
+	}
+	return cache
+}
+
+// ttl isteğin önbelleğe alınıp alınmayacağını ve süresini döndürür
+func (rc *responseCache) ttl(method, resource string) (time.Duration, bool) {
+	if rc == nil || method != http.MethodGet {
+		return 0, false
+	}
+	ttl, ok := rc.ttls[resource]
+	return ttl, ok && ttl > 0
+}
+
+// cacheResource URL'in ait olduğu kaynağı döndürür: firma numarasından
+// sonraki ilk path parçası, firma kapsamı dışındaki adresler için ilk parça
+func (c *Client) cacheResource(url string) string {
+	path := strings.TrimPrefix(url, c.baseURL)
+	path, _, _ = strings.Cut(path, "?")
+	path = strings.TrimPrefix(path, "/")
+	if rest, ok := strings.CutPrefix(path, strconv.Itoa(c.companyID)+"/"); ok {
+		path = rest
+	}
+	resource, _, _ := strings.Cut(path, "/")
+	return resource
+}
+
+// invalidatedResources değiştirici isteğin geçersiz kıldığı kaynakları döndürür.
+// Ödeme ve hesap hareketleri hesap bakiyelerini değiştirdiğinden accounts da
+// geçersiz kılınır.
+func (c *Client) invalidatedResources(url string) []string {
+	resources := []string{c.cacheResource(url)}
+	if strings.Contains(url, "/payments") || strings.Contains(url, "/transactions") {
+		resources = append(resources, "accounts")
+	}
+	return resources
+}
+
+// InvalidateCache verilen kaynakların önbellekteki yanıtlarını siler.
+// Kaynak verilmezse önbellekteki tüm kaynaklar silinir.
+func (c *Client) InvalidateCache(resources ...string) {
+	if c.cache == nil {
+		return
+	}
+	if len(resources) == 0 {
+		for resource := range c.cache.ttls {
+			resources = append(resources, resource)
+		}
+	}
+	for _, resource := range resources {
+		c.cache.backend.DeleteResource(resource)
+	}
+}
+
+// cachedResponse önbellekteki gövdeden HTTP yanıtı oluşturur
+func cachedResponse(req *http.Request, entry *CacheEntry) *http.Response {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	if entry.ETag != "" {
+		header.Set("ETag", entry.ETag)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}
+}
+
+// doCached önbelleğe alınabilen GET isteğini yapar. Süresi dolmamış kayıt
+// doğrudan döndürülür; süresi dolmuş ve ETag'i olan kayıt If-None-Match ile
+// doğrulanır, 304 yanıtında süresi uzatılır.
+func (c *Client) doCached(req *http.Request, resource string, ttl time.Duration) (*http.Response, error) {
+	key := req.URL.String()
+	entry, ok := c.cache.backend.Get(key)
+	if ok && c.cache.now().Before(entry.ExpiresAt) {
+		return cachedResponse(req, entry), nil
+	}
+	if ok && entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && ok {
+		resp.Body.Close()
+		refreshed := *entry
+		refreshed.ExpiresAt = c.cache.now().Add(ttl)
+		c.cache.backend.Set(key, &refreshed)
+		return cachedResponse(req, &refreshed), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	c.cache.backend.Set(key, &CacheEntry{
+		Resource:  resource,
+		Body:      body,
+		ETag:      resp.Header.Get("ETag"),
+		ExpiresAt: c.cache.now().Add(ttl),
+	})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
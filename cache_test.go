@@ -0,0 +1,175 @@
+package parasut
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CacheEntry{Resource: "tags"})
+	cache.Set("b", &CacheEntry{Resource: "taxes"})
+	cache.Get("a")
+	cache.Set("c", &CacheEntry{Resource: "tags"})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("En az kullanılan kayıt (b) silinmeliydi")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("a önbellekte olmalı")
+	}
+
+	cache.DeleteResource("tags")
+	if cache.Len() != 0 {
+		t.Errorf("Len = %d, beklenen 0", cache.Len())
+	}
+}
+
+// cacheServer istekleri sayan ve ETag destekleyen test sunucusu
+type cacheServer struct {
+	mu       sync.Mutex
+	requests []string
+	etag     string
+}
+
+func (s *cacheServer) count(prefix string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := 0
+	for _, r := range s.requests {
+		if strings.HasPrefix(r, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func newCacheTestClient(t *testing.T, config *CacheConfig) (*Client, *cacheServer) {
+	t.Helper()
+	state := &cacheServer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state.mu.Lock()
+		defer state.mu.Unlock()
+
+		state.requests = append(state.requests, r.Method+" "+r.URL.Path)
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "1", "type": "x", "attributes": {}}}`))
+			return
+		}
+		if state.etag != "" {
+			if r.Header.Get("If-None-Match") == state.etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", state.etag)
+		}
+		if r.URL.Path == "/me" {
+			w.Write([]byte(`{"data": {"id": "1", "type": "users", "attributes": {"name": "Ali"}}}`))
+			return
+		}
+		w.Write([]byte(`{"data": [{"id": "1", "type": "tags", "attributes": {"name": "a"}}], "meta": {"current_page": 1, "total_pages": 1, "total_count": 1}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(&Config{CompanyID: 123, BaseURL: server.URL, Cache: config}), state
+}
+
+func TestClient_Cache(t *testing.T) {
+	client, server := newCacheTestClient(t, &CacheConfig{})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		tags, _, err := client.Tags.List(ctx, nil)
+		if err != nil || len(tags) != 1 || tags[0].Attributes.Name != "a" {
+			t.Fatalf("Tags.List = %+v, %v", tags, err)
+		}
+		if _, _, err := client.Contacts.List(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Me.Get(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := server.count("GET /123/tags"); n != 1 {
+		t.Errorf("Etiket isteği sayısı = %d, beklenen 1", n)
+	}
+	if n := server.count("GET /123/contacts"); n != 3 {
+		t.Errorf("Önbelleğe alınmayan kaynak isteği sayısı = %d, beklenen 3", n)
+	}
+	if n := server.count("GET /me"); n != 1 {
+		t.Errorf("/me isteği sayısı = %d, beklenen 1", n)
+	}
+
+	// Farklı parametreler ayrı kayıt olarak saklanır
+	client.Tags.List(ctx, &ListParams{Page: 2})
+	if n := server.count("GET /123/tags"); n != 2 {
+		t.Errorf("Etiket isteği sayısı = %d, beklenen 2", n)
+	}
+
+	// Aynı istemciyle yapılan değişiklik önbelleği geçersiz kılar
+	if _, err := client.Tags.Create(ctx, TagInput{Name: "b"}); err != nil {
+		t.Fatal(err)
+	}
+	client.Tags.List(ctx, nil)
+	if n := server.count("GET /123/tags"); n != 3 {
+		t.Errorf("Geçersiz kılma sonrası etiket isteği sayısı = %d, beklenen 3", n)
+	}
+	if n := server.count("GET /me"); n != 1 {
+		t.Error("Başka kaynakların önbelleği silinmemeli")
+	}
+}
+
+func TestClient_CacheRevalidation(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	client, server := newCacheTestClient(t, &CacheConfig{TTLs: map[string]time.Duration{"taxes": time.Minute}})
+	client.cache.now = func() time.Time { return now }
+	server.etag = `"v1"`
+	ctx := context.Background()
+
+	client.Taxes.List(ctx, nil)
+	now = now.Add(2 * time.Minute)
+	taxes, _, err := client.Taxes.List(ctx, nil)
+	if err != nil || len(taxes) != 1 {
+		t.Fatalf("304 sonrası Taxes.List = %+v, %v", taxes, err)
+	}
+	if n := server.count("GET /123/taxes"); n != 2 {
+		t.Errorf("İstek sayısı = %d, beklenen 2", n)
+	}
+
+	// 304 yanıtı kaydın süresini uzatır
+	now = now.Add(30 * time.Second)
+	client.Taxes.List(ctx, nil)
+	if n := server.count("GET /123/taxes"); n != 2 {
+		t.Errorf("İstek sayısı = %d, beklenen 2", n)
+	}
+}
+
+func TestClient_CacheInvalidation(t *testing.T) {
+	client, server := newCacheTestClient(t, &CacheConfig{})
+	ctx := context.Background()
+
+	client.Accounts.List(ctx, nil)
+	if _, err := client.SalesInvoices.CreatePayment(ctx, "5", PaymentInput{AccountID: "9", Date: "2024-05-01", Amount: 10, ExchangeRate: 1}); err != nil {
+		t.Fatal(err)
+	}
+	client.Accounts.List(ctx, nil)
+	if n := server.count("GET /123/accounts"); n != 2 {
+		t.Errorf("Ödeme sonrası hesap isteği sayısı = %d, beklenen 2", n)
+	}
+
+	client.InvalidateCache("accounts")
+	client.Accounts.List(ctx, nil)
+	client.InvalidateCache()
+	client.Accounts.List(ctx, nil)
+	if n := server.count("GET /123/accounts"); n != 4 {
+		t.Errorf("InvalidateCache sonrası hesap isteği sayısı = %d, beklenen 4", n)
+	}
+}
@@ -0,0 +1,243 @@
+// Package changefeed Paraşüt servisleri için updated_at imleçli değişiklik
+// akışı sunar. Her kaynak için son görülen UpdatedAt değeri bir CursorStore'da
+// saklanır; sonraki yoklamada yalnızca bu değerden sonra değişen kayıtlar
+// okunur ve eskiden yeniye oluşturma/güncelleme olayları olarak bildirilir:
+//
+//	store, _ := changefeed.NewFileStore("imlecler")
+//	feed := changefeed.New("sales_invoices", client.SalesInvoices.List, store)
+//	err := feed.Watch(ctx, time.Minute, func(ctx context.Context, e changefeed.Event[parasut.SalesInvoice]) error {
+//		log.Println(e.Type, e.ID, e.UpdatedAt)
+//		return nil
+//	})
+//
+// Sunucu ile istemci arasındaki saat farkı ve aynı zaman damgasını taşıyan
+// kayıtlar için imleçten Skew kadar geriden okunur; bu pencerede daha önce
+// bildirilmiş kayıtlar id ve UpdatedAt ile ayıklanır, böylece her değişiklik
+// bir kez bildirilir.
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// EventType olay türü
+type EventType string
+
+const (
+	EventCreate EventType = "create"
+	EventUpdate EventType = "update"
+)
+
+// Event akıştaki tek değişiklik
+type Event[T any] struct {
+	Type      EventType
+	Resource  string
+	ID        string
+	UpdatedAt time.Time
+	Item      T
+}
+
+// Cursor kaynağın okunduğu son nokta
+type Cursor struct {
+	// UpdatedAt bildirilen kayıtlardaki en yeni UpdatedAt
+	UpdatedAt time.Time `json:"updated_at"`
+	// Seen Skew penceresinde bildirilmiş kayıtların id → UpdatedAt eşlemesi
+	Seen map[string]time.Time `json:"seen,omitempty"`
+}
+
+// Feed tek bir kaynağın değişiklik akışı
+type Feed[T any] struct {
+	// Name imlecin saklandığı ve olaylarda bildirilen kaynak adı
+	Name string
+	// List kaynağın servis List metodu (ör. client.Contacts.List)
+	List func(context.Context, *parasut.ListParams) ([]T, *parasut.Meta, error)
+	// Store imleçlerin saklandığı yer
+	Store CursorStore
+
+	// Skew saat farkı ve eş zamanlı kayıtlar için imleçten geriye okunan süre; 0 ise 1 dakika
+	Skew time.Duration
+	// Since imleç yokken başlangıç noktası; sıfırsa tüm kayıtlar oluşturma olayı olarak bildirilir
+	Since time.Time
+	// Filter listelemeye eklenen ek filtreler (ör. item_type=invoice)
+	Filter map[string]string
+	// PageSize sayfa başına kayıt sayısı; 0 ise API varsayılanı
+	PageSize int
+}
+
+// New verilen servis List metodu için bir akış oluşturur
+func New[T any](name string, list func(context.Context, *parasut.ListParams) ([]T, *parasut.Meta, error), store CursorStore) *Feed[T] {
+	return &Feed[T]{Name: name, List: list, Store: store}
+}
+
+// errStop imlece ulaşıldığında sayfalamayı durdurur
+var errStop = errors.New("imlece ulaşıldı")
+
+// change okunan ve bildirilecek kayıt
+type change[T any] struct {
+	item      T
+	id        string
+	createdAt *time.Time
+	updatedAt time.Time
+}
+
+// Poll imleçten sonra değişen kayıtları okur ve handler'a eskiden yeniye
+// bildirir. Bildirilen olay sayısını döndürür. handler hata döndürürse o
+// ana kadar başarıyla bildirilen olaylar için imleç kaydedilir ve hata
+// döndürülür; hatalı olay sonraki yoklamada tekrar bildirilir.
+func (f *Feed[T]) Poll(ctx context.Context, handler func(context.Context, Event[T]) error) (int, error) {
+	cursor, err := f.Store.Load(ctx, f.Name)
+	if err != nil {
+		return 0, fmt.Errorf("%s imleci okunamadı: %w", f.Name, err)
+	}
+	if cursor == nil {
+		cursor = &Cursor{UpdatedAt: f.Since}
+	}
+	if cursor.Seen == nil {
+		cursor.Seen = map[string]time.Time{}
+	}
+
+	skew := f.Skew
+	if skew == 0 {
+		skew = time.Minute
+	}
+	var since time.Time
+	if !cursor.UpdatedAt.IsZero() {
+		since = cursor.UpdatedAt.Add(-skew)
+	}
+
+	changes, err := f.fetch(ctx, since)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, c := range changes {
+		previous, seen := cursor.Seen[c.id]
+		if seen && !c.updatedAt.After(previous) {
+			continue
+		}
+
+		event := Event[T]{Type: EventUpdate, Resource: f.Name, ID: c.id, UpdatedAt: c.updatedAt, Item: c.item}
+		if !seen && (cursor.UpdatedAt.IsZero() || (c.createdAt != nil && !c.createdAt.Before(since))) {
+			event.Type = EventCreate
+		}
+
+		if err := handler(ctx, event); err != nil {
+			if saveErr := f.save(ctx, cursor, skew); saveErr != nil {
+				return count, errors.Join(err, saveErr)
+			}
+			return count, err
+		}
+
+		if c.updatedAt.After(cursor.UpdatedAt) {
+			cursor.UpdatedAt = c.updatedAt
+		}
+		cursor.Seen[c.id] = c.updatedAt
+		count++
+	}
+
+	return count, f.save(ctx, cursor, skew)
+}
+
+// Watch ctx iptal edilene kadar interval aralıklarla Poll çağırır. Yoklama
+// veya handler hatasında durur ve hatayı döndürür.
+func (f *Feed[T]) Watch(ctx context.Context, interval time.Duration, handler func(context.Context, Event[T]) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := f.Poll(ctx, handler); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetch since'ten sonra değişen kayıtları yeniden eskiye sıralı okur ve
+// eskiden yeniye sıralı döndürür
+func (f *Feed[T]) fetch(ctx context.Context, since time.Time) ([]change[T], error) {
+	var changes []change[T]
+	params := &parasut.ListParams{Sort: "-updated_at", Filter: f.Filter, PageSize: f.PageSize}
+
+	err := parasut.EachPage(ctx, f.List, params, func(items []T, meta *parasut.Meta) error {
+		for _, item := range items {
+			id, createdAt, updatedAt, err := timestamps(item)
+			if err != nil {
+				return err
+			}
+			if updatedAt == nil {
+				continue
+			}
+			if updatedAt.Before(since) {
+				return errStop
+			}
+			changes = append(changes, change[T]{item: item, id: id, createdAt: createdAt, updatedAt: *updatedAt})
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStop) {
+		return nil, fmt.Errorf("%s okunamadı: %w", f.Name, err)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if !changes[i].updatedAt.Equal(changes[j].updatedAt) {
+			return changes[i].updatedAt.Before(changes[j].updatedAt)
+		}
+		return changes[i].id < changes[j].id
+	})
+	return changes, nil
+}
+
+// save penceresi dışına çıkan görülen kayıtları ayıklayarak imleci kaydeder
+func (f *Feed[T]) save(ctx context.Context, cursor *Cursor, skew time.Duration) error {
+	window := cursor.UpdatedAt.Add(-skew)
+	for id, updatedAt := range cursor.Seen {
+		if updatedAt.Before(window) {
+			delete(cursor.Seen, id)
+		}
+	}
+	if err := f.Store.Save(ctx, f.Name, cursor); err != nil {
+		return fmt.Errorf("%s imleci kaydedilemedi: %w", f.Name, err)
+	}
+	return nil
+}
+
+// timestamps modelin ID alanını ve Attributes içindeki CreatedAt/UpdatedAt
+// alanlarını okur
+func timestamps(item interface{}) (string, *time.Time, *time.Time, error) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return "", nil, nil, fmt.Errorf("%T bir model değil", item)
+	}
+
+	id := v.FieldByName("ID")
+	attributes := v.FieldByName("Attributes")
+	if !id.IsValid() || id.Kind() != reflect.String || !attributes.IsValid() || attributes.Kind() != reflect.Struct {
+		return "", nil, nil, fmt.Errorf("%T ID ve Attributes alanları içermiyor", item)
+	}
+
+	field := attributes.FieldByName("UpdatedAt")
+	if !field.IsValid() {
+		return "", nil, nil, fmt.Errorf("%T UpdatedAt alanı içermiyor", item)
+	}
+	updated, ok := field.Interface().(*time.Time)
+	if !ok {
+		return "", nil, nil, fmt.Errorf("%T UpdatedAt alanı *time.Time değil", item)
+	}
+	var created *time.Time
+	if field := attributes.FieldByName("CreatedAt"); field.IsValid() {
+		created, _ = field.Interface().(*time.Time)
+	}
+	return id.String(), created, updated, nil
+}
@@ -0,0 +1,180 @@
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/parasuttest"
+)
+
+// clock sahte sunucunun zamanını elle ilerletmek için kullanılır
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+// collect olayları kaydeden handler döndürür
+func collect(events *[]Event[parasut.Contact]) func(context.Context, Event[parasut.Contact]) error {
+	return func(ctx context.Context, e Event[parasut.Contact]) error {
+		*events = append(*events, e)
+		return nil
+	}
+}
+
+func TestFeed_Poll(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	c := &clock{now: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	server.Now = c.Now
+
+	first := server.Seed("contacts", map[string]interface{}{"name": "Alfa"}, nil)
+	server.Seed("contacts", map[string]interface{}{"name": "Beta"}, nil)
+	server.Seed("contacts", map[string]interface{}{"name": "Gama"}, nil)
+
+	store := NewMemoryStore()
+	feed := New("contacts", client.Contacts.List, store)
+	feed.PageSize = 2
+	ctx := context.Background()
+
+	var events []Event[parasut.Contact]
+	if n, err := feed.Poll(ctx, collect(&events)); err != nil || n != 3 {
+		t.Fatalf("İlk Poll = %d, %v", n, err)
+	}
+	for _, e := range events {
+		if e.Type != EventCreate || e.Resource != "contacts" || e.Item.ID != e.ID {
+			t.Errorf("Olay = %+v", e)
+		}
+	}
+
+	events = nil
+	if n, err := feed.Poll(ctx, collect(&events)); err != nil || n != 0 {
+		t.Fatalf("Değişiklik yokken Poll = %d, %v (%+v)", n, err, events)
+	}
+
+	// Skew penceresi içinde güncelleme ve aynı saniyede yeni kayıt
+	c.now = c.now.Add(30 * time.Second)
+	if _, err := client.Contacts.Update(ctx, first, parasut.ContactInput{Name: "Alfa 2"}, nil); err != nil {
+		t.Fatalf("Update hata döndü: %v", err)
+	}
+	added := server.Seed("contacts", map[string]interface{}{"name": "Delta"}, nil)
+
+	events = nil
+	if _, err := feed.Poll(ctx, collect(&events)); err != nil {
+		t.Fatalf("Poll hata döndü: %v", err)
+	}
+	if len(events) != 2 || events[0].ID != first || events[0].Type != EventUpdate || events[1].ID != added || events[1].Type != EventCreate {
+		t.Fatalf("Olaylar = %+v", events)
+	}
+
+	// İmleçle aynı zaman damgasını taşıyan kayıt kaçırılmamalı
+	tie := server.Seed("contacts", map[string]interface{}{"name": "Epsilon"}, nil)
+	events = nil
+	if _, err := feed.Poll(ctx, collect(&events)); err != nil {
+		t.Fatalf("Poll hata döndü: %v", err)
+	}
+	if len(events) != 1 || events[0].ID != tie || events[0].Type != EventCreate {
+		t.Errorf("Eş zamanlı kayıt olayları = %+v", events)
+	}
+
+	cursor, _ := store.Load(ctx, "contacts")
+	if !cursor.UpdatedAt.Equal(c.now) || len(cursor.Seen) != 5 {
+		t.Errorf("İmleç = %+v", cursor)
+	}
+}
+
+func TestFeed_PollStopsAtCursor(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	c := &clock{now: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	server.Now = c.Now
+	for i := 0; i < 6; i++ {
+		server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01"}, nil)
+	}
+	server.Seed("sales_invoices", map[string]interface{}{"item_type": "estimate", "issue_date": "2024-05-01"}, nil)
+
+	c.now = c.now.Add(time.Hour)
+	recent := server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01"}, nil)
+
+	feed := New("sales_invoices", client.SalesInvoices.List, NewMemoryStore())
+	feed.Since = c.now.Add(-10 * time.Minute)
+	feed.Filter = map[string]string{"item_type": "invoice"}
+	feed.PageSize = 2
+
+	before := len(server.Requests())
+	var ids []string
+	_, err := feed.Poll(context.Background(), func(ctx context.Context, e Event[parasut.SalesInvoice]) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Poll hata döndü: %v", err)
+	}
+
+	if len(ids) != 1 || ids[0] != recent {
+		t.Errorf("Olaylar = %v, beklenen [%s]", ids, recent)
+	}
+	requests := server.Requests()[before:]
+	if len(requests) != 1 || requests[0].Query.Get("sort") != "-updated_at" || requests[0].Query.Get("filter[item_type]") != "invoice" {
+		t.Errorf("İstekler = %+v", requests)
+	}
+}
+
+func TestFeed_HandlerError(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	c := &clock{now: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	server.Now = c.Now
+	server.Seed("contacts", map[string]interface{}{"name": "Alfa"}, nil)
+	c.now = c.now.Add(time.Hour)
+	failing := server.Seed("contacts", map[string]interface{}{"name": "Beta"}, nil)
+
+	store := NewMemoryStore()
+	feed := New("contacts", client.Contacts.List, store)
+	errHandler := errors.New("kuyruk dolu")
+
+	n, err := feed.Poll(context.Background(), func(ctx context.Context, e Event[parasut.Contact]) error {
+		if e.ID == failing {
+			return errHandler
+		}
+		return nil
+	})
+	if !errors.Is(err, errHandler) || n != 1 {
+		t.Fatalf("Poll = %d, %v", n, err)
+	}
+
+	var events []Event[parasut.Contact]
+	if _, err := feed.Poll(context.Background(), collect(&events)); err != nil {
+		t.Fatalf("Poll hata döndü: %v", err)
+	}
+	if len(events) != 1 || events[0].ID != failing {
+		t.Errorf("Hatalı olay tekrar bildirilmeli: %+v", events)
+	}
+}
+
+func TestFeed_Watch(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	server.Seed("contacts", map[string]interface{}{"name": "Alfa"}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	feed := New("contacts", client.Contacts.List, NewMemoryStore())
+	err := feed.Watch(ctx, time.Millisecond, func(ctx context.Context, e Event[parasut.Contact]) error {
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Watch = %v, beklenen context.Canceled", err)
+	}
+}
+
+func TestTimestamps(t *testing.T) {
+	now := time.Now()
+	id, created, updated, err := timestamps(parasut.Product{ID: "5", Attributes: parasut.ProductAttributes{CreatedAt: &now, UpdatedAt: &now}})
+	if err != nil || id != "5" || created != &now || updated != &now {
+		t.Errorf("timestamps = %s, %v, %v, %v", id, created, updated, err)
+	}
+
+	if _, _, _, err := timestamps(struct{ ID string }{ID: "1"}); err == nil {
+		t.Error("Attributes içermeyen tip hata döndürmeli")
+	}
+}
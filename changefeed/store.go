@@ -0,0 +1,107 @@
+package changefeed
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// CursorStore kaynak imleçlerini saklar. Veritabanı, Redis vb. için kendi
+// uygulamanızı yazabilirsiniz.
+type CursorStore interface {
+	// Load imleci döndürür; imleç yoksa nil, nil döner
+	Load(ctx context.Context, name string) (*Cursor, error)
+	Save(ctx context.Context, name string, cursor *Cursor) error
+}
+
+// MemoryStore imleçleri bellekte tutar; testler ve tek süreçlik işler içindir
+type MemoryStore struct {
+	mu      sync.Mutex
+	cursors map[string]Cursor
+}
+
+// NewMemoryStore boş bir MemoryStore oluşturur
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{cursors: map[string]Cursor{}}
+}
+
+func (s *MemoryStore) Load(ctx context.Context, name string) (*Cursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cursor, ok := s.cursors[name]
+	if !ok {
+		return nil, nil
+	}
+	return copyCursor(cursor), nil
+}
+
+func (s *MemoryStore) Save(ctx context.Context, name string, cursor *Cursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cursors[name] = *copyCursor(*cursor)
+	return nil
+}
+
+// FileStore her imleci dizinde <ad>.json dosyası olarak saklar
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore dizini oluşturur ve FileStore döndürür
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileStore{Dir: dir}, nil
+}
+
+func (s *FileStore) Load(ctx context.Context, name string) (*Cursor, error) {
+	data, err := os.ReadFile(s.path(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+// Save imleci geçici dosya üzerinden atomik olarak yazar
+func (s *FileStore) Save(ctx context.Context, name string, cursor *Cursor) error {
+	data, err := json.MarshalIndent(cursor, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path(name) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path(name))
+}
+
+func (s *FileStore) path(name string) string {
+	return filepath.Join(s.Dir, name+".json")
+}
+
+func copyCursor(cursor Cursor) *Cursor {
+	copied := Cursor{UpdatedAt: cursor.UpdatedAt, Seen: make(map[string]time.Time, len(cursor.Seen))}
+	for id, updatedAt := range cursor.Seen {
+		copied.Seen[id] = updatedAt
+	}
+	return &copied
+}
+
+var (
+	_ CursorStore = (*MemoryStore)(nil)
+	_ CursorStore = (*FileStore)(nil)
+)
@@ -0,0 +1,37 @@
+package changefeed
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStores(t *testing.T) {
+	fileStore, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore hata döndü: %v", err)
+	}
+
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	for name, store := range map[string]CursorStore{"memory": NewMemoryStore(), "file": fileStore} {
+		t.Run(name, func(t *testing.T) {
+			cursor, err := store.Load(ctx, "contacts")
+			if err != nil || cursor != nil {
+				t.Fatalf("Boş Load = %+v, %v", cursor, err)
+			}
+
+			saved := &Cursor{UpdatedAt: now, Seen: map[string]time.Time{"1": now}}
+			if err := store.Save(ctx, "contacts", saved); err != nil {
+				t.Fatalf("Save hata döndü: %v", err)
+			}
+			saved.Seen["2"] = now
+
+			cursor, err = store.Load(ctx, "contacts")
+			if err != nil || !cursor.UpdatedAt.Equal(now) || len(cursor.Seen) != 1 || !cursor.Seen["1"].Equal(now) {
+				t.Errorf("Load = %+v, %v", cursor, err)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -26,10 +28,17 @@ const (
 // Client Parasüt API istemcisi
 type Client struct {
 	httpClient *http.Client
+	transport  http.RoundTripper
 	baseURL    string
 	companyID  int
 	config     *oauth2.Config
 	token      *oauth2.Token
+	tokenMu    sync.Mutex
+	cache      *responseCache
+	flights    *flightGroup
+
+	instrumentation Instrumentation
+	exchangeRates   ExchangeRateProvider
 
 	// Services
 	Me                *MeService
@@ -42,9 +51,11 @@ type Client struct {
 	ESMMs             *ESMMsService
 	Employees         *EmployeesService
 	ItemCategories    *ItemCategoriesService
+	Payments          *PaymentsService
 	Products          *ProductsService
 	PurchaseBills     *PurchaseBillsService
 	Salaries          *SalariesService
+	RecurrencePlans   *RecurrencePlansService
 	SalesInvoices     *SalesInvoicesService
 	SalesOffers       *SalesOffersService
 	Sharings          *SharingsService
@@ -65,6 +76,33 @@ type Config struct {
 	ClientSecret string
 	RedirectURL  string
 	CompanyID    int
+
+	// BaseURL, AuthURL ve TokenURL boş bırakılırsa varsayılan API adresleri kullanılır
+	BaseURL  string
+	AuthURL  string
+	TokenURL string
+
+	// Transport tüm isteklerin (token istekleri dahil) geçtiği alt katman.
+	// Boşsa http.DefaultTransport kullanılır.
+	Transport http.RoundTripper
+
+	// Cache verilirse referans verilerinin GET yanıtları önbelleğe alınır;
+	// aynı istemciyle yapılan Create/Update/Delete istekleri ilgili kaynağın
+	// önbelleğini geçersiz kılar.
+	Cache *CacheConfig
+
+	// DeduplicateRequests true ise aynı URL'e eşzamanlı yapılan GET istekleri
+	// tek isteğe indirilir; tüm çağıranlar aynı yanıtı alır.
+	DeduplicateRequests bool
+
+	// Instrumentation verilirse her API ve token isteği için çağrılır
+	// (OpenTelemetry span'leri, metrikler vb.)
+	Instrumentation Instrumentation
+
+	// ExchangeRates verilirse satış faturası, alış faturası, banka ücreti ve
+	// ödeme oluşturulurken dövizli belgede boş bırakılan ExchangeRate belge
+	// tarihinin kuruyla doldurulur (ör. &parasut.TCMBProvider{})
+	ExchangeRates ExchangeRateProvider
 }
 
 // NewClient yeni bir Parasüt istemcisi oluşturur
@@ -78,12 +116,32 @@ func NewClient(config *Config) *Client {
 			TokenURL: TokenURL,
 		},
 	}
+	if config.AuthURL != "" {
+		oauth2Config.Endpoint.AuthURL = config.AuthURL
+	}
+	if config.TokenURL != "" {
+		oauth2Config.Endpoint.TokenURL = config.TokenURL
+	}
+
+	baseURL := BaseURL
+	if config.BaseURL != "" {
+		baseURL = strings.TrimSuffix(config.BaseURL, "/")
+	}
 
 	client := &Client{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
-		baseURL:    BaseURL,
-		companyID:  config.CompanyID,
-		config:     oauth2Config,
+		transport:       config.Transport,
+		baseURL:         baseURL,
+		companyID:       config.CompanyID,
+		config:          oauth2Config,
+		instrumentation: config.Instrumentation,
+		exchangeRates:   config.ExchangeRates,
+	}
+	client.httpClient = client.baseHTTPClient()
+	if config.Cache != nil {
+		client.cache = newResponseCache(config.Cache)
+	}
+	if config.DeduplicateRequests {
+		client.flights = &flightGroup{}
 	}
 
 	// Initialize services
@@ -97,9 +155,11 @@ func NewClient(config *Config) *Client {
 	client.ESMMs = &ESMMsService{client: client}
 	client.Employees = &EmployeesService{client: client}
 	client.ItemCategories = &ItemCategoriesService{client: client}
+	client.Payments = &PaymentsService{client: client}
 	client.Products = &ProductsService{client: client}
 	client.PurchaseBills = &PurchaseBillsService{client: client}
 	client.Salaries = &SalariesService{client: client}
+	client.RecurrencePlans = &RecurrencePlansService{client: client}
 	client.SalesInvoices = &SalesInvoicesService{client: client}
 	client.SalesOffers = &SalesOffersService{client: client}
 	client.Sharings = &SharingsService{client: client}
@@ -123,12 +183,12 @@ func (c *Client) AuthorizeURL(state string) string {
 
 // SetTokenFromCode yetkilendirme kodundan token alır
 func (c *Client) SetTokenFromCode(ctx context.Context, code string) error {
+	ctx = c.oauthContext(ctx)
 	token, err := c.config.Exchange(ctx, code)
 	if err != nil {
 		return err
 	}
-	c.token = token
-	c.httpClient = c.config.Client(ctx, token)
+	c.setToken(ctx, token)
 	return nil
 }
 
@@ -142,36 +202,97 @@ func (c *Client) SetTokenFromPassword(ctx context.Context, email, password strin
 	data.Set("password", password)
 	data.Set("redirect_uri", c.config.RedirectURL)
 
-	resp, err := http.PostForm(TokenURL, data)
+	resp, err := c.baseHTTPClient().PostForm(c.config.Endpoint.TokenURL, data)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= 400 {
+		return parseErrorResponse(resp)
+	}
+
 	var token oauth2.Token
 	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
 		return err
 	}
 
-	c.token = &token
-	c.httpClient = c.config.Client(ctx, &token)
+	c.setToken(c.oauthContext(ctx), &token)
 	return nil
 }
 
 // SetToken mevcut token'ı ayarlar
 func (c *Client) SetToken(token *oauth2.Token) {
+	c.setToken(c.oauthContext(context.Background()), token)
+}
+
+// setToken token'ı ayarlar; yenilenen token'lar GetToken ile okunabilsin diye
+// token kaynağı izlenir
+func (c *Client) setToken(ctx context.Context, token *oauth2.Token) {
+	c.tokenMu.Lock()
 	c.token = token
-	c.httpClient = c.config.Client(context.Background(), token)
+	c.tokenMu.Unlock()
+	source := &trackingTokenSource{base: c.config.TokenSource(ctx, token), client: c}
+	c.httpClient = oauth2.NewClient(ctx, source)
 }
 
-// GetToken mevcut token'ı döndürür
+// trackingTokenSource isteklerde kullanılan son token'ı istemciye yazar
+type trackingTokenSource struct {
+	base   oauth2.TokenSource
+	client *Client
+}
+
+// Token gerekirse token'ı yeniler ve istemcinin token'ını günceller
+func (s *trackingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.base.Token()
+	if err != nil {
+		return nil, err
+	}
+	s.client.tokenMu.Lock()
+	s.client.token = token
+	s.client.tokenMu.Unlock()
+	return token, nil
+}
+
+// baseHTTPClient token eklemeyen, Config.Transport'u kullanan HTTP istemcisi döndürür
+func (c *Client) baseHTTPClient() *http.Client {
+	transport := c.transport
+	if c.instrumentation != nil {
+		transport = &tokenTransport{base: c.transport, client: c}
+	}
+	return &http.Client{Timeout: 30 * time.Second, Transport: transport}
+}
+
+// oauthContext oauth2 paketinin token ve API isteklerinde Config.Transport'u kullanmasını sağlar
+func (c *Client) oauthContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, c.baseHTTPClient())
+}
+
+// GetToken mevcut token'ı döndürür; süresi dolan token bir istekte
+// yenilendiyse yenilenmiş token döner
 func (c *Client) GetToken() *oauth2.Token {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 	return c.token
 }
 
-// makeRequest HTTP isteği yapar
+// GetCompanyID istemcinin çalıştığı firma numarasını döndürür
+func (c *Client) GetCompanyID() int {
+	return c.companyID
+}
+
+// makeRequest firma kapsamındaki bir path'e HTTP isteği yapar
 func (c *Client) makeRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
-	url := fmt.Sprintf("%s/%d%s", c.baseURL, c.companyID, path)
+	return c.do(ctx, method, fmt.Sprintf("%s/%d%s", c.baseURL, c.companyID, path), body)
+}
+
+// do verilen URL'e HTTP isteği yapar. 4xx/5xx yanıtlar *ErrorResponse olarak döner.
+func (c *Client) do(ctx context.Context, method, url string, body interface{}) (resp *http.Response, err error) {
+	if c.instrumentation != nil {
+		var end func(*http.Response, error)
+		ctx, end = c.startRequest(ctx, c.requestInfo(method, url))
+		defer func() { end(resp, err) }()
+	}
 
 	var reqBody io.Reader
 	if body != nil {
@@ -189,8 +310,43 @@ func (c *Client) makeRequest(ctx context.Context, method, path string, body inte
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	c.injectHeaders(ctx, req.Header)
+
+	if c.flights != nil && method == http.MethodGet {
+		return c.flights.do(ctx, url, func() (*http.Response, error) {
+			return c.send(req, method, url)
+		})
+	}
+	return c.send(req, method, url)
+}
+
+// send isteği önbellek üzerinden veya doğrudan gönderir
+func (c *Client) send(req *http.Request, method, url string) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+	resource := ""
+	if c.cache != nil {
+		resource = c.cacheResource(url)
+	}
+	if ttl, ok := c.cache.ttl(method, resource); ok {
+		resp, err = c.doCached(req, resource, ttl)
+	} else {
+		resp, err = c.httpClient.Do(req)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	return c.httpClient.Do(req)
+	if c.cache != nil && method != http.MethodGet && resp.StatusCode < 400 {
+		c.InvalidateCache(c.invalidatedResources(url)...)
+	}
+
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		return nil, parseErrorResponse(resp)
+	}
+
+	return resp, nil
 }
 
 // get GET isteği yapar
@@ -231,6 +387,7 @@ type ListParams struct {
 	PageSize int               `json:"page_size,omitempty"`
 	Sort     string            `json:"sort,omitempty"`
 	Filter   map[string]string `json:"filter,omitempty"`
+	Include  string            `json:"include,omitempty"`
 }
 
 // ToMap ListParams'ı map'e çevirir
@@ -251,6 +408,9 @@ func (lp *ListParams) ToMap() map[string]string {
 			params[fmt.Sprintf("filter[%s]", k)] = v
 		}
 	}
+	if lp.Include != "" {
+		params["include"] = lp.Include
+	}
 
 	return params
 }
@@ -276,7 +436,21 @@ type Error struct {
 
 // ErrorResponse hata yanıt yapısı
 type ErrorResponse struct {
-	Errors []Error `json:"errors"`
+	StatusCode int     `json:"-"`
+	Errors     []Error `json:"errors"`
+}
+
+// parseErrorResponse hatalı HTTP yanıtını ErrorResponse'a çevirir
+func parseErrorResponse(resp *http.Response) error {
+	errResp := &ErrorResponse{StatusCode: resp.StatusCode}
+	data, err := io.ReadAll(resp.Body)
+	if err == nil && len(data) > 0 {
+		json.Unmarshal(data, errResp)
+	}
+	if len(errResp.Errors) == 0 {
+		errResp.Errors = []Error{{Title: resp.Status}}
+	}
+	return errResp
 }
 
 func (e *ErrorResponse) Error() string {
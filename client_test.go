@@ -3,6 +3,7 @@ package parasut
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -508,3 +509,47 @@ func TestClient_HTTPMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestNewClient_BaseURL(t *testing.T) {
+	client := NewClient(&Config{
+		CompanyID: 123,
+		BaseURL:   "http://localhost:8080/v4/",
+	})
+
+	if client.baseURL != "http://localhost:8080/v4" {
+		t.Errorf("baseURL = %s, beklenen http://localhost:8080/v4", client.baseURL)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClient_Transport(t *testing.T) {
+	var authorizations []string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data": [], "meta": {"current_page": 1, "total_pages": 1, "total_count": 0}}`)),
+			Request:    req,
+		}, nil
+	})
+
+	client := NewClient(&Config{CompanyID: 123, Transport: transport})
+	if _, _, err := client.Tags.List(context.Background(), nil); err != nil {
+		t.Fatalf("Tags.List hata döndü: %v", err)
+	}
+
+	client.SetToken(&oauth2.Token{AccessToken: "test-token"})
+	if _, _, err := client.Tags.List(context.Background(), nil); err != nil {
+		t.Fatalf("Tags.List hata döndü: %v", err)
+	}
+
+	if len(authorizations) != 2 || authorizations[0] != "" || authorizations[1] != "Bearer test-token" {
+		t.Errorf("Authorization başlıkları = %v", authorizations)
+	}
+}
@@ -0,0 +1,473 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// login password veya authorization code akışıyla token alır ve kaydeder
+func (a *app) login(ctx context.Context, args []string) error {
+	cfg, err := loadConfig(a.configPath)
+	if err != nil {
+		return err
+	}
+
+	fs := a.newFlagSet("login")
+	fs.StringVar(&cfg.ClientID, "client-id", cfg.ClientID, "OAuth client id")
+	fs.StringVar(&cfg.ClientSecret, "client-secret", cfg.ClientSecret, "OAuth client secret")
+	fs.IntVar(&cfg.CompanyID, "company-id", cfg.CompanyID, "firma numarası")
+	fs.StringVar(&cfg.RedirectURL, "redirect-url", cfg.RedirectURL, "OAuth yönlendirme adresi")
+	fs.StringVar(&cfg.BaseURL, "base-url", cfg.BaseURL, "API adresi (sandbox için)")
+	fs.StringVar(&cfg.AuthURL, "auth-url", cfg.AuthURL, "OAuth yetkilendirme adresi")
+	fs.StringVar(&cfg.TokenURL, "token-url", cfg.TokenURL, "OAuth token adresi")
+	email := fs.String("email", "", "e-posta (password akışı)")
+	password := fs.String("password", os.Getenv("PARASUT_PASSWORD"), "şifre; boşsa PARASUT_PASSWORD veya istem")
+	authCode := fs.Bool("auth-code", false, "authorization code akışını kullan")
+	code := fs.String("code", "", "yetkilendirme kodu (authorization code akışı)")
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	if cfg.ClientID == "" || cfg.ClientSecret == "" || cfg.CompanyID == 0 {
+		return errors.New("--client-id, --client-secret ve --company-id gerekli")
+	}
+
+	client := parasut.NewClient(cfg.sdkConfig())
+
+	if *authCode || *code != "" {
+		if *code == "" {
+			fmt.Fprintln(a.stderr, "Tarayıcıda şu adrese gidin ve yetki verin:")
+			fmt.Fprintln(a.stderr, client.AuthorizeURL("parasut-cli"))
+			if *code, err = a.prompt("Yetkilendirme kodu: "); err != nil {
+				return err
+			}
+		}
+		if err := client.SetTokenFromCode(ctx, *code); err != nil {
+			return fmt.Errorf("token alınamadı: %w", err)
+		}
+	} else {
+		if *email == "" {
+			return errors.New("--email veya --auth-code gerekli")
+		}
+		if *password == "" {
+			if *password, err = a.prompt("Şifre: "); err != nil {
+				return err
+			}
+		}
+		if err := client.SetTokenFromPassword(ctx, *email, *password); err != nil {
+			return fmt.Errorf("token alınamadı: %w", err)
+		}
+	}
+
+	cfg.Token = client.GetToken()
+	if err := cfg.save(a.configPath); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Oturum açıldı, token %s dosyasına kaydedildi\n", a.configPath)
+	return nil
+}
+
+// client kayıtlı ayarlardan istemci oluşturur
+func (a *app) client() (*parasut.Client, error) {
+	cfg, err := loadConfig(a.configPath)
+	if err != nil {
+		return nil, err
+	}
+	client, err := cfg.client()
+	if err != nil {
+		return nil, err
+	}
+	a.config, a.sdk = cfg, client
+	return client, nil
+}
+
+// saveToken komut sırasında yenilenen token'ı yapılandırma dosyasına yazar
+func (a *app) saveToken() error {
+	if a.sdk == nil {
+		return nil
+	}
+	token := a.sdk.GetToken()
+	if token == nil || token == a.config.Token {
+		return nil
+	}
+	a.config.Token = token
+	return a.config.save(a.configPath)
+}
+
+var contactColumns = []column[parasut.Contact]{
+	{"id", func(c parasut.Contact) string { return c.ID }},
+	{"ad", func(c parasut.Contact) string { return c.Attributes.Name }},
+	{"e-posta", func(c parasut.Contact) string { return c.Attributes.Email }},
+	{"vergi no", func(c parasut.Contact) string { return c.Attributes.TaxNumber }},
+	{"hesap türü", func(c parasut.Contact) string { return c.Attributes.AccountType }},
+	{"şehir", func(c parasut.Contact) string { return c.Attributes.City }},
+}
+
+func (a *app) contactsList(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("contacts list")
+	opts := addListFlags(fs)
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	contacts, err := fetch(ctx, opts, client.Contacts.List)
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, opts.output, contacts, contactColumns)
+}
+
+func (a *app) contactsGet(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("contacts get")
+	output := fs.String("output", "table", "çıktı biçimi: table, json, csv")
+	ids, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	contact, err := client.Contacts.Get(ctx, ids[0])
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, *output, []parasut.Contact{*contact}, contactColumns)
+}
+
+func (a *app) contactsCreate(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("contacts create")
+	var input parasut.ContactInput
+	fs.StringVar(&input.Name, "name", "", "ad (zorunlu)")
+	fs.StringVar(&input.Email, "email", "", "e-posta")
+	fs.StringVar(&input.ContactType, "contact-type", "company", "person veya company")
+	fs.StringVar(&input.AccountType, "account-type", "customer", "customer, supplier veya both")
+	fs.StringVar(&input.TaxNumber, "tax-number", "", "VKN/TCKN")
+	fs.StringVar(&input.TaxOffice, "tax-office", "", "vergi dairesi")
+	fs.StringVar(&input.City, "city", "", "şehir")
+	fs.StringVar(&input.District, "district", "", "ilçe")
+	fs.StringVar(&input.Address, "address", "", "adres")
+	fs.StringVar(&input.Phone, "phone", "", "telefon")
+	output := fs.String("output", "table", "çıktı biçimi: table, json, csv")
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if input.Name == "" {
+		return errors.New("--name gerekli")
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	contact, err := client.Contacts.Create(ctx, input, nil)
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, *output, []parasut.Contact{*contact}, contactColumns)
+}
+
+var invoiceColumns = []column[parasut.SalesInvoice]{
+	{"id", func(i parasut.SalesInvoice) string { return i.ID }},
+	{"tür", func(i parasut.SalesInvoice) string { return i.Attributes.ItemType }},
+	{"açıklama", func(i parasut.SalesInvoice) string { return i.Attributes.Description }},
+	{"tarih", func(i parasut.SalesInvoice) string { return i.Attributes.IssueDate }},
+	{"vade", func(i parasut.SalesInvoice) string { return i.Attributes.DueDate }},
+	{"döviz", func(i parasut.SalesInvoice) string { return i.Attributes.Currency }},
+	{"net", func(i parasut.SalesInvoice) string { return amount(i.Attributes.NetTotal) }},
+	{"kalan", func(i parasut.SalesInvoice) string { return amount(i.Attributes.Remaining) }},
+	{"durum", func(i parasut.SalesInvoice) string { return i.Attributes.PaymentStatus }},
+}
+
+func (a *app) invoicesList(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("invoices list")
+	opts := addListFlags(fs)
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	invoices, err := fetch(ctx, opts, client.SalesInvoices.List)
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, opts.output, invoices, invoiceColumns)
+}
+
+func (a *app) invoicesPDF(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("invoices pdf")
+	out := fs.String("o", "", "PDF dosyası; boşsa fatura-<id>.pdf")
+	ids, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	pdf, err := client.SalesInvoices.GetPDF(ctx, ids[0])
+	if err != nil {
+		return err
+	}
+
+	path := *out
+	if path == "" {
+		path = "fatura-" + ids[0] + ".pdf"
+	}
+	if path == "-" {
+		_, err = a.stdout.Write(pdf)
+		return err
+	}
+	if err := os.WriteFile(path, pdf, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "%s yazıldı (%d bayt)\n", path, len(pdf))
+	return nil
+}
+
+func (a *app) invoicesPay(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("invoices pay")
+	var input parasut.PaymentInput
+	fs.StringVar(&input.AccountID, "account", "", "kasa/banka hesabı numarası (zorunlu)")
+	fs.Float64Var(&input.Amount, "amount", 0, "tutar (zorunlu)")
+	fs.Float64Var(&input.ExchangeRate, "rate", 0, "döviz kuru; boşsa TRL için 1, dövizde hesaptan veya TCMB'den")
+	fs.StringVar(&input.Date, "date", time.Now().Format("2006-01-02"), "ödeme tarihi")
+	fs.StringVar(&input.Description, "description", "", "açıklama")
+	fs.StringVar(&input.Currency, "currency", "", "döviz")
+	ids, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if input.Amount <= 0 {
+		return errors.New("--amount sıfırdan büyük olmalı")
+	}
+	if input.AccountID == "" {
+		return errors.New("--account zorunlu")
+	}
+	if input.ExchangeRate == 0 && parasut.CurrencyOrDefault(input.Currency) == "TRL" {
+		input.ExchangeRate = 1
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	payment, err := client.SalesInvoices.CreatePayment(ctx, ids[0], input)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Ödeme %s oluşturuldu: %s %s\n", payment.ID, amount(payment.Attributes.Amount), payment.Attributes.Date)
+	return nil
+}
+
+func (a *app) invoicesCancel(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("invoices cancel")
+	ids, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	if err := client.SalesInvoices.Cancel(ctx, ids[0]); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Fatura %s iptal edildi\n", ids[0])
+	return nil
+}
+
+var productColumns = []column[parasut.Product]{
+	{"id", func(p parasut.Product) string { return p.ID }},
+	{"kod", func(p parasut.Product) string { return p.Attributes.Code }},
+	{"ad", func(p parasut.Product) string { return p.Attributes.Name }},
+	{"kdv", func(p parasut.Product) string { return amount(p.Attributes.VatRate) }},
+	{"fiyat", func(p parasut.Product) string { return amount(p.Attributes.ListPrice) }},
+	{"döviz", func(p parasut.Product) string { return p.Attributes.Currency }},
+}
+
+func (a *app) productsList(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("products list")
+	opts := addListFlags(fs)
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	products, err := fetch(ctx, opts, client.Products.List)
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, opts.output, products, productColumns)
+}
+
+func (a *app) productsGet(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("products get")
+	output := fs.String("output", "table", "çıktı biçimi: table, json, csv")
+	ids, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	product, err := client.Products.Get(ctx, ids[0])
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, *output, []parasut.Product{*product}, productColumns)
+}
+
+func (a *app) productsCreate(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("products create")
+	var input parasut.ProductInput
+	fs.StringVar(&input.Code, "code", "", "ürün kodu")
+	fs.StringVar(&input.Name, "name", "", "ad (zorunlu)")
+	fs.Float64Var(&input.VatRate, "vat-rate", 20, "KDV oranı")
+	fs.Float64Var(&input.ListPrice, "list-price", 0, "satış fiyatı")
+	fs.StringVar(&input.Currency, "currency", "TRL", "döviz")
+	fs.StringVar(&input.Unit, "unit", "", "birim")
+	output := fs.String("output", "table", "çıktı biçimi: table, json, csv")
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if input.Name == "" {
+		return errors.New("--name gerekli")
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	product, err := client.Products.Create(ctx, input)
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, *output, []parasut.Product{*product}, productColumns)
+}
+
+var webhookColumns = []column[parasut.Webhook]{
+	{"id", func(w parasut.Webhook) string { return w.ID }},
+	{"olay", func(w parasut.Webhook) string { return w.Attributes.Event }},
+	{"url", func(w parasut.Webhook) string { return w.Attributes.URL }},
+	{"aktif", func(w parasut.Webhook) string { return strconv.FormatBool(w.Attributes.IsActive) }},
+}
+
+func (a *app) webhooksList(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("webhooks list")
+	opts := addListFlags(fs)
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	webhooks, err := fetch(ctx, opts, client.Webhooks.List)
+	if err != nil {
+		return err
+	}
+	return render(a.stdout, opts.output, webhooks, webhookColumns)
+}
+
+// webhooksSync dosyadaki webhook listesini firmaya uygular: eksikleri
+// oluşturur, farklı olanları günceller, --prune ile fazlaları siler.
+// Webhook'lar olay ve URL ikilisiyle eşleştirilir.
+func (a *app) webhooksSync(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("webhooks sync")
+	file := fs.String("file", "", "istenen webhook'ları içeren JSON dosyası (zorunlu)")
+	prune := fs.Bool("prune", false, "dosyada olmayan webhook'ları sil")
+	dryRun := fs.Bool("dry-run", false, "değişiklik yapmadan yalnızca planı yazdır")
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("--file gerekli")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	var desired []parasut.WebhookInput
+	if err := json.Unmarshal(data, &desired); err != nil {
+		return fmt.Errorf("%s okunamadı: %w", *file, err)
+	}
+
+	client, err := a.client()
+	if err != nil {
+		return err
+	}
+	existing, err := parasut.ListAll(ctx, client.Webhooks.List, nil)
+	if err != nil {
+		return err
+	}
+
+	key := func(event, url string) string { return event + " " + url }
+	current := map[string]parasut.Webhook{}
+	for _, webhook := range existing {
+		current[key(webhook.Attributes.Event, webhook.Attributes.URL)] = webhook
+	}
+
+	wanted := map[string]bool{}
+	for _, input := range desired {
+		k := key(input.Event, input.URL)
+		wanted[k] = true
+
+		webhook, ok := current[k]
+		switch {
+		case !ok:
+			fmt.Fprintf(a.stdout, "+ %s %s\n", input.Event, input.URL)
+			if !*dryRun {
+				if _, err := client.Webhooks.Create(ctx, input); err != nil {
+					return err
+				}
+			}
+		case webhook.Attributes.ToInput() != input:
+			fmt.Fprintf(a.stdout, "~ %s %s\n", input.Event, input.URL)
+			if !*dryRun {
+				if _, err := client.Webhooks.Update(ctx, webhook.ID, input); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if *prune {
+		for _, webhook := range existing {
+			if wanted[key(webhook.Attributes.Event, webhook.Attributes.URL)] {
+				continue
+			}
+			fmt.Fprintf(a.stdout, "- %s %s\n", webhook.Attributes.Event, webhook.Attributes.URL)
+			if !*dryRun {
+				if err := client.Webhooks.Delete(ctx, webhook.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
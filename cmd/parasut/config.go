@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/oauth2"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// configEnv yapılandırma dosyasının yolunu değiştiren ortam değişkeni
+const configEnv = "PARASUT_CONFIG"
+
+// cliConfig login ile kaydedilen istemci ayarları ve token
+type cliConfig struct {
+	ClientID     string        `json:"client_id"`
+	ClientSecret string        `json:"client_secret"`
+	RedirectURL  string        `json:"redirect_url,omitempty"`
+	CompanyID    int           `json:"company_id"`
+	BaseURL      string        `json:"base_url,omitempty"`
+	AuthURL      string        `json:"auth_url,omitempty"`
+	TokenURL     string        `json:"token_url,omitempty"`
+	Token        *oauth2.Token `json:"token,omitempty"`
+}
+
+// defaultConfigPath PARASUT_CONFIG veya kullanıcı yapılandırma klasöründeki dosyayı döndürür
+func defaultConfigPath() string {
+	if path := os.Getenv(configEnv); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "parasut.json"
+	}
+	return filepath.Join(dir, "parasut", "config.json")
+}
+
+// loadConfig yapılandırma dosyasını okur; dosya yoksa boş ayar döner
+func loadConfig(path string) (*cliConfig, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &cliConfig{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg cliConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("yapılandırma okunamadı (%s): %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// save ayarları yalnızca kullanıcının okuyabileceği şekilde yazar
+func (c *cliConfig) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// sdkConfig SDK istemci ayarlarını oluşturur. Kuru verilmeyen dövizli
+// ödemeler için kurlar TCMB'den alınır.
+func (c *cliConfig) sdkConfig() *parasut.Config {
+	redirectURL := c.RedirectURL
+	if redirectURL == "" {
+		redirectURL = "urn:ietf:wg:oauth:2.0:oob"
+	}
+	return &parasut.Config{
+		ClientID:      c.ClientID,
+		ClientSecret:  c.ClientSecret,
+		RedirectURL:   redirectURL,
+		CompanyID:     c.CompanyID,
+		BaseURL:       c.BaseURL,
+		AuthURL:       c.AuthURL,
+		TokenURL:      c.TokenURL,
+		ExchangeRates: &parasut.TCMBProvider{},
+	}
+}
+
+// client token'ı ayarlanmış istemci döndürür
+func (c *cliConfig) client() (*parasut.Client, error) {
+	if c.Token == nil {
+		return nil, errors.New("oturum açılmamış; önce 'parasut login' çalıştırın")
+	}
+	client := parasut.NewClient(c.sdkConfig())
+	client.SetToken(c.Token)
+	return client, nil
+}
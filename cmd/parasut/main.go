@@ -0,0 +1,159 @@
+// parasut Paraşüt API'si için komut satırı aracıdır.
+//
+//	parasut login --client-id ID --client-secret SECRET --company-id 123 --email e@posta.com
+//	parasut contacts list --filter account_type=customer --all --output csv
+//	parasut invoices pay 42 --account 7 --amount 100 --date 2024-05-01
+//	parasut webhooks sync --file webhooks.json --prune
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/parevo-lab/parasut"
+)
+
+const usage = `Kullanım: parasut [--config dosya] <komut> <alt komut> [bayraklar]
+
+Komutlar:
+  login                              oturum aç ve token'ı kaydet
+  contacts  list | get | create      müşteri/tedarikçiler
+  invoices  list | pdf | pay | cancel satış faturaları
+  products  list | get | create      ürünler
+  webhooks  list | sync              webhook'lar
+
+Ayrıntılar için: parasut <komut> <alt komut> --help
+`
+
+// errUsage kullanım hatası; çıkış kodu 2 ile sonuçlanır
+var errUsage = errors.New("geçersiz kullanım")
+
+func main() {
+	err := run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	switch {
+	case err == nil:
+	case errors.Is(err, errUsage), errors.Is(err, flag.ErrHelp):
+		os.Exit(2)
+	default:
+		fmt.Fprintln(os.Stderr, "hata:", err)
+		os.Exit(1)
+	}
+}
+
+// app komutların ortak durumu
+type app struct {
+	configPath string
+	stdin      *bufio.Reader
+	stdout     io.Writer
+	stderr     io.Writer
+
+	// config ve sdk komutun kullandığı ayarlar ve istemci; komut bitince
+	// yenilenen token kaydedilir
+	config *cliConfig
+	sdk    *parasut.Client
+}
+
+// run komut satırını çözer ve ilgili komutu çalıştırır
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	global := flag.NewFlagSet("parasut", flag.ContinueOnError)
+	global.SetOutput(stderr)
+	global.Usage = func() { fmt.Fprint(stderr, usage) }
+	configPath := global.String("config", defaultConfigPath(), "yapılandırma dosyası")
+	if err := global.Parse(args); err != nil {
+		return err
+	}
+
+	a := &app{
+		configPath: *configPath,
+		stdin:      bufio.NewReader(stdin),
+		stdout:     stdout,
+		stderr:     stderr,
+	}
+
+	rest := global.Args()
+	if len(rest) == 0 {
+		fmt.Fprint(stderr, usage)
+		return errUsage
+	}
+
+	if rest[0] == "login" {
+		return a.login(ctx, rest[1:])
+	}
+
+	if len(rest) < 2 {
+		fmt.Fprint(stderr, usage)
+		return errUsage
+	}
+
+	commands := map[string]func(context.Context, []string) error{
+		"contacts list":   a.contactsList,
+		"contacts get":    a.contactsGet,
+		"contacts create": a.contactsCreate,
+		"invoices list":   a.invoicesList,
+		"invoices pdf":    a.invoicesPDF,
+		"invoices pay":    a.invoicesPay,
+		"invoices cancel": a.invoicesCancel,
+		"products list":   a.productsList,
+		"products get":    a.productsGet,
+		"products create": a.productsCreate,
+		"webhooks list":   a.webhooksList,
+		"webhooks sync":   a.webhooksSync,
+	}
+
+	command, ok := commands[rest[0]+" "+rest[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "bilinmeyen komut: %s %s\n\n%s", rest[0], rest[1], usage)
+		return errUsage
+	}
+	err := command(ctx, rest[2:])
+	if saveErr := a.saveToken(); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	return err
+}
+
+// newFlagSet alt komut için bayrak kümesi oluşturur
+func (a *app) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	return fs
+}
+
+// parse bayrakları konumsal argümanlardan bağımsız olarak çözer; bayraklar
+// argümanlardan önce veya sonra yazılabilir. want konumsal argüman sayısıdır.
+func (a *app) parse(fs *flag.FlagSet, args []string, want int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) != want {
+		fmt.Fprintf(a.stderr, "%s: %d argüman bekleniyordu, %d verildi\n", fs.Name(), want, len(positional))
+		return nil, errUsage
+	}
+	return positional, nil
+}
+
+// prompt stderr'e soru yazar ve stdin'den bir satır okur
+func (a *app) prompt(question string) (string, error) {
+	fmt.Fprint(a.stderr, question)
+	line, err := a.stdin.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
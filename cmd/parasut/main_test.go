@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/parasuttest"
+)
+
+// runCLI komutu çalıştırır ve stdout'u döndürür
+func runCLI(t *testing.T, configPath string, args ...string) (string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	err := run(context.Background(), append([]string{"--config", configPath}, args...), strings.NewReader(""), &stdout, &stderr)
+	return stdout.String(), err
+}
+
+// loggedIn sahte sunucuya oturum açmış yapılandırma dosyası oluşturur
+func loggedIn(t *testing.T) (string, *parasuttest.Server) {
+	t.Helper()
+	server := parasuttest.NewServer()
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	_, err := runCLI(t, path, "login",
+		"--client-id", "id", "--client-secret", "secret", "--company-id", "1",
+		"--base-url", server.URL+"/v4", "--token-url", server.URL+"/oauth/token",
+		"--email", parasuttest.DefaultEmail, "--password", parasuttest.DefaultPassword)
+	if err != nil {
+		t.Fatalf("login hata döndü: %v", err)
+	}
+	return path, server
+}
+
+func TestLogin_SavesToken(t *testing.T) {
+	path, _ := loggedIn(t)
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig hata döndü: %v", err)
+	}
+
+	if cfg.Token == nil || cfg.Token.AccessToken != parasuttest.DefaultToken || cfg.CompanyID != 1 {
+		t.Errorf("Kaydedilen ayarlar = %+v", cfg)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat hata döndü: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("Dosya izinleri = %v, beklenen 0600", info.Mode().Perm())
+	}
+}
+
+func TestRun_SavesRefreshedToken(t *testing.T) {
+	path, _ := loggedIn(t)
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig hata döndü: %v", err)
+	}
+	cfg.Token.Expiry = time.Now().Add(-time.Hour)
+	if err := cfg.save(path); err != nil {
+		t.Fatalf("save hata döndü: %v", err)
+	}
+
+	if _, err := runCLI(t, path, "contacts", "list"); err != nil {
+		t.Fatalf("contacts list hata döndü: %v", err)
+	}
+
+	cfg, err = loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig hata döndü: %v", err)
+	}
+	if cfg.Token == nil || !cfg.Token.Expiry.After(time.Now()) {
+		t.Errorf("Yenilenen token kaydedilmedi: %+v", cfg.Token)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("Dosya izinleri = %v, %v", info.Mode().Perm(), err)
+	}
+}
+
+func TestContacts_CreateAndList(t *testing.T) {
+	path, server := loggedIn(t)
+
+	if _, err := runCLI(t, path, "contacts", "create", "--name", "Alfa Ltd.", "--tax-number", "1234567890"); err != nil {
+		t.Fatalf("contacts create hata döndü: %v", err)
+	}
+	server.Seed("contacts", map[string]interface{}{"name": "Beta A.Ş.", "account_type": "supplier"}, nil)
+
+	out, err := runCLI(t, path, "contacts", "list", "--filter", "account_type=customer", "--output", "csv")
+	if err != nil {
+		t.Fatalf("contacts list hata döndü: %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV okunamadı: %v", err)
+	}
+	if len(records) != 2 || records[1][1] != "Alfa Ltd." || records[1][3] != "1234567890" {
+		t.Errorf("CSV = %v", records)
+	}
+
+	out, err = runCLI(t, path, "contacts", "list", "--all", "--page-size", "1", "--sort", "-name", "--output", "json")
+	if err != nil {
+		t.Fatalf("contacts list --all hata döndü: %v", err)
+	}
+
+	var contacts []parasut.Contact
+	if err := json.Unmarshal([]byte(out), &contacts); err != nil {
+		t.Fatalf("JSON çözülemedi: %v", err)
+	}
+	if len(contacts) != 2 || contacts[0].Attributes.Name != "Beta A.Ş." {
+		t.Errorf("Müşteriler = %+v", contacts)
+	}
+
+	out, err = runCLI(t, path, "contacts", "get", contacts[1].ID)
+	if err != nil {
+		t.Fatalf("contacts get hata döndü: %v", err)
+	}
+	if !strings.Contains(out, "Alfa Ltd.") || !strings.HasPrefix(out, "ID") {
+		t.Errorf("Tablo çıktısı = %q", out)
+	}
+}
+
+func TestInvoices_PayAndCancel(t *testing.T) {
+	path, server := loggedIn(t)
+	id := server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01", "net_total": 500}, nil)
+
+	if _, err := runCLI(t, path, "invoices", "pay", id, "--account", "9", "--amount", "200", "--date", "2024-05-02"); err != nil {
+		t.Fatalf("invoices pay hata döndü: %v", err)
+	}
+
+	if _, err := runCLI(t, path, "invoices", "cancel", id); err != nil {
+		t.Fatalf("invoices cancel hata döndü: %v", err)
+	}
+
+	invoice, _ := server.Resource("sales_invoices", id)
+	if invoice.Attributes["remaining"] != 300.0 || invoice.Attributes["item_type"] != "cancelled" {
+		t.Errorf("Fatura = %+v", invoice.Attributes)
+	}
+
+	// Dövizli ödemede kur verilmezse aynı dövizdeki hesap için 1 kullanılır
+	usdInvoice := server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "currency": "USD", "net_total": 100}, nil)
+	usdAccount := server.Seed("accounts", map[string]interface{}{"name": "USD Kasa", "currency": "USD"}, nil)
+	if _, err := runCLI(t, path, "invoices", "pay", usdInvoice, "--account", usdAccount, "--amount", "100", "--currency", "USD"); err != nil {
+		t.Fatalf("Dövizli invoices pay hata döndü: %v", err)
+	}
+	payments := server.Resources("payments")
+	if len(payments) != 2 {
+		t.Fatalf("Ödeme sayısı = %d, beklenen 2", len(payments))
+	}
+	for _, payment := range payments {
+		if payment.Attributes["exchange_rate"] != 1.0 {
+			t.Errorf("Ödeme kuru = %v, beklenen 1", payment.Attributes["exchange_rate"])
+		}
+	}
+
+	pdfPath := filepath.Join(t.TempDir(), "fatura.pdf")
+	if _, err := runCLI(t, path, "invoices", "pdf", id, "-o", pdfPath); err != nil {
+		t.Fatalf("invoices pdf hata döndü: %v", err)
+	}
+	if data, _ := os.ReadFile(pdfPath); !bytes.HasPrefix(data, []byte("%PDF")) {
+		t.Errorf("PDF içeriği = %q", data)
+	}
+}
+
+func TestWebhooks_Sync(t *testing.T) {
+	path, server := loggedIn(t)
+	server.Seed("webhooks", map[string]interface{}{"event": "sales_invoice.created", "url": "https://ornek.com/eski"}, nil)
+	keep := server.Seed("webhooks", map[string]interface{}{"event": "contact.created", "url": "https://ornek.com/hook", "is_active": false}, nil)
+
+	file := filepath.Join(t.TempDir(), "webhooks.json")
+	os.WriteFile(file, []byte(`[
+		{"event": "contact.created", "url": "https://ornek.com/hook", "is_active": true},
+		{"event": "sales_invoice.paid", "url": "https://ornek.com/hook", "is_active": true}
+	]`), 0o644)
+
+	out, err := runCLI(t, path, "webhooks", "sync", "--file", file, "--prune", "--dry-run")
+	if err != nil {
+		t.Fatalf("webhooks sync --dry-run hata döndü: %v", err)
+	}
+	if out != "~ contact.created https://ornek.com/hook\n+ sales_invoice.paid https://ornek.com/hook\n- sales_invoice.created https://ornek.com/eski\n" {
+		t.Errorf("Plan = %q", out)
+	}
+	if len(server.Resources("webhooks")) != 2 {
+		t.Fatal("--dry-run değişiklik yapmamalı")
+	}
+
+	if _, err := runCLI(t, path, "webhooks", "sync", "--file", file, "--prune"); err != nil {
+		t.Fatalf("webhooks sync hata döndü: %v", err)
+	}
+
+	webhooks := server.Resources("webhooks")
+	if len(webhooks) != 2 {
+		t.Fatalf("Webhook sayısı = %d, beklenen 2", len(webhooks))
+	}
+	if webhooks[0].ID != keep || webhooks[0].Attributes["is_active"] != true {
+		t.Errorf("Güncellenen webhook = %+v", webhooks[0])
+	}
+}
+
+func TestRun_Usage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	if _, err := runCLI(t, path, "contacts", "delete"); err != errUsage {
+		t.Errorf("Bilinmeyen komut hatası = %v", err)
+	}
+
+	if _, err := runCLI(t, path, "contacts", "list"); err == nil || !strings.Contains(err.Error(), "login") {
+		t.Errorf("Oturumsuz komut hatası = %v", err)
+	}
+}
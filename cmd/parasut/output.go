@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// column tablo ve CSV çıktısındaki bir sütun
+type column[T any] struct {
+	title string
+	value func(T) string
+}
+
+// render kayıtları table, json veya csv biçiminde yazar
+func render[T any](w io.Writer, format string, items []T, columns []column[T]) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if items == nil {
+			items = []T{}
+		}
+		return encoder.Encode(items)
+	case "csv":
+		writer := csv.NewWriter(w)
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = c.title
+		}
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := writer.Write(row(item, columns)); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case "table", "":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = strings.ToUpper(c.title)
+		}
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, item := range items {
+			fmt.Fprintln(tw, strings.Join(row(item, columns), "\t"))
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("bilinmeyen çıktı biçimi: %s (table, json, csv)", format)
+}
+
+func row[T any](item T, columns []column[T]) []string {
+	values := make([]string, len(columns))
+	for i, c := range columns {
+		values[i] = c.value(item)
+	}
+	return values
+}
+
+func amount(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+// filterFlag tekrar edilebilir --filter anahtar=değer bayrağı
+type filterFlag map[string]string
+
+func (f filterFlag) String() string {
+	var parts []string
+	for k, v := range f {
+		parts = append(parts, k+"="+v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f filterFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("filtre anahtar=değer biçiminde olmalı: %s", value)
+	}
+	f[key] = val
+	return nil
+}
+
+// listOptions liste komutlarının ortak bayrakları
+type listOptions struct {
+	output   string
+	filter   filterFlag
+	sort     string
+	page     int
+	pageSize int
+	all      bool
+}
+
+func addListFlags(fs *flag.FlagSet) *listOptions {
+	opts := &listOptions{filter: filterFlag{}}
+	fs.StringVar(&opts.output, "output", "table", "çıktı biçimi: table, json, csv")
+	fs.Var(opts.filter, "filter", "filtre, anahtar=değer (tekrar edilebilir)")
+	fs.StringVar(&opts.sort, "sort", "", "sıralama alanı, azalan için - öneki")
+	fs.IntVar(&opts.page, "page", 0, "sayfa numarası")
+	fs.IntVar(&opts.pageSize, "page-size", 0, "sayfa boyutu")
+	fs.BoolVar(&opts.all, "all", false, "tüm sayfaları getir")
+	return opts
+}
+
+func (o *listOptions) params() *parasut.ListParams {
+	params := &parasut.ListParams{
+		Page:     o.page,
+		PageSize: o.pageSize,
+		Sort:     o.sort,
+	}
+	if len(o.filter) > 0 {
+		params.Filter = o.filter
+	}
+	return params
+}
+
+// fetch --all verilmişse tüm sayfaları, aksi halde istenen sayfayı getirir
+func fetch[T any](ctx context.Context, opts *listOptions, fn func(context.Context, *parasut.ListParams) ([]T, *parasut.Meta, error)) ([]T, error) {
+	if opts.all {
+		return parasut.ListAll(ctx, fn, opts.params())
+	}
+	items, _, err := fn(ctx, opts.params())
+	return items, err
+}
@@ -0,0 +1,277 @@
+package parasut
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrExchangeRateNotFound sağlayıcının istenen gün için kur yayımlamadığını
+// belirtir (hafta sonu, resmi tatil veya henüz yayımlanmamış bülten)
+var ErrExchangeRateNotFound = errors.New("kur bulunamadı")
+
+// ExchangeRateProvider döviz kuru kaynağı. Rate 1 birim dövizin verilen
+// gündeki TRL karşılığını döndürür; o gün kur yayımlanmadıysa
+// ErrExchangeRateNotFound döndürmelidir.
+type ExchangeRateProvider interface {
+	Rate(ctx context.Context, currency string, date time.Time) (float64, error)
+}
+
+// maxRateLookback kur bulunamadığında geriye doğru denenen en fazla gün sayısı.
+// Dokuz günlük bayram tatillerini kapsar.
+const maxRateLookback = 10
+
+// LookupExchangeRate verilen günün kurunu döndürür. O gün kur yoksa önceki
+// iş gününe, en fazla maxRateLookback gün geriye gidilir. İkinci dönüş değeri
+// kurun alındığı gündür.
+func LookupExchangeRate(ctx context.Context, provider ExchangeRateProvider, currency string, date time.Time) (float64, time.Time, error) {
+	day := TruncateDate(date)
+	for i := 0; i < maxRateLookback; i++ {
+		rate, err := provider.Rate(ctx, currency, day)
+		if err == nil {
+			return rate, day, nil
+		}
+		if !errors.Is(err, ErrExchangeRateNotFound) {
+			return 0, time.Time{}, err
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return 0, time.Time{}, fmt.Errorf("%s için %s tarihinden önceki %d günde %w", currency, TruncateDate(date).Format("2006-01-02"), maxRateLookback, ErrExchangeRateNotFound)
+}
+
+// fillExchangeRate istemcide kur sağlayıcısı varsa dövizli belgede boş
+// bırakılan kuru belge tarihine göre doldurur
+func (c *Client) fillExchangeRate(ctx context.Context, currency, date string, rate *float64) error {
+	if c.exchangeRates == nil || *rate != 0 || CurrencyOrDefault(currency) == "TRL" {
+		return nil
+	}
+	day, err := ParseDate(date)
+	if err != nil {
+		return fmt.Errorf("kur için geçersiz tarih: %q", date)
+	}
+	value, _, err := LookupExchangeRate(ctx, c.exchangeRates, currency, day)
+	if err != nil {
+		return err
+	}
+	*rate = value
+	return nil
+}
+
+// fillPaymentRate kur sağlayıcısı varsa dövizli ödemede boş bırakılan
+// belge-hesap kurunu doldurur. ExchangeRate belge ile hesap dövizi arasındaki
+// kur olduğundan hesabın dövizine bakılır: hesap belgeyle aynı dövizdeyse 1,
+// TRL ise ödeme tarihindeki kur yazılır; diğer hesaplarda kur elle verilmelidir.
+func (c *Client) fillPaymentRate(ctx context.Context, payment *PaymentInput) error {
+	currency := CurrencyOrDefault(payment.Currency)
+	if c.exchangeRates == nil || payment.ExchangeRate != 0 || currency == "TRL" || payment.AccountID == "" {
+		return nil
+	}
+	account, err := c.Accounts.Get(ctx, payment.AccountID)
+	if err != nil {
+		return err
+	}
+	switch CurrencyOrDefault(account.Attributes.Currency) {
+	case currency:
+		payment.ExchangeRate = 1
+	case "TRL":
+		return c.fillExchangeRate(ctx, currency, payment.Date, &payment.ExchangeRate)
+	}
+	return nil
+}
+
+// StaticRates sabit kurlar sağlayan ExchangeRateProvider: döviz kodundan
+// güne (2006-01-02) ve kura. Testler ve elle girilen kurlar içindir.
+type StaticRates map[string]map[string]float64
+
+// Rate verilen dövizin o günkü kurunu döndürür
+func (r StaticRates) Rate(_ context.Context, currency string, date time.Time) (float64, error) {
+	rate, ok := r[currency][date.Format("2006-01-02")]
+	if !ok {
+		return 0, ErrExchangeRateNotFound
+	}
+	return rate, nil
+}
+
+// TCMBRateType TCMB bülteninde kullanılan kur türü
+type TCMBRateType string
+
+const (
+	ForexBuying     TCMBRateType = "ForexBuying"
+	ForexSelling    TCMBRateType = "ForexSelling"
+	BanknoteBuying  TCMBRateType = "BanknoteBuying"
+	BanknoteSelling TCMBRateType = "BanknoteSelling"
+)
+
+// TCMBBaseURL TCMB kur bültenlerinin kök adresi
+const TCMBBaseURL = "https://www.tcmb.gov.tr/kurlar"
+
+// tcmbLocation bülten tarihlerinin yorumlandığı Türkiye saati
+var tcmbLocation = time.FixedZone("TRT", 3*60*60)
+
+// TCMBProvider Türkiye Cumhuriyet Merkez Bankası kur bültenlerinden kur
+// sağlar. Bugün için today.xml, geçmiş günler için YYYYMM/DDMMYYYY.xml
+// arşivi okunur. Okunan bültenler (yayımlanmamış günler dahil) bellekte
+// tutulur; sıfır değeri kullanıma hazırdır ve eşzamanlı kullanılabilir.
+type TCMBProvider struct {
+	// BaseURL boşsa TCMBBaseURL
+	BaseURL string
+	// HTTPClient boşsa http.DefaultClient
+	HTTPClient *http.Client
+	// RateType boşsa ForexBuying (döviz alış)
+	RateType TCMBRateType
+	// Now bugünün tarihini belirler; boşsa time.Now
+	Now func() time.Time
+
+	mu        sync.Mutex
+	bulletins map[string]map[string]float64
+}
+
+// Rate verilen günün bülteninden 1 birim dövizin TRL karşılığını döndürür.
+// JPY gibi 100 birim üzerinden yayımlanan kurlar birime bölünür.
+func (p *TCMBProvider) Rate(ctx context.Context, currency string, date time.Time) (float64, error) {
+	day := TruncateDate(date)
+	key := day.Format("2006-01-02")
+
+	p.mu.Lock()
+	rates, cached := p.bulletins[key]
+	p.mu.Unlock()
+
+	if !cached {
+		var err error
+		rates, err = p.fetch(ctx, day)
+		if err != nil {
+			return 0, err
+		}
+		// Bugünün bülteni gün içinde yayımlanabileceğinden yokluğu saklanmaz
+		if rates != nil || day.Before(p.today()) {
+			p.mu.Lock()
+			if p.bulletins == nil {
+				p.bulletins = map[string]map[string]float64{}
+			}
+			p.bulletins[key] = rates
+			p.mu.Unlock()
+		}
+	}
+
+	if rates == nil {
+		return 0, ErrExchangeRateNotFound
+	}
+	rate, ok := rates[strings.ToUpper(currency)]
+	if !ok {
+		return 0, fmt.Errorf("TCMB bülteninde %s kuru yok", currency)
+	}
+	return rate, nil
+}
+
+// tcmbBulletin TCMB kur bülteni XML'i
+type tcmbBulletin struct {
+	Date       string         `xml:"Tarih,attr"`
+	Currencies []tcmbCurrency `xml:"Currency"`
+}
+
+// tcmbCurrency bültendeki döviz kaydı
+type tcmbCurrency struct {
+	Code            string `xml:"CurrencyCode,attr"`
+	Unit            string `xml:"Unit"`
+	ForexBuying     string `xml:"ForexBuying"`
+	ForexSelling    string `xml:"ForexSelling"`
+	BanknoteBuying  string `xml:"BanknoteBuying"`
+	BanknoteSelling string `xml:"BanknoteSelling"`
+}
+
+// value kur türüne göre bültendeki değeri döndürür
+func (c tcmbCurrency) value(rateType TCMBRateType) string {
+	switch rateType {
+	case ForexSelling:
+		return c.ForexSelling
+	case BanknoteBuying:
+		return c.BanknoteBuying
+	case BanknoteSelling:
+		return c.BanknoteSelling
+	}
+	return c.ForexBuying
+}
+
+// today Türkiye saatine göre bugünün tarihini döndürür
+func (p *TCMBProvider) today() time.Time {
+	now := time.Now
+	if p.Now != nil {
+		now = p.Now
+	}
+	return TruncateDate(now().In(tcmbLocation))
+}
+
+// fetch günün bültenini indirir. Bülten yayımlanmamışsa nil döner.
+func (p *TCMBProvider) fetch(ctx context.Context, day time.Time) (map[string]float64, error) {
+	today := p.today()
+	if day.After(today) {
+		return nil, nil
+	}
+
+	baseURL := TCMBBaseURL
+	if p.BaseURL != "" {
+		baseURL = strings.TrimSuffix(p.BaseURL, "/")
+	}
+	url := baseURL + "/today.xml"
+	if day.Before(today) {
+		url = fmt.Sprintf("%s/%s/%s.xml", baseURL, day.Format("200601"), day.Format("02012006"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := p.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("TCMB kur bülteni alınamadı: %s", resp.Status)
+	}
+
+	var bulletin tcmbBulletin
+	if err := xml.NewDecoder(resp.Body).Decode(&bulletin); err != nil {
+		return nil, fmt.Errorf("TCMB kur bülteni çözülemedi: %w", err)
+	}
+	published, err := time.Parse("02.01.2006", bulletin.Date)
+	if err != nil {
+		return nil, fmt.Errorf("TCMB bülten tarihi okunamadı: %q", bulletin.Date)
+	}
+	// today.xml tatil günlerinde ve bülten yayımlanmadan önce son bülteni içerir
+	if !published.Equal(day) {
+		return nil, nil
+	}
+
+	rateType := p.RateType
+	if rateType == "" {
+		rateType = ForexBuying
+	}
+	rates := map[string]float64{}
+	for _, currency := range bulletin.Currencies {
+		value, err := strconv.ParseFloat(strings.TrimSpace(currency.value(rateType)), 64)
+		if err != nil || value <= 0 {
+			continue
+		}
+		unit, err := strconv.ParseFloat(strings.TrimSpace(currency.Unit), 64)
+		if err != nil || unit <= 0 {
+			unit = 1
+		}
+		rates[currency.Code] = value / unit
+	}
+	return rates, nil
+}
@@ -0,0 +1,167 @@
+package parasut
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLookupExchangeRate(t *testing.T) {
+	rates := StaticRates{"USD": {"2024-05-03": 32.25}}
+	ctx := context.Background()
+
+	// 2024-05-05 pazar; cuma gününün kuru kullanılır
+	rate, day, err := LookupExchangeRate(ctx, rates, "USD", time.Date(2024, 5, 5, 14, 0, 0, 0, time.UTC))
+	if err != nil || rate != 32.25 || day.Format("2006-01-02") != "2024-05-03" {
+		t.Errorf("LookupExchangeRate() = %v, %v, %v", rate, day, err)
+	}
+
+	if _, _, err := LookupExchangeRate(ctx, rates, "USD", time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("Geriye bakma sınırı aşıldığında hata = %v", err)
+	}
+	if _, _, err := LookupExchangeRate(ctx, rates, "EUR", time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("Bilinmeyen döviz hatası = %v", err)
+	}
+}
+
+const testTCMBBulletin = `<?xml version="1.0" encoding="UTF-8"?>
+<Tarih_Date Tarih="%s" Date="%s" Bulten_No="2024/85">
+	<Currency CrossOrder="0" Kod="USD" CurrencyCode="USD">
+		<Unit>1</Unit><Isim>ABD DOLARI</Isim>
+		<ForexBuying>32.1000</ForexBuying><ForexSelling>32.2000</ForexSelling>
+		<BanknoteBuying>32.0500</BanknoteBuying><BanknoteSelling>32.2500</BanknoteSelling>
+	</Currency>
+	<Currency CrossOrder="11" Kod="JPY" CurrencyCode="JPY">
+		<Unit>100</Unit><Isim>JAPON YENİ</Isim>
+		<ForexBuying>20.5000</ForexBuying><ForexSelling>20.6000</ForexSelling>
+		<BanknoteBuying></BanknoteBuying><BanknoteSelling></BanknoteSelling>
+	</Currency>
+</Tarih_Date>`
+
+func TestTCMBProvider(t *testing.T) {
+	var paths []string
+	todayPublished := "02.05.2024"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/kurlar/202405/03052024.xml":
+			fmt.Fprintf(w, testTCMBBulletin, "03.05.2024", "05/03/2024")
+		case "/kurlar/today.xml":
+			fmt.Fprintf(w, testTCMBBulletin, todayPublished, "")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	provider := &TCMBProvider{
+		BaseURL: server.URL + "/kurlar",
+		Now:     func() time.Time { return time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC) },
+	}
+	ctx := context.Background()
+
+	// Hafta sonu arşivde yok; cuma bülteni kullanılır
+	rate, day, err := LookupExchangeRate(ctx, provider, "USD", time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC))
+	if err != nil || rate != 32.1 || day.Format("2006-01-02") != "2024-05-03" {
+		t.Fatalf("LookupExchangeRate() = %v, %v, %v", rate, day, err)
+	}
+	if rate, err := provider.Rate(ctx, "JPY", day); err != nil || rate != 0.205 {
+		t.Errorf("JPY kuru = %v, %v", rate, err)
+	}
+
+	requests := len(paths)
+	if _, _, err := LookupExchangeRate(ctx, provider, "USD", time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)); err != nil || len(paths) != requests {
+		t.Errorf("Önbellekteki bültenler tekrar istendi: %v", paths[requests:])
+	}
+
+	// Bugünün bülteni henüz yayımlanmadı: today.xml eski tarihli, yokluk saklanmaz
+	if _, err := provider.Rate(ctx, "USD", time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("Yayımlanmamış bülten hatası = %v", err)
+	}
+	todayPublished = "06.05.2024"
+	if rate, err := provider.Rate(ctx, "USD", time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)); err != nil || rate != 32.1 {
+		t.Errorf("Bugünün kuru = %v, %v", rate, err)
+	}
+
+	if _, err := provider.Rate(ctx, "USD", time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("Gelecek gün hatası = %v", err)
+	}
+	if _, err := provider.Rate(ctx, "XYZ", time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)); err == nil || errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("Bültende olmayan döviz hatası = %v", err)
+	}
+
+	selling := &TCMBProvider{BaseURL: server.URL + "/kurlar", RateType: BanknoteSelling, Now: provider.Now}
+	if rate, err := selling.Rate(ctx, "USD", time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)); err != nil || rate != 32.25 {
+		t.Errorf("Efektif satış kuru = %v, %v", rate, err)
+	}
+}
+
+func TestClient_FillExchangeRate(t *testing.T) {
+	bodies := map[string]map[string]interface{}{}
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			currency := map[string]string{"/v4/123/accounts/2": "TRL", "/v4/123/accounts/3": "EUR", "/v4/123/accounts/4": "USD"}[r.URL.Path]
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"data": {"id": "2", "type": "accounts", "attributes": {"currency": %q}}}`, currency)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		json.Unmarshal(body, &payload)
+		bodies[r.URL.Path] = payload["data"].(map[string]interface{})["attributes"].(map[string]interface{})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"id": "1", "type": "x"}}`))
+	})
+	client.exchangeRates = StaticRates{"USD": {"2024-05-03": 32.1}, "EUR": {"2024-05-03": 34.5}}
+	ctx := context.Background()
+
+	if _, err := client.SalesInvoices.Create(ctx, SalesInvoiceInput{ItemType: "invoice", IssueDate: "2024-05-04", Currency: "USD"}, nil); err != nil {
+		t.Fatalf("SalesInvoices.Create hata döndü: %v", err)
+	}
+	if _, err := client.PurchaseBills.Create(ctx, PurchaseBillInput{ItemType: "bill", IssueDate: "2024-05-03", Currency: "EUR", ExchangeRate: 35}, nil); err != nil {
+		t.Fatalf("PurchaseBills.Create hata döndü: %v", err)
+	}
+	if _, err := client.BankFees.Create(ctx, BankFeeInput{IssueDate: "2024-05-03", Currency: "TRL", NetTotal: 10}); err != nil {
+		t.Fatalf("BankFees.Create hata döndü: %v", err)
+	}
+	if _, err := client.SalesInvoices.CreatePayment(ctx, "9", PaymentInput{AccountID: "2", Date: "2024-05-05", Amount: 10, Currency: "EUR"}); err != nil {
+		t.Fatalf("CreatePayment hata döndü: %v", err)
+	}
+
+	if rate := bodies["/v4/123/sales_invoices"]["exchange_rate"]; rate != 32.1 {
+		t.Errorf("Satış faturası kuru = %v", rate)
+	}
+	if rate := bodies["/v4/123/purchase_bills"]["exchange_rate"]; rate != 35.0 {
+		t.Errorf("Verilen kur değiştirilmemeli: %v", rate)
+	}
+	if _, ok := bodies["/v4/123/bank_fees"]["exchange_rate"]; ok {
+		t.Errorf("TRL belgeye kur yazılmamalı: %v", bodies["/v4/123/bank_fees"])
+	}
+	if rate := bodies["/v4/123/sales_invoices/9/payments"]["exchange_rate"]; rate != 34.5 {
+		t.Errorf("TRL hesaba ödeme kuru = %v", rate)
+	}
+
+	// Ödeme kuru belge ile hesap arasındadır: aynı dövizdeki hesapta 1
+	if _, err := client.SalesInvoices.CreatePayment(ctx, "10", PaymentInput{AccountID: "3", Date: "2024-05-05", Amount: 10, Currency: "EUR"}); err != nil {
+		t.Fatalf("CreatePayment hata döndü: %v", err)
+	}
+	if rate := bodies["/v4/123/sales_invoices/10/payments"]["exchange_rate"]; rate != 1.0 {
+		t.Errorf("EUR hesaba ödeme kuru = %v", rate)
+	}
+	if _, err := client.SalesInvoices.CreatePayment(ctx, "11", PaymentInput{AccountID: "4", Date: "2024-05-05", Amount: 10, Currency: "EUR"}); err == nil {
+		t.Error("Çapraz dövizli hesap için kur verilmeden hata bekleniyordu")
+	}
+
+	_, err := client.SalesInvoices.Create(ctx, SalesInvoiceInput{ItemType: "invoice", IssueDate: "2024-06-01", Currency: "GBP"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "GBP") {
+		t.Errorf("Kur bulunamadığında hata = %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package parasut
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// flightGroup aynı anahtarla eşzamanlı yapılan çağrıları tek çağrıya indirir
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall devam eden veya tamamlanmış bir çağrı
+type flightCall struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// do anahtar için devam eden çağrı varsa onun sonucunu bekler, yoksa fn'i
+// çalıştırır. Yanıt gövdesi bir kez okunur ve her çağırana ayrı bir kopya
+// verilir. Çağrı ilk çağıranın context'i ile yapıldığından, bu context iptal
+// edilirse bekleyen çağıranlar da aynı hatayı alır. Bekleyen çağıranın kendi
+// ctx'i iptal edilirse çağrının bitmesi beklenmeden ctx.Err() döner.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() (*http.Response, error)) (*http.Response, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*flightCall{}
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.response()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.resp, call.err = fn()
+	if call.err == nil {
+		call.body, call.err = io.ReadAll(call.resp.Body)
+		call.resp.Body.Close()
+	}
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+
+	return call.response()
+}
+
+// response çağrı sonucunun gövdesi yeniden okunabilir bir kopyasını döndürür
+func (call *flightCall) response() (*http.Response, error) {
+	if call.err != nil {
+		return nil, call.err
+	}
+	resp := *call.resp
+	resp.Header = call.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(call.body))
+	return &resp, nil
+}
@@ -0,0 +1,127 @@
+package parasut
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFlightTestServer(t *testing.T, status int, release <-chan struct{}) (*httptest.Server, *int32) {
+	t.Helper()
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.WriteHeader(status)
+		if status >= 400 {
+			w.Write([]byte(`{"errors": [{"title": "Bulunamadı", "detail": "Kayıt yok"}]}`))
+			return
+		}
+		w.Write([]byte(`{"data": {"id": "1", "type": "contacts", "attributes": {"name": "Acme"}}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits
+}
+
+// getConcurrently n goroutine'den aynı müşteriyi ister ve ilk istek sunucuya
+// ulaştıktan sonra yanıtı serbest bırakır
+func getConcurrently(client *Client, n int, release chan struct{}, hits *int32) ([]*Contact, []error) {
+	contacts := make([]*Contact, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			contacts[i], errs[i] = client.Contacts.Get(context.Background(), "1")
+		}(i)
+	}
+	for atomic.LoadInt32(hits) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	return contacts, errs
+}
+
+func TestClient_DeduplicateRequests(t *testing.T) {
+	release := make(chan struct{})
+	server, hits := newFlightTestServer(t, http.StatusOK, release)
+	client := NewClient(&Config{CompanyID: 123, BaseURL: server.URL, DeduplicateRequests: true})
+
+	contacts, errs := getConcurrently(client, 10, release, hits)
+	for i := range contacts {
+		if errs[i] != nil || contacts[i] == nil || contacts[i].Attributes.Name != "Acme" {
+			t.Fatalf("%d. çağrı = %+v, %v", i, contacts[i], errs[i])
+		}
+	}
+	if n := atomic.LoadInt32(hits); n != 1 {
+		t.Errorf("Sunucuya ulaşan istek sayısı = %d, beklenen 1", n)
+	}
+
+	// Tamamlanan istekler paylaşılmaz
+	client.Contacts.Get(context.Background(), "1")
+	if n := atomic.LoadInt32(hits); n != 2 {
+		t.Errorf("Sunucuya ulaşan istek sayısı = %d, beklenen 2", n)
+	}
+}
+
+func TestClient_DeduplicateRequests_Error(t *testing.T) {
+	release := make(chan struct{})
+	server, hits := newFlightTestServer(t, http.StatusNotFound, release)
+	client := NewClient(&Config{CompanyID: 123, BaseURL: server.URL, DeduplicateRequests: true})
+
+	_, errs := getConcurrently(client, 5, release, hits)
+	for i, err := range errs {
+		if errResp, ok := err.(*ErrorResponse); !ok || errResp.StatusCode != http.StatusNotFound {
+			t.Errorf("%d. hata = %v", i, err)
+		}
+	}
+	if n := atomic.LoadInt32(hits); n != 1 {
+		t.Errorf("Sunucuya ulaşan istek sayısı = %d, beklenen 1", n)
+	}
+}
+
+func TestClient_DeduplicateRequests_Disabled(t *testing.T) {
+	release := make(chan struct{})
+	server, hits := newFlightTestServer(t, http.StatusOK, release)
+	client := NewClient(&Config{CompanyID: 123, BaseURL: server.URL})
+
+	getConcurrently(client, 3, release, hits)
+	if n := atomic.LoadInt32(hits); n != 3 {
+		t.Errorf("Sunucuya ulaşan istek sayısı = %d, beklenen 3", n)
+	}
+}
+
+func TestClient_DeduplicateRequests_WaiterContext(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	server, hits := newFlightTestServer(t, http.StatusOK, release)
+	client := NewClient(&Config{CompanyID: 123, BaseURL: server.URL, DeduplicateRequests: true})
+
+	go client.Contacts.Get(context.Background(), "1")
+	for atomic.LoadInt32(hits) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Lider yanıt beklerken kısa süreli bekleyen kendi süresi dolunca döner
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.Contacts.Get(ctx, "1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Bekleyen çağrı hatası = %v, beklenen context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Bekleyen çağrı %v sonra döndü", elapsed)
+	}
+	if n := atomic.LoadInt32(hits); n != 1 {
+		t.Errorf("Sunucuya ulaşan istek sayısı = %d, beklenen 1", n)
+	}
+}
@@ -0,0 +1,630 @@
+// Package importer CSV ve XLSX tablolarından müşteri/tedarikçi ve ürün
+// aktarımı yapar. Satırlar doğrulanır, mevcut kayıtlarla eşleştirilerek bir
+// plan (dry-run farkı) çıkarılır, plan servisler üzerinden uygulanır ve satır
+// bazlı sonuçlar bir dosyaya yazılır:
+//
+//	sheet, _ := importer.ReadFile("musteriler.xlsx")
+//	im := importer.New(client)
+//	plan, _ := im.PlanContacts(ctx, sheet)
+//	plan.WriteDiff(os.Stdout)
+//	im.Apply(ctx, plan)
+//	plan.SaveResults("musteriler-sonuc.csv")
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// Kind aktarılan kaynak türü
+type Kind string
+
+const (
+	KindContacts Kind = "contacts"
+	KindProducts Kind = "products"
+)
+
+// Action plandaki satır için yapılacak işlem
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionSkip    Action = "skip"
+	ActionInvalid Action = "invalid"
+)
+
+// contactAliases varsayılan sütun adı → ContactInput alanı eşlemesi
+var contactAliases = map[string]string{
+	"ad":             "name",
+	"unvan":          "name",
+	"ünvan":          "name",
+	"müşteri":        "name",
+	"firma":          "name",
+	"kısa ad":        "short_name",
+	"e-posta":        "email",
+	"eposta":         "email",
+	"e-mail":         "email",
+	"vkn":            "tax_number",
+	"tckn":           "tax_number",
+	"vkn/tckn":       "tax_number",
+	"vergi no":       "tax_number",
+	"vergi numarası": "tax_number",
+	"vergi dairesi":  "tax_office",
+	"il":             "city",
+	"şehir":          "city",
+	"ilçe":           "district",
+	"adres":          "address",
+	"telefon":        "phone",
+	"tel":            "phone",
+	"faks":           "fax",
+	"ülke":           "country",
+	"iban":           "ibans",
+	"kişi tipi":      "contact_type",
+	"tip":            "contact_type",
+	"hesap türü":     "account_type",
+	"cari türü":      "account_type",
+}
+
+// productAliases varsayılan sütun adı → ProductInput alanı eşlemesi
+var productAliases = map[string]string{
+	"kod":             "code",
+	"ürün kodu":       "code",
+	"stok kodu":       "code",
+	"sku":             "code",
+	"ad":              "name",
+	"ürün adı":        "name",
+	"ürün":            "name",
+	"kdv":             "vat_rate",
+	"kdv oranı":       "vat_rate",
+	"fiyat":           "list_price",
+	"satış fiyatı":    "list_price",
+	"liste fiyatı":    "list_price",
+	"döviz":           "currency",
+	"para birimi":     "currency",
+	"alış fiyatı":     "buying_price",
+	"alış dövizi":     "buying_currency",
+	"birim":           "unit",
+	"stok takibi":     "inventory_tracking",
+	"başlangıç stoğu": "initial_stock_count",
+}
+
+// Change güncellenecek bir alanın eski ve yeni değeri
+type Change struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Item plandaki tek satır
+type Item struct {
+	Row     Row
+	Action  Action
+	Key     string
+	ID      string
+	Changes []Change
+	Errors  []string
+
+	Contact *parasut.ContactInput
+	Product *parasut.ProductInput
+
+	// Applied Apply sonrası işlemin yapıldığını, Err ise başarısız olduğunu gösterir
+	Applied bool
+	Err     error
+}
+
+// Status satırın sonuç dosyasına yazılan durumu
+func (i *Item) Status() string {
+	switch {
+	case i.Action == ActionInvalid:
+		return "geçersiz"
+	case i.Err != nil:
+		return "hata"
+	case i.Action == ActionSkip:
+		return "değişmedi"
+	case i.Action == ActionCreate && i.Applied:
+		return "oluşturuldu"
+	case i.Action == ActionCreate:
+		return "oluşturulacak"
+	case i.Action == ActionUpdate && i.Applied:
+		return "güncellendi"
+	}
+	return "güncellenecek"
+}
+
+// Plan tablonun satır bazlı aktarım planı
+type Plan struct {
+	Kind  Kind
+	Sheet *Sheet
+	Items []*Item
+}
+
+// Count verilen işlemdeki satır sayısını döndürür
+func (p *Plan) Count(action Action) int {
+	count := 0
+	for _, item := range p.Items {
+		if item.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// WriteDiff planı okunabilir bir fark listesi olarak yazar
+func (p *Plan) WriteDiff(w io.Writer) error {
+	for _, item := range p.Items {
+		var err error
+		switch item.Action {
+		case ActionCreate:
+			_, err = fmt.Fprintf(w, "+ %d. satır: %s\n", item.Row.Line, item.Key)
+		case ActionUpdate:
+			_, err = fmt.Fprintf(w, "~ %d. satır: %s [%s]\n", item.Row.Line, item.Key, item.ID)
+			for _, change := range item.Changes {
+				if err == nil {
+					_, err = fmt.Fprintf(w, "    %s: %q -> %q\n", change.Field, change.Old, change.New)
+				}
+			}
+		case ActionSkip:
+			_, err = fmt.Fprintf(w, "= %d. satır: %s [%s]\n", item.Row.Line, item.Key, item.ID)
+		case ActionInvalid:
+			_, err = fmt.Fprintf(w, "! %d. satır: %s\n", item.Row.Line, strings.Join(item.Errors, "; "))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d yeni, %d güncelleme, %d değişmeyen, %d geçersiz\n",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionSkip), p.Count(ActionInvalid))
+	return err
+}
+
+// WriteResults orijinal sütunlara sonuç, id ve hata sütunlarını ekleyerek CSV yazar
+func (p *Plan) WriteResults(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	header := append(append([]string{}, p.Sheet.Header...), "sonuç", "id", "hata")
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, item := range p.Items {
+		record := make([]string, len(p.Sheet.Header))
+		copy(record, item.Row.Values)
+
+		message := strings.Join(item.Errors, "; ")
+		if item.Err != nil {
+			message = item.Err.Error()
+		}
+		record = append(record, item.Status(), item.ID, message)
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// SaveResults sonuçları dosyaya yazar
+func (p *Plan) SaveResults(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := p.WriteResults(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Importer tabloları Paraşüt servisleri üzerinden aktarır
+type Importer struct {
+	Contacts parasut.ContactsAPI
+	Products parasut.ProductsAPI
+
+	// Mapping sütun adı → alan (ör. "Müşteri Kodu" → "short_name") eşlemesi;
+	// varsayılan eşlemeleri ezer. Alan adları API'deki JSON adlarıdır.
+	Mapping map[string]string
+}
+
+// New istemcinin servisleriyle çalışan bir Importer oluşturur
+func New(client *parasut.Client) *Importer {
+	return &Importer{Contacts: client.Contacts, Products: client.Products}
+}
+
+// PlanContacts satırları doğrular ve mevcut müşteri/tedarikçilerle eşleştirir.
+// Eşleştirme önce vergi numarası, yoksa ada göre yapılır.
+func (im *Importer) PlanContacts(ctx context.Context, sheet *Sheet) (*Plan, error) {
+	existing, err := parasut.ListAll(ctx, im.Contacts.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("mevcut müşteriler alınamadı: %w", err)
+	}
+
+	byTax := map[string]parasut.Contact{}
+	byName := map[string]parasut.Contact{}
+	for _, contact := range existing {
+		if contact.Attributes.TaxNumber != "" {
+			byTax[contact.Attributes.TaxNumber] = contact
+		}
+		byName[strings.ToLower(contact.Attributes.Name)] = contact
+	}
+
+	columns := im.columns(sheet, contactAliases, parasut.ContactInput{})
+	plan := &Plan{Kind: KindContacts, Sheet: sheet}
+	seen := map[string]int{}
+
+	for _, row := range sheet.Rows {
+		item := &Item{Row: row}
+		plan.Items = append(plan.Items, item)
+
+		values := rowValues(sheet, row, columns)
+		item.Key = values["name"]
+
+		var input parasut.ContactInput
+		item.Errors = append(item.Errors, setFields(&input, values)...)
+		item.Errors = append(item.Errors, validateContact(input)...)
+
+		key := "name:" + strings.ToLower(input.Name)
+		if input.TaxNumber != "" {
+			key = "tax:" + input.TaxNumber
+		}
+		if line, ok := seen[key]; ok {
+			item.Errors = append(item.Errors, fmt.Sprintf("%d. satırla aynı kayıt", line))
+		}
+		seen[key] = row.Line
+
+		if len(item.Errors) > 0 {
+			item.Action = ActionInvalid
+			continue
+		}
+
+		match, found := byTax[input.TaxNumber]
+		if input.TaxNumber == "" || !found {
+			match, found = byName[strings.ToLower(input.Name)]
+		}
+
+		if !found {
+			if input.ContactType == "" {
+				input.ContactType = "company"
+				if len(input.TaxNumber) == 11 {
+					input.ContactType = "person"
+				}
+			}
+			if input.AccountType == "" {
+				input.AccountType = "customer"
+			}
+			item.Action = ActionCreate
+			item.Contact = &input
+			continue
+		}
+
+		current := match.Attributes.ToInput()
+		merged := current
+		setFields(&merged, values)
+		item.ID = match.ID
+		item.Changes = diff(current, merged)
+		item.Contact = &merged
+		item.Action = ActionUpdate
+		if len(item.Changes) == 0 {
+			item.Action = ActionSkip
+		}
+	}
+
+	return plan, nil
+}
+
+// PlanProducts satırları doğrular ve mevcut ürünlerle eşleştirir. Eşleştirme
+// önce ürün koduna, yoksa ada göre yapılır.
+func (im *Importer) PlanProducts(ctx context.Context, sheet *Sheet) (*Plan, error) {
+	existing, err := parasut.ListAll(ctx, im.Products.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("mevcut ürünler alınamadı: %w", err)
+	}
+
+	byCode := map[string]parasut.Product{}
+	byName := map[string]parasut.Product{}
+	for _, product := range existing {
+		if product.Attributes.Code != "" {
+			byCode[product.Attributes.Code] = product
+		}
+		byName[strings.ToLower(product.Attributes.Name)] = product
+	}
+
+	columns := im.columns(sheet, productAliases, parasut.ProductInput{})
+	plan := &Plan{Kind: KindProducts, Sheet: sheet}
+	seen := map[string]int{}
+
+	for _, row := range sheet.Rows {
+		item := &Item{Row: row}
+		plan.Items = append(plan.Items, item)
+
+		values := rowValues(sheet, row, columns)
+		for _, field := range []string{"currency", "buying_currency"} {
+			if value, ok := values[field]; ok {
+				values[field] = NormalizeCurrency(value)
+			}
+		}
+		item.Key = values["name"]
+
+		var input parasut.ProductInput
+		item.Errors = append(item.Errors, setFields(&input, values)...)
+		item.Errors = append(item.Errors, validateProduct(input, values)...)
+
+		key := "name:" + strings.ToLower(input.Name)
+		if input.Code != "" {
+			key = "code:" + input.Code
+		}
+		if line, ok := seen[key]; ok {
+			item.Errors = append(item.Errors, fmt.Sprintf("%d. satırla aynı kayıt", line))
+		}
+		seen[key] = row.Line
+
+		if len(item.Errors) > 0 {
+			item.Action = ActionInvalid
+			continue
+		}
+
+		match, found := byCode[input.Code]
+		if input.Code == "" || !found {
+			match, found = byName[strings.ToLower(input.Name)]
+		}
+
+		if !found {
+			if input.Currency == "" {
+				input.Currency = "TRL"
+			}
+			item.Action = ActionCreate
+			item.Product = &input
+			continue
+		}
+
+		current := match.Attributes.ToInput()
+		merged := current
+		setFields(&merged, values)
+		item.ID = match.ID
+		item.Changes = diff(current, merged)
+		item.Product = &merged
+		item.Action = ActionUpdate
+		if len(item.Changes) == 0 {
+			item.Action = ActionSkip
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply plandaki oluşturma ve güncellemeleri uygular. Satır hataları
+// Item.Err'e yazılır ve aktarım devam eder; yalnızca context iptali
+// aktarımı durdurur.
+func (im *Importer) Apply(ctx context.Context, plan *Plan) error {
+	for _, item := range plan.Items {
+		if item.Action != ActionCreate && item.Action != ActionUpdate {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		switch plan.Kind {
+		case KindContacts:
+			var contact *parasut.Contact
+			if item.Action == ActionCreate {
+				contact, item.Err = im.Contacts.Create(ctx, *item.Contact, nil)
+			} else {
+				contact, item.Err = im.Contacts.Update(ctx, item.ID, *item.Contact, nil)
+			}
+			if item.Err == nil && contact != nil {
+				item.ID = contact.ID
+			}
+		case KindProducts:
+			var product *parasut.Product
+			if item.Action == ActionCreate {
+				product, item.Err = im.Products.Create(ctx, *item.Product)
+			} else {
+				product, item.Err = im.Products.Update(ctx, item.ID, *item.Product)
+			}
+			if item.Err == nil && product != nil {
+				item.ID = product.ID
+			}
+		default:
+			return fmt.Errorf("bilinmeyen aktarım türü: %s", plan.Kind)
+		}
+		item.Applied = item.Err == nil
+	}
+	return nil
+}
+
+func validateContact(input parasut.ContactInput) []string {
+	var errs []string
+	if input.Name == "" {
+		errs = append(errs, "ad boş olamaz")
+	}
+	if err := ValidateTaxNumber(input.TaxNumber); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if err := ValidateEmail(input.Email); err != nil {
+		errs = append(errs, err.Error())
+	}
+	switch input.AccountType {
+	case "", "customer", "supplier", "both":
+	default:
+		errs = append(errs, "geçersiz hesap türü: "+input.AccountType)
+	}
+	switch input.ContactType {
+	case "", "person", "company":
+	default:
+		errs = append(errs, "geçersiz kişi tipi: "+input.ContactType)
+	}
+	return errs
+}
+
+func validateProduct(input parasut.ProductInput, values map[string]string) []string {
+	var errs []string
+	if input.Name == "" {
+		errs = append(errs, "ad boş olamaz")
+	}
+	if _, ok := values["vat_rate"]; ok {
+		if err := ValidateVatRate(input.VatRate); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	for _, currency := range []string{input.Currency, input.BuyingCurrency} {
+		if err := ValidateCurrency(currency); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if input.ListPrice < 0 || input.BuyingPrice < 0 {
+		errs = append(errs, "fiyat negatif olamaz")
+	}
+	return errs
+}
+
+// columns başlık sütunlarını alan adlarına eşler. Öncelik sırası: Mapping,
+// varsayılan eşlemeler, Input tipindeki JSON adları.
+func (im *Importer) columns(sheet *Sheet, aliases map[string]string, input interface{}) map[int]string {
+	known := map[string]bool{}
+	t := reflect.TypeOf(input)
+	for i := 0; i < t.NumField(); i++ {
+		known[jsonName(t.Field(i))] = true
+	}
+
+	columns := map[int]string{}
+	for i, header := range sheet.Header {
+		normalized := strings.ToLower(strings.TrimSpace(header))
+		switch {
+		case im.Mapping[header] != "":
+			columns[i] = im.Mapping[header]
+		case im.Mapping[normalized] != "":
+			columns[i] = im.Mapping[normalized]
+		case aliases[normalized] != "":
+			columns[i] = aliases[normalized]
+		case known[normalized]:
+			columns[i] = normalized
+		}
+	}
+	return columns
+}
+
+// rowValues satırın boş olmayan değerlerini alan adlarına göre döndürür
+func rowValues(sheet *Sheet, row Row, columns map[int]string) map[string]string {
+	values := map[string]string{}
+	for i, field := range columns {
+		if i >= len(row.Values) {
+			continue
+		}
+		if value := strings.TrimSpace(row.Values[i]); value != "" {
+			values[field] = value
+		}
+	}
+	return values
+}
+
+// setFields değerleri JSON adlarına göre struct alanlarına dönüştürerek yazar
+func setFields(target interface{}, values map[string]string) []string {
+	var errs []string
+	v := reflect.ValueOf(target).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		name := jsonName(t.Field(i))
+		raw, ok := values[name]
+		if !ok {
+			continue
+		}
+
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(raw)
+		case reflect.Float64:
+			number, err := parseNumber(raw)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s sayı olmalı: %s", name, raw))
+				continue
+			}
+			field.SetFloat(number)
+		case reflect.Int:
+			number, err := strconv.Atoi(raw)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s tam sayı olmalı: %s", name, raw))
+				continue
+			}
+			field.SetInt(int64(number))
+		case reflect.Bool:
+			switch strings.ToLower(raw) {
+			case "1", "true", "evet", "e", "yes", "x":
+				field.SetBool(true)
+			case "0", "false", "hayır", "h", "no":
+				field.SetBool(false)
+			default:
+				errs = append(errs, fmt.Sprintf("%s evet/hayır olmalı: %s", name, raw))
+			}
+		case reflect.Slice:
+			var items []string
+			for _, item := range strings.FieldsFunc(raw, func(r rune) bool { return r == ';' || r == ',' }) {
+				items = append(items, strings.TrimSpace(item))
+			}
+			field.Set(reflect.ValueOf(items))
+		}
+	}
+	return errs
+}
+
+// parseNumber "1.234,50" ve "1234.50" biçimlerini kabul eder
+func parseNumber(raw string) (float64, error) {
+	raw = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(raw), "%"), "%")
+	if strings.Contains(raw, ",") {
+		raw = strings.ReplaceAll(raw, ".", "")
+		raw = strings.ReplaceAll(raw, ",", ".")
+	}
+	return strconv.ParseFloat(raw, 64)
+}
+
+// diff iki Input değeri arasındaki farklı alanları döndürür
+func diff(old, new interface{}) []Change {
+	var changes []Change
+	ov := reflect.ValueOf(old)
+	nv := reflect.ValueOf(new)
+	t := ov.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		if reflect.DeepEqual(ov.Field(i).Interface(), nv.Field(i).Interface()) {
+			continue
+		}
+		changes = append(changes, Change{
+			Field: jsonName(t.Field(i)),
+			Old:   format(ov.Field(i)),
+			New:   format(nv.Field(i)),
+		})
+	}
+	return changes
+}
+
+func format(v reflect.Value) string {
+	if v.Kind() == reflect.Slice {
+		var items []string
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, fmt.Sprint(v.Index(i).Interface()))
+		}
+		return strings.Join(items, ";")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
@@ -0,0 +1,202 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/parasutmock"
+	"github.com/parevo-lab/parasut/parasuttest"
+)
+
+func mustReadCSV(t *testing.T, text string) *Sheet {
+	t.Helper()
+	sheet, err := ReadCSV(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("ReadCSV hata döndü: %v", err)
+	}
+	return sheet
+}
+
+func TestImporter_Contacts(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	existing := server.Seed("contacts", map[string]interface{}{
+		"name": "Alfa Ltd.", "tax_number": "1234567890", "email": "eski@alfa.com",
+		"contact_type": "company", "account_type": "customer",
+	}, nil)
+	same := server.Seed("contacts", map[string]interface{}{
+		"name": "Gama Ltd.", "email": "info@gama.com", "contact_type": "company", "account_type": "supplier",
+	}, nil)
+
+	sheet := mustReadCSV(t, `Unvan;VKN/TCKN;E-posta;Cari Türü
+Alfa Limited;1234567890;yeni@alfa.com;
+Gama Ltd.;;info@gama.com;supplier
+Ali Veli;10000000146;ali@ornek.com;
+Hatalı;1234567891;hatali-eposta;
+Ali Veli 2;10000000146;;
+`)
+
+	im := New(client)
+	ctx := context.Background()
+	plan, err := im.PlanContacts(ctx, sheet)
+	if err != nil {
+		t.Fatalf("PlanContacts hata döndü: %v", err)
+	}
+
+	actions := []Action{ActionUpdate, ActionSkip, ActionCreate, ActionInvalid, ActionInvalid}
+	for i, item := range plan.Items {
+		if item.Action != actions[i] {
+			t.Errorf("%d. satır işlemi = %s, beklenen %s (%v)", item.Row.Line, item.Action, actions[i], item.Errors)
+		}
+	}
+	if plan.Items[0].ID != existing || plan.Items[1].ID != same {
+		t.Errorf("Eşleşen ID'ler = %s, %s", plan.Items[0].ID, plan.Items[1].ID)
+	}
+	if changes := plan.Items[0].Changes; len(changes) != 2 || changes[0].Old != "eski@alfa.com" || changes[1].Field != "name" {
+		t.Errorf("Değişiklikler = %+v", changes)
+	}
+	if contact := plan.Items[2].Contact; contact.ContactType != "person" || contact.AccountType != "customer" {
+		t.Errorf("Varsayılanlar = %+v", contact)
+	}
+	if errs := plan.Items[3].Errors; len(errs) != 2 {
+		t.Errorf("Geçersiz satır hataları = %q", errs)
+	}
+	if errs := plan.Items[4].Errors; len(errs) != 1 || !strings.Contains(errs[0], "4. satır") {
+		t.Errorf("Tekrarlanan satır hataları = %q", errs)
+	}
+
+	var diffOut bytes.Buffer
+	plan.WriteDiff(&diffOut)
+	for _, want := range []string{
+		"~ 2. satır: Alfa Limited [" + existing + "]",
+		`    email: "eski@alfa.com" -> "yeni@alfa.com"`,
+		"= 3. satır: Gama Ltd.",
+		"+ 4. satır: Ali Veli",
+		"! 5. satır: geçersiz VKN: 1234567891; geçersiz e-posta: hatali-eposta",
+		"1 yeni, 1 güncelleme, 1 değişmeyen, 2 geçersiz",
+	} {
+		if !strings.Contains(diffOut.String(), want) {
+			t.Errorf("Fark çıktısında %q yok:\n%s", want, diffOut.String())
+		}
+	}
+	if len(server.Resources("contacts")) != 2 {
+		t.Fatal("Plan sunucuda değişiklik yapmamalı")
+	}
+
+	if err := im.Apply(ctx, plan); err != nil {
+		t.Fatalf("Apply hata döndü: %v", err)
+	}
+
+	updated, _ := server.Resource("contacts", existing)
+	if updated.Attributes["email"] != "yeni@alfa.com" || updated.Attributes["name"] != "Alfa Limited" {
+		t.Errorf("Güncellenen müşteri = %+v", updated.Attributes)
+	}
+	created, ok := server.Resource("contacts", plan.Items[2].ID)
+	if !ok || created.Attributes["tax_number"] != "10000000146" {
+		t.Errorf("Oluşturulan müşteri = %+v", created)
+	}
+
+	var results bytes.Buffer
+	if err := plan.WriteResults(&results); err != nil {
+		t.Fatalf("WriteResults hata döndü: %v", err)
+	}
+	records, err := csv.NewReader(&results).ReadAll()
+	if err != nil {
+		t.Fatalf("Sonuç CSV'si okunamadı: %v", err)
+	}
+	if got := records[0]; len(got) != 7 || got[4] != "sonuç" || got[6] != "hata" {
+		t.Errorf("Sonuç başlığı = %q", got)
+	}
+	statuses := []string{"güncellendi", "değişmedi", "oluşturuldu", "geçersiz", "geçersiz"}
+	for i, status := range statuses {
+		if records[i+1][4] != status {
+			t.Errorf("%d. satır sonucu = %q, beklenen %q", i+2, records[i+1][4], status)
+		}
+	}
+	if records[3][5] != plan.Items[2].ID || records[3][0] != "Ali Veli" {
+		t.Errorf("Oluşturulan satır = %q", records[3])
+	}
+}
+
+func TestImporter_Products(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	existing := server.Seed("products", map[string]interface{}{
+		"code": "K-1", "name": "Kalem", "vat_rate": 20, "list_price": 10, "currency": "TRL",
+	}, nil)
+
+	sheet := mustReadCSV(t, `Ürün Kodu;Ürün Adı;KDV;Satış Fiyatı;Döviz;Stok Takibi
+K-1;Kalem;%20;12,50;TL;evet
+;Defter;10;1.250,00;USD;
+K-3;Silgi;17;5;XYZ;belki
+`)
+
+	im := New(client)
+	ctx := context.Background()
+	plan, err := im.PlanProducts(ctx, sheet)
+	if err != nil {
+		t.Fatalf("PlanProducts hata döndü: %v", err)
+	}
+
+	update, create, invalid := plan.Items[0], plan.Items[1], plan.Items[2]
+	if update.Action != ActionUpdate || update.ID != existing || len(update.Changes) != 2 {
+		t.Errorf("Güncelleme = %+v", update)
+	}
+	if create.Action != ActionCreate || create.Product.ListPrice != 1250 || create.Product.Currency != "USD" {
+		t.Errorf("Oluşturma = %+v, %+v", create, create.Product)
+	}
+	if invalid.Action != ActionInvalid || len(invalid.Errors) != 3 {
+		t.Errorf("Geçersiz satır hataları = %q", invalid.Errors)
+	}
+
+	if err := im.Apply(ctx, plan); err != nil {
+		t.Fatalf("Apply hata döndü: %v", err)
+	}
+
+	product, _ := server.Resource("products", existing)
+	if product.Attributes["list_price"] != 12.5 || product.Attributes["inventory_tracking"] != true {
+		t.Errorf("Güncellenen ürün = %+v", product.Attributes)
+	}
+	if len(server.Resources("products")) != 2 {
+		t.Errorf("Ürün sayısı = %d, beklenen 2", len(server.Resources("products")))
+	}
+}
+
+func TestImporter_MappingAndRowErrors(t *testing.T) {
+	products := &parasutmock.ProductsAPI{
+		ListFunc: func(ctx context.Context, params *parasut.ListParams) ([]parasut.Product, *parasut.Meta, error) {
+			return nil, &parasut.Meta{TotalPages: 1}, nil
+		},
+		CreateFunc: func(ctx context.Context, attributes parasut.ProductInput) (*parasut.Product, error) {
+			if attributes.Code == "HATA" {
+				return nil, &parasut.ErrorResponse{StatusCode: 422, Errors: []parasut.Error{{Title: "Kod kullanımda"}}}
+			}
+			return &parasut.Product{ID: "42"}, nil
+		},
+	}
+
+	im := &Importer{Products: products, Mapping: map[string]string{"SKU No": "code", "Açıklama": "name"}}
+	sheet := mustReadCSV(t, "SKU No,Açıklama\nHATA,Kalem\nOK,Defter\n")
+
+	plan, err := im.PlanProducts(context.Background(), sheet)
+	if err != nil {
+		t.Fatalf("PlanProducts hata döndü: %v", err)
+	}
+	if err := im.Apply(context.Background(), plan); err != nil {
+		t.Fatalf("Apply hata döndü: %v", err)
+	}
+
+	var apiErr *parasut.ErrorResponse
+	if !errors.As(plan.Items[0].Err, &apiErr) || plan.Items[0].Status() != "hata" {
+		t.Errorf("İlk satır = %+v", plan.Items[0])
+	}
+	if plan.Items[1].ID != "42" || plan.Items[1].Status() != "oluşturuldu" {
+		t.Errorf("İkinci satır = %+v", plan.Items[1])
+	}
+	if calls := products.CallsTo("Create"); len(calls) != 2 {
+		t.Errorf("Create çağrı sayısı = %d, beklenen 2", len(calls))
+	}
+}
@@ -0,0 +1,215 @@
+package importer
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Row tablodaki bir veri satırı. Line dosyadaki satır numarasıdır (başlık 1. satır).
+type Row struct {
+	Line   int
+	Values []string
+}
+
+// Sheet başlık satırı ve veri satırlarından oluşan tablo
+type Sheet struct {
+	Header []string
+	Rows   []Row
+}
+
+// Get satırın verilen sütundaki değerini döndürür
+func (s *Sheet) Get(row Row, column string) string {
+	for i, name := range s.Header {
+		if name == column && i < len(row.Values) {
+			return strings.TrimSpace(row.Values[i])
+		}
+	}
+	return ""
+}
+
+// ReadFile uzantıya göre CSV veya XLSX dosyasını okur
+func ReadFile(name string) (*Sheet, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv", ".txt":
+		return ReadCSV(file)
+	case ".xlsx":
+		info, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		return ReadXLSX(file, info.Size())
+	}
+	return nil, fmt.Errorf("desteklenmeyen dosya türü: %s (csv, xlsx)", filepath.Ext(name))
+}
+
+// ReadCSV virgül veya noktalı virgülle ayrılmış CSV okur. Ayraç başlık
+// satırından tahmin edilir; UTF-8 BOM atlanır.
+func ReadCSV(r io.Reader) (*Sheet, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimPrefix(string(data), "\ufeff")
+
+	reader := csv.NewReader(strings.NewReader(text))
+	firstLine, _, _ := strings.Cut(text, "\n")
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return newSheet(records)
+}
+
+// ReadXLSX XLSX dosyasının ilk çalışma sayfasını okur
+func ReadXLSX(r io.ReaderAt, size int64) (*Sheet, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("xlsx açılamadı: %w", err)
+	}
+
+	files := map[string]*zip.File{}
+	var sheets []string
+	for _, f := range archive.File {
+		files[f.Name] = f
+		if strings.HasPrefix(f.Name, "xl/worksheets/") && path.Ext(f.Name) == ".xml" {
+			sheets = append(sheets, f.Name)
+		}
+	}
+	if len(sheets) == 0 {
+		return nil, errors.New("xlsx çalışma sayfası içermiyor")
+	}
+	sort.Strings(sheets)
+	sheetName := sheets[0]
+	if _, ok := files["xl/worksheets/sheet1.xml"]; ok {
+		sheetName = "xl/worksheets/sheet1.xml"
+	}
+
+	var shared []string
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		var sst struct {
+			Items []struct {
+				Text string `xml:"t"`
+				Runs []struct {
+					Text string `xml:"t"`
+				} `xml:"r"`
+			} `xml:"si"`
+		}
+		if err := decodeZipXML(f, &sst); err != nil {
+			return nil, err
+		}
+		for _, item := range sst.Items {
+			text := item.Text
+			for _, run := range item.Runs {
+				text += run.Text
+			}
+			shared = append(shared, text)
+		}
+	}
+
+	var worksheet struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Type   string `xml:"t,attr"`
+				Value  string `xml:"v"`
+				Inline struct {
+					Text string `xml:"t"`
+				} `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := decodeZipXML(files[sheetName], &worksheet); err != nil {
+		return nil, err
+	}
+
+	var records [][]string
+	for _, row := range worksheet.Rows {
+		var record []string
+		for i, cell := range row.Cells {
+			column := i
+			if cell.Ref != "" {
+				column = columnIndex(cell.Ref)
+			}
+			for len(record) <= column {
+				record = append(record, "")
+			}
+
+			value := cell.Value
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index >= len(shared) {
+					return nil, fmt.Errorf("%s hücresinde geçersiz paylaşılan metin", cell.Ref)
+				}
+				value = shared[index]
+			case "inlineStr":
+				value = cell.Inline.Text
+			}
+			record[column] = value
+		}
+		records = append(records, record)
+	}
+	return newSheet(records)
+}
+
+func decodeZipXML(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(rc).Decode(v)
+}
+
+// columnIndex "C12" gibi hücre adresinden sıfır tabanlı sütun numarasını çıkarır
+func columnIndex(ref string) int {
+	index := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		index = index*26 + int(r-'A'+1)
+	}
+	return index - 1
+}
+
+// newSheet ilk kaydı başlık kabul ederek tablo oluşturur; boş satırlar atlanır
+func newSheet(records [][]string) (*Sheet, error) {
+	if len(records) == 0 {
+		return nil, errors.New("dosya boş")
+	}
+
+	sheet := &Sheet{}
+	for _, name := range records[0] {
+		sheet.Header = append(sheet.Header, strings.TrimSpace(name))
+	}
+
+	for i, record := range records[1:] {
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		sheet.Rows = append(sheet.Rows, Row{Line: i + 2, Values: record})
+	}
+	return sheet, nil
+}
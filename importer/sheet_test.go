@@ -0,0 +1,103 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV_SemicolonAndBOM(t *testing.T) {
+	sheet, err := ReadCSV(strings.NewReader("\ufeffUnvan;VKN\nAlfa Ltd.;1234567890\n;\nBeta A.Ş.;\n"))
+	if err != nil {
+		t.Fatalf("ReadCSV hata döndü: %v", err)
+	}
+
+	if !reflect.DeepEqual(sheet.Header, []string{"Unvan", "VKN"}) {
+		t.Errorf("Başlık = %q", sheet.Header)
+	}
+	if len(sheet.Rows) != 2 {
+		t.Fatalf("Satır sayısı = %d, beklenen 2", len(sheet.Rows))
+	}
+	if sheet.Get(sheet.Rows[0], "VKN") != "1234567890" {
+		t.Errorf("VKN = %q", sheet.Get(sheet.Rows[0], "VKN"))
+	}
+	if sheet.Rows[1].Line != 4 {
+		t.Errorf("Satır numarası = %d, beklenen 4", sheet.Rows[1].Line)
+	}
+}
+
+// buildXLSX paylaşılan metin ve satır içi metin hücreleri olan en küçük XLSX'i üretir
+func buildXLSX(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	files := map[string]string{
+		"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>Kod</t></si><si><t>Ad</t></si><si><r><t>Kalem </t></r><r><t>Mavi</t></r></si>
+</sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>Fiyat</t></is></c></row>
+<row r="2"><c r="A2" t="inlineStr"><is><t>K-1</t></is></c><c r="B2" t="s"><v>2</v></c><c r="C2"><v>12.5</v></c></row>
+<row r="3"><c r="B3" t="inlineStr"><is><t>Silgi</t></is></c></row>
+</sheetData></worksheet>`,
+	}
+	for name, content := range files {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := buildXLSX(t)
+	sheet, err := ReadXLSX(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("ReadXLSX hata döndü: %v", err)
+	}
+
+	if !reflect.DeepEqual(sheet.Header, []string{"Kod", "Ad", "Fiyat"}) {
+		t.Errorf("Başlık = %q", sheet.Header)
+	}
+	if len(sheet.Rows) != 2 {
+		t.Fatalf("Satır sayısı = %d, beklenen 2", len(sheet.Rows))
+	}
+	if got := sheet.Rows[0].Values; !reflect.DeepEqual(got, []string{"K-1", "Kalem Mavi", "12.5"}) {
+		t.Errorf("İlk satır = %q", got)
+	}
+	if sheet.Get(sheet.Rows[1], "Kod") != "" || sheet.Get(sheet.Rows[1], "Ad") != "Silgi" {
+		t.Errorf("İkinci satır = %q", sheet.Rows[1].Values)
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+	xlsx := filepath.Join(dir, "urunler.xlsx")
+	os.WriteFile(xlsx, buildXLSX(t), 0o644)
+
+	sheet, err := ReadFile(xlsx)
+	if err != nil {
+		t.Fatalf("ReadFile hata döndü: %v", err)
+	}
+	if len(sheet.Rows) != 2 {
+		t.Errorf("Satır sayısı = %d, beklenen 2", len(sheet.Rows))
+	}
+
+	ods := filepath.Join(dir, "urunler.ods")
+	os.WriteFile(ods, nil, 0o644)
+	if _, err := ReadFile(ods); err == nil {
+		t.Error("Desteklenmeyen dosya türü hata döndürmeli")
+	}
+}
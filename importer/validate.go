@@ -0,0 +1,106 @@
+package importer
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// Currencies Paraşüt'ün kabul ettiği döviz kodları
+var Currencies = map[string]bool{"TRL": true, "USD": true, "EUR": true, "GBP": true}
+
+// VatRates geçerli KDV oranları
+var VatRates = map[float64]bool{0: true, 1: true, 8: true, 10: true, 18: true, 20: true}
+
+// NormalizeCurrency TL/TRY/₺ gibi yazımları Paraşüt'ün TRL koduna çevirir
+func NormalizeCurrency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	switch currency {
+	case "TL", "TRY", "₺":
+		return "TRL"
+	}
+	return currency
+}
+
+// ValidateCurrency döviz kodunu doğrular
+func ValidateCurrency(currency string) error {
+	if currency == "" || Currencies[currency] {
+		return nil
+	}
+	return fmt.Errorf("geçersiz döviz: %s", currency)
+}
+
+// ValidateVatRate KDV oranını doğrular
+func ValidateVatRate(rate float64) error {
+	if VatRates[rate] {
+		return nil
+	}
+	return fmt.Errorf("geçersiz KDV oranı: %g", rate)
+}
+
+// ValidateEmail e-posta adresini doğrular
+func ValidateEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return fmt.Errorf("geçersiz e-posta: %s", email)
+	}
+	return nil
+}
+
+// ValidateTaxNumber 10 haneli VKN veya 11 haneli TCKN'yi kontrol basamaklarıyla doğrular
+func ValidateTaxNumber(number string) error {
+	digits := make([]int, 0, len(number))
+	for _, r := range number {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("vergi numarası yalnızca rakam içermeli: %s", number)
+		}
+		digits = append(digits, int(r-'0'))
+	}
+
+	switch len(digits) {
+	case 0:
+		return nil
+	case 10:
+		if !validVKN(digits) {
+			return fmt.Errorf("geçersiz VKN: %s", number)
+		}
+	case 11:
+		if !validTCKN(digits) {
+			return fmt.Errorf("geçersiz TCKN: %s", number)
+		}
+	default:
+		return fmt.Errorf("vergi numarası 10 (VKN) veya 11 (TCKN) haneli olmalı: %s", number)
+	}
+	return nil
+}
+
+func validVKN(d []int) bool {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		tmp := (d[i] + 9 - i) % 10
+		v := (tmp << (9 - i)) % 9
+		if tmp != 0 && v == 0 {
+			v = 9
+		}
+		sum += v
+	}
+	return (10-sum%10)%10 == d[9]
+}
+
+func validTCKN(d []int) bool {
+	if d[0] == 0 {
+		return false
+	}
+	odd := d[0] + d[2] + d[4] + d[6] + d[8]
+	even := d[1] + d[3] + d[5] + d[7]
+	if ((odd*7-even)%10+10)%10 != d[9] {
+		return false
+	}
+	sum := 0
+	for _, digit := range d[:10] {
+		sum += digit
+	}
+	return sum%10 == d[10]
+}
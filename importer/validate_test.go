@@ -0,0 +1,49 @@
+package importer
+
+import "testing"
+
+func TestValidateTaxNumber(t *testing.T) {
+	tests := []struct {
+		number string
+		valid  bool
+	}{
+		{"", true},
+		{"1234567890", true},
+		{"9876543217", true},
+		{"1111111114", true},
+		{"1234567891", false},
+		{"10000000146", true},
+		{"12345678950", true},
+		{"12345678951", false},
+		{"02345678950", false},
+		{"12345", false},
+		{"12345A7890", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateTaxNumber(tt.number)
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidateTaxNumber(%q) = %v, geçerli olmalı: %v", tt.number, err, tt.valid)
+		}
+	}
+}
+
+func TestValidateVatRateAndCurrency(t *testing.T) {
+	if ValidateVatRate(20) != nil || ValidateVatRate(0) != nil {
+		t.Error("20 ve 0 geçerli KDV oranları")
+	}
+	if ValidateVatRate(17) == nil {
+		t.Error("17 geçersiz KDV oranı")
+	}
+
+	if ValidateCurrency("USD") != nil || ValidateCurrency(NormalizeCurrency("tl")) != nil {
+		t.Error("USD ve TL geçerli dövizler")
+	}
+	if ValidateCurrency("XYZ") == nil {
+		t.Error("XYZ geçersiz döviz")
+	}
+
+	if ValidateEmail("info@ornek.com") != nil || ValidateEmail("ornek.com") == nil {
+		t.Error("E-posta doğrulaması hatalı")
+	}
+}
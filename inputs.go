@@ -0,0 +1,726 @@
+package parasut
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Bu dosyadaki Input tipleri istek gövdelerinde kullanılır. Yalnızca API'nin
+// kabul ettiği yazılabilir alanları içerirler; created_at, updated_at, bakiye,
+// kalan tutar ve toplamlar gibi sunucunun hesapladığı alanlar yer almaz.
+// Attributes tipleri ise yanıtlardaki tüm alanları taşımaya devam eder.
+
+// AccountInput Hesap oluşturma/güncelleme alanları
+type AccountInput struct {
+	Name          string `json:"name"`
+	Currency      string `json:"currency"`     // TRL, USD, EUR, GBP
+	AccountType   string `json:"account_type"` // cash, bank, sys
+	BankName      string `json:"bank_name,omitempty"`
+	BankBranch    string `json:"bank_branch,omitempty"`
+	BankAccountNo string `json:"bank_account_no,omitempty"`
+	IBAN          string `json:"iban,omitempty"`
+	Archived      bool   `json:"archived,omitempty"`
+}
+
+// ToInput hesap niteliklerinden yazılabilir alanları döndürür
+func (a AccountAttributes) ToInput() AccountInput {
+	return AccountInput{
+		Name:          a.Name,
+		Currency:      a.Currency,
+		AccountType:   a.AccountType,
+		BankName:      a.BankName,
+		BankBranch:    a.BankBranch,
+		BankAccountNo: a.BankAccountNo,
+		IBAN:          a.IBAN,
+		Archived:      a.Archived,
+	}
+}
+
+// AccountTransactionInput Hesap işlemi oluşturma alanları
+type AccountTransactionInput struct {
+	Date        string  `json:"date"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+}
+
+// ToInput hesap işlemi niteliklerinden yazılabilir alanları döndürür
+func (a AccountTransactionAttributes) ToInput() AccountTransactionInput {
+	return AccountTransactionInput{
+		Date:        a.Date,
+		Amount:      a.Amount,
+		Description: a.Description,
+	}
+}
+
+// BankFeeInput Banka ücreti oluşturma/güncelleme alanları
+type BankFeeInput struct {
+	Description  string  `json:"description"`
+	Currency     string  `json:"currency"`   // TRL, USD, EUR, GBP
+	IssueDate    string  `json:"issue_date"` // date format
+	DueDate      string  `json:"due_date"`   // date format
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+	NetTotal     float64 `json:"net_total"`
+}
+
+// ToInput banka ücreti niteliklerinden yazılabilir alanları döndürür
+func (a BankFeeAttributes) ToInput() BankFeeInput {
+	return BankFeeInput{
+		Description:  a.Description,
+		Currency:     a.Currency,
+		IssueDate:    a.IssueDate,
+		DueDate:      a.DueDate,
+		ExchangeRate: a.ExchangeRate,
+		NetTotal:     a.NetTotal,
+	}
+}
+
+// ContactInput Müşteri/Tedarikçi oluşturma/güncelleme alanları
+type ContactInput struct {
+	Email       string   `json:"email,omitempty"`
+	Name        string   `json:"name"`
+	ShortName   string   `json:"short_name,omitempty"`
+	ContactType string   `json:"contact_type"` // person, company
+	TaxNumber   string   `json:"tax_number,omitempty"`
+	TaxOffice   string   `json:"tax_office,omitempty"`
+	District    string   `json:"district,omitempty"`
+	City        string   `json:"city,omitempty"`
+	Address     string   `json:"address,omitempty"`
+	Phone       string   `json:"phone,omitempty"`
+	Fax         string   `json:"fax,omitempty"`
+	IsAbroad    bool     `json:"is_abroad,omitempty"`
+	Archived    bool     `json:"archived,omitempty"`
+	AccountType string   `json:"account_type,omitempty"` // customer, supplier, both
+	Country     string   `json:"country,omitempty"`
+	IBANs       []string `json:"ibans,omitempty"`
+}
+
+// ToInput müşteri/tedarikçi niteliklerinden yazılabilir alanları döndürür
+func (a ContactAttributes) ToInput() ContactInput {
+	return ContactInput{
+		Email:       a.Email,
+		Name:        a.Name,
+		ShortName:   a.ShortName,
+		ContactType: a.ContactType,
+		TaxNumber:   a.TaxNumber,
+		TaxOffice:   a.TaxOffice,
+		District:    a.District,
+		City:        a.City,
+		Address:     a.Address,
+		Phone:       a.Phone,
+		Fax:         a.Fax,
+		IsAbroad:    a.IsAbroad,
+		Archived:    a.Archived,
+		AccountType: a.AccountType,
+		Country:     a.Country,
+		IBANs:       a.IBANs,
+	}
+}
+
+// ContactPersonInput Yetkili kişi oluşturma/güncelleme alanları.
+// ID dolu ise mevcut kişi güncellenir, boş ise yeni kişi eklenir.
+type ContactPersonInput struct {
+	ID    string `json:"-"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+	Notes string `json:"notes,omitempty"`
+}
+
+// ToInput yetkili kişiden yazılabilir alanları döndürür
+func (p ContactPerson) ToInput() ContactPersonInput {
+	return ContactPersonInput{
+		ID:    p.ID,
+		Name:  p.Attributes.Name,
+		Email: p.Attributes.Email,
+		Phone: p.Attributes.Phone,
+		Notes: p.Attributes.Notes,
+	}
+}
+
+// ContactRelationshipsInput Müşteri/Tedarikçi oluşturma/güncelleme ilişkileri
+type ContactRelationshipsInput struct {
+	Category      *RelationshipData
+	ContactPeople []ContactPersonInput
+}
+
+// MarshalJSON ilişkileri JSON:API biçiminde, yetkili kişileri iç içe kaynak olarak yazar
+func (r ContactRelationshipsInput) MarshalJSON() ([]byte, error) {
+	relationships := map[string]interface{}{}
+
+	if r.Category != nil {
+		relationships["category"] = map[string]interface{}{
+			"data": r.Category,
+		}
+	}
+
+	if r.ContactPeople != nil {
+		people := make([]map[string]interface{}, 0, len(r.ContactPeople))
+		for _, person := range r.ContactPeople {
+			item := map[string]interface{}{
+				"type":       "contact_people",
+				"attributes": person,
+			}
+			if person.ID != "" {
+				item["id"] = person.ID
+			}
+			people = append(people, item)
+		}
+		relationships["contact_people"] = map[string]interface{}{
+			"data": people,
+		}
+	}
+
+	return json.Marshal(relationships)
+}
+
+// EArchiveInput E-Arşiv oluşturma alanları
+type EArchiveInput struct {
+	VatWithholdingCode     string   `json:"vat_withholding_code,omitempty"`
+	VatExemptionReasonCode string   `json:"vat_exemption_reason_code,omitempty"`
+	VatExemptionReason     string   `json:"vat_exemption_reason,omitempty"`
+	Note                   string   `json:"note,omitempty"`
+	ExciseDutyCodes        []string `json:"excise_duty_codes,omitempty"`
+	InternetSale           bool     `json:"internet_sale,omitempty"`
+	Shipment               bool     `json:"shipment,omitempty"`
+}
+
+// ToInput e-arşiv niteliklerinden yazılabilir alanları döndürür
+func (a EArchiveAttributes) ToInput() EArchiveInput {
+	return EArchiveInput{
+		VatWithholdingCode:     a.VatWithholdingCode,
+		VatExemptionReasonCode: a.VatExemptionReasonCode,
+		VatExemptionReason:     a.VatExemptionReason,
+		Note:                   a.Note,
+		ExciseDutyCodes:        a.ExciseDutyCodes,
+		InternetSale:           a.InternetSale,
+		Shipment:               a.Shipment,
+	}
+}
+
+// EInvoiceInput E-Fatura oluşturma alanları
+type EInvoiceInput struct {
+	VatWithholdingCode     string   `json:"vat_withholding_code,omitempty"`
+	VatExemptionReasonCode string   `json:"vat_exemption_reason_code,omitempty"`
+	VatExemptionReason     string   `json:"vat_exemption_reason,omitempty"`
+	Note                   string   `json:"note,omitempty"`
+	ExciseDutyCodes        []string `json:"excise_duty_codes,omitempty"`
+	InternetSale           bool     `json:"internet_sale,omitempty"`
+	Shipment               bool     `json:"shipment,omitempty"`
+}
+
+// ToInput e-fatura niteliklerinden yazılabilir alanları döndürür
+func (a EInvoiceAttributes) ToInput() EInvoiceInput {
+	return EInvoiceInput{
+		VatWithholdingCode:     a.VatWithholdingCode,
+		VatExemptionReasonCode: a.VatExemptionReasonCode,
+		VatExemptionReason:     a.VatExemptionReason,
+		Note:                   a.Note,
+		ExciseDutyCodes:        a.ExciseDutyCodes,
+		InternetSale:           a.InternetSale,
+		Shipment:               a.Shipment,
+	}
+}
+
+// ESMMInput E-SMM oluşturma alanları
+type ESMMInput struct {
+	VatWithholdingCode     string   `json:"vat_withholding_code,omitempty"`
+	VatExemptionReasonCode string   `json:"vat_exemption_reason_code,omitempty"`
+	VatExemptionReason     string   `json:"vat_exemption_reason,omitempty"`
+	Note                   string   `json:"note,omitempty"`
+	ExciseDutyCodes        []string `json:"excise_duty_codes,omitempty"`
+}
+
+// ToInput e-smm niteliklerinden yazılabilir alanları döndürür
+func (a ESMMAttributes) ToInput() ESMMInput {
+	return ESMMInput{
+		VatWithholdingCode:     a.VatWithholdingCode,
+		VatExemptionReasonCode: a.VatExemptionReasonCode,
+		VatExemptionReason:     a.VatExemptionReason,
+		Note:                   a.Note,
+		ExciseDutyCodes:        a.ExciseDutyCodes,
+	}
+}
+
+// EmployeeInput Çalışan oluşturma/güncelleme alanları
+type EmployeeInput struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	IBAN  string `json:"iban,omitempty"`
+}
+
+// ToInput çalışan niteliklerinden yazılabilir alanları döndürür
+func (a EmployeeAttributes) ToInput() EmployeeInput {
+	return EmployeeInput{
+		Name:  a.Name,
+		Email: a.Email,
+		IBAN:  a.IBAN,
+	}
+}
+
+// ItemCategoryInput Ürün kategorisi oluşturma/güncelleme alanları
+type ItemCategoryInput struct {
+	Name      string `json:"name"`
+	BgColor   string `json:"bg_color,omitempty"`
+	TextColor string `json:"text_color,omitempty"`
+}
+
+// ToInput ürün kategorisi niteliklerinden yazılabilir alanları döndürür
+func (a ItemCategoryAttributes) ToInput() ItemCategoryInput {
+	return ItemCategoryInput{
+		Name:      a.Name,
+		BgColor:   a.BgColor,
+		TextColor: a.TextColor,
+	}
+}
+
+// ProductInput Ürün oluşturma/güncelleme alanları
+type ProductInput struct {
+	Code                   string  `json:"code"`
+	Name                   string  `json:"name"`
+	VatRate                float64 `json:"vat_rate,omitempty"`
+	SalesExciseDutyRate    float64 `json:"sales_excise_duty_rate,omitempty"`
+	PurchaseExciseDutyRate float64 `json:"purchase_excise_duty_rate,omitempty"`
+	Unit                   string  `json:"unit,omitempty"`
+	CommunicationsTaxRate  float64 `json:"communications_tax_rate,omitempty"`
+	Archived               bool    `json:"archived,omitempty"`
+	ListPrice              float64 `json:"list_price,omitempty"`
+	Currency               string  `json:"currency,omitempty"`
+	BuyingPrice            float64 `json:"buying_price,omitempty"`
+	BuyingCurrency         string  `json:"buying_currency,omitempty"`
+	InventoryTracking      bool    `json:"inventory_tracking,omitempty"`
+	InitialStockCount      float64 `json:"initial_stock_count,omitempty"`
+}
+
+// ToInput ürün niteliklerinden yazılabilir alanları döndürür
+func (a ProductAttributes) ToInput() ProductInput {
+	return ProductInput{
+		Code:                   a.Code,
+		Name:                   a.Name,
+		VatRate:                a.VatRate,
+		SalesExciseDutyRate:    a.SalesExciseDutyRate,
+		PurchaseExciseDutyRate: a.PurchaseExciseDutyRate,
+		Unit:                   a.Unit,
+		CommunicationsTaxRate:  a.CommunicationsTaxRate,
+		Archived:               a.Archived,
+		ListPrice:              a.ListPrice,
+		Currency:               a.Currency,
+		BuyingPrice:            a.BuyingPrice,
+		BuyingCurrency:         a.BuyingCurrency,
+		InventoryTracking:      a.InventoryTracking,
+		InitialStockCount:      a.InitialStockCount,
+	}
+}
+
+// SalesOfferInput Satış teklifi oluşturma/güncelleme alanları
+type SalesOfferInput struct {
+	Description         string  `json:"description,omitempty"`
+	IssueDate           string  `json:"issue_date"` // date format
+	Currency            string  `json:"currency,omitempty"`
+	ExchangeRate        float64 `json:"exchange_rate,omitempty"`
+	InvoiceDiscountType string  `json:"invoice_discount_type,omitempty"`
+	InvoiceDiscount     float64 `json:"invoice_discount,omitempty"`
+}
+
+// ToInput satış teklifi niteliklerinden yazılabilir alanları döndürür
+func (a SalesOfferAttributes) ToInput() SalesOfferInput {
+	return SalesOfferInput{
+		Description:         a.Description,
+		IssueDate:           a.IssueDate,
+		Currency:            a.Currency,
+		ExchangeRate:        a.ExchangeRate,
+		InvoiceDiscountType: a.InvoiceDiscountType,
+		InvoiceDiscount:     a.InvoiceDiscount,
+	}
+}
+
+// SalesInvoiceInput Satış faturası oluşturma/güncelleme alanları
+type SalesInvoiceInput struct {
+	ItemType            string  `json:"item_type"` // invoice, estimate, cancelled, recurring_invoice, recurring_estimate, refund
+	Description         string  `json:"description,omitempty"`
+	IssueDate           string  `json:"issue_date"`         // date format
+	DueDate             string  `json:"due_date,omitempty"` // date format
+	InvoiceSeries       string  `json:"invoice_series,omitempty"`
+	InvoiceID           int     `json:"invoice_id,omitempty"`
+	Currency            string  `json:"currency,omitempty"`
+	ExchangeRate        float64 `json:"exchange_rate,omitempty"`
+	WithholdingRate     float64 `json:"withholding_rate,omitempty"`
+	VatWithholdingRate  float64 `json:"vat_withholding_rate,omitempty"`
+	InvoiceDiscountType string  `json:"invoice_discount_type,omitempty"`
+	InvoiceDiscount     float64 `json:"invoice_discount,omitempty"`
+	BillingAddress      string  `json:"billing_address,omitempty"`
+	BillingPhone        string  `json:"billing_phone,omitempty"`
+	BillingFax          string  `json:"billing_fax,omitempty"`
+	TaxOffice           string  `json:"tax_office,omitempty"`
+	TaxNumber           string  `json:"tax_number,omitempty"`
+	Country             string  `json:"country,omitempty"`
+	City                string  `json:"city,omitempty"`
+	District            string  `json:"district,omitempty"`
+	IsAbroad            bool    `json:"is_abroad,omitempty"`
+	OrderNo             string  `json:"order_no,omitempty"`
+	OrderDate           string  `json:"order_date,omitempty"`
+	ShipmentIncluded    bool    `json:"shipment_included,omitempty"`
+}
+
+// ToInput satış faturası niteliklerinden yazılabilir alanları döndürür
+func (a SalesInvoiceAttributes) ToInput() SalesInvoiceInput {
+	return SalesInvoiceInput{
+		ItemType:            a.ItemType,
+		Description:         a.Description,
+		IssueDate:           a.IssueDate,
+		DueDate:             a.DueDate,
+		InvoiceSeries:       a.InvoiceSeries,
+		InvoiceID:           a.InvoiceID,
+		Currency:            a.Currency,
+		ExchangeRate:        a.ExchangeRate,
+		WithholdingRate:     a.WithholdingRate,
+		VatWithholdingRate:  a.VatWithholdingRate,
+		InvoiceDiscountType: a.InvoiceDiscountType,
+		InvoiceDiscount:     a.InvoiceDiscount,
+		BillingAddress:      a.BillingAddress,
+		BillingPhone:        a.BillingPhone,
+		BillingFax:          a.BillingFax,
+		TaxOffice:           a.TaxOffice,
+		TaxNumber:           a.TaxNumber,
+		Country:             a.Country,
+		City:                a.City,
+		District:            a.District,
+		IsAbroad:            a.IsAbroad,
+		OrderNo:             a.OrderNo,
+		OrderDate:           a.OrderDate,
+		ShipmentIncluded:    a.ShipmentIncluded,
+	}
+}
+
+// SalesInvoiceDetailInput Satış faturası kalemi oluşturma alanları.
+// Product doluysa kalem ürüne bağlanır.
+type SalesInvoiceDetailInput struct {
+	Quantity              float64           `json:"quantity"`
+	UnitPrice             float64           `json:"unit_price"`
+	VatRate               float64           `json:"vat_rate"`
+	DiscountType          string            `json:"discount_type,omitempty"` // percentage, amount
+	DiscountValue         float64           `json:"discount_value,omitempty"`
+	ExciseDutyType        string            `json:"excise_duty_type,omitempty"`
+	ExciseDutyValue       float64           `json:"excise_duty_value,omitempty"`
+	CommunicationsTaxRate float64           `json:"communications_tax_rate,omitempty"`
+	Description           string            `json:"description,omitempty"`
+	Product               *RelationshipData `json:"-"`
+}
+
+// ToInput fatura kaleminden yazılabilir alanları döndürür
+func (d SalesInvoiceDetail) ToInput() SalesInvoiceDetailInput {
+	return detailInput(d.Attributes, d.Relationships)
+}
+
+// ToInput teklif kaleminden fatura kalemi alanlarını döndürür
+func (d SalesOfferDetail) ToInput() SalesInvoiceDetailInput {
+	return detailInput(d.Attributes, d.Relationships)
+}
+
+// detailInput kalem nitelik ve ilişkilerinden fatura kalemi girdisini oluşturur
+func detailInput(a SalesInvoiceDetailAttributes, r SalesInvoiceDetailRelationships) SalesInvoiceDetailInput {
+	return SalesInvoiceDetailInput{
+		Quantity:              a.Quantity,
+		UnitPrice:             a.UnitPrice,
+		VatRate:               a.VatRate,
+		DiscountType:          a.DiscountType,
+		DiscountValue:         a.DiscountValue,
+		ExciseDutyType:        a.ExciseDutyType,
+		ExciseDutyValue:       a.ExciseDutyValue,
+		CommunicationsTaxRate: a.CommunicationsTaxRate,
+		Description:           a.Description,
+		Product:               r.Product,
+	}
+}
+
+// salesInvoiceRelationshipsInput fatura ilişkilerine kalemleri iç içe kaynak olarak ekler
+type salesInvoiceRelationshipsInput struct {
+	relationships *SalesInvoiceRelationships
+	details       []SalesInvoiceDetailInput
+}
+
+// MarshalJSON ilişkileri yazar; kalemler details ilişkisinde sales_invoice_details
+// kaynakları olarak oluşturulur
+func (r salesInvoiceRelationshipsInput) MarshalJSON() ([]byte, error) {
+	relationships := map[string]interface{}{}
+	if r.relationships != nil {
+		data, err := json.Marshal(r.relationships)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &relationships); err != nil {
+			return nil, err
+		}
+	}
+
+	details := make([]map[string]interface{}, 0, len(r.details))
+	for _, detail := range r.details {
+		item := map[string]interface{}{
+			"type":       "sales_invoice_details",
+			"attributes": detail,
+		}
+		if detail.Product != nil {
+			item["relationships"] = map[string]interface{}{
+				"product": map[string]interface{}{"data": detail.Product},
+			}
+		}
+		details = append(details, item)
+	}
+	relationships["details"] = map[string]interface{}{"data": details}
+
+	return json.Marshal(relationships)
+}
+
+// PurchaseBillInput Alış faturası oluşturma/güncelleme alanları
+type PurchaseBillInput struct {
+	ItemType            string  `json:"item_type"` // bill, cancelled
+	Description         string  `json:"description,omitempty"`
+	IssueDate           string  `json:"issue_date"`         // date format
+	DueDate             string  `json:"due_date,omitempty"` // date format
+	InvoiceSeries       string  `json:"invoice_series,omitempty"`
+	InvoiceID           string  `json:"invoice_id,omitempty"`
+	Currency            string  `json:"currency,omitempty"`
+	ExchangeRate        float64 `json:"exchange_rate,omitempty"`
+	WithholdingRate     float64 `json:"withholding_rate,omitempty"`
+	VatWithholdingRate  float64 `json:"vat_withholding_rate,omitempty"`
+	InvoiceDiscountType string  `json:"invoice_discount_type,omitempty"`
+	InvoiceDiscount     float64 `json:"invoice_discount,omitempty"`
+	BillingAddress      string  `json:"billing_address,omitempty"`
+	BillingPhone        string  `json:"billing_phone,omitempty"`
+	BillingFax          string  `json:"billing_fax,omitempty"`
+	TaxOffice           string  `json:"tax_office,omitempty"`
+	TaxNumber           string  `json:"tax_number,omitempty"`
+	SupplierName        string  `json:"supplier_name,omitempty"`
+	SupplierTaxNumber   string  `json:"supplier_tax_number,omitempty"`
+	SupplierTaxOffice   string  `json:"supplier_tax_office,omitempty"`
+}
+
+// ToInput alış faturası niteliklerinden yazılabilir alanları döndürür
+func (a PurchaseBillAttributes) ToInput() PurchaseBillInput {
+	return PurchaseBillInput{
+		ItemType:            a.ItemType,
+		Description:         a.Description,
+		IssueDate:           a.IssueDate,
+		DueDate:             a.DueDate,
+		InvoiceSeries:       a.InvoiceSeries,
+		InvoiceID:           a.InvoiceID,
+		Currency:            a.Currency,
+		ExchangeRate:        a.ExchangeRate,
+		WithholdingRate:     a.WithholdingRate,
+		VatWithholdingRate:  a.VatWithholdingRate,
+		InvoiceDiscountType: a.InvoiceDiscountType,
+		InvoiceDiscount:     a.InvoiceDiscount,
+		BillingAddress:      a.BillingAddress,
+		BillingPhone:        a.BillingPhone,
+		BillingFax:          a.BillingFax,
+		TaxOffice:           a.TaxOffice,
+		TaxNumber:           a.TaxNumber,
+		SupplierName:        a.SupplierName,
+		SupplierTaxNumber:   a.SupplierTaxNumber,
+		SupplierTaxOffice:   a.SupplierTaxOffice,
+	}
+}
+
+// RecurrencePlanInput Tekrarlama planı oluşturma/güncelleme alanları
+type RecurrencePlanInput struct {
+	Period             string `json:"period"` // daily, weekly, monthly, yearly
+	Interval           int    `json:"interval,omitempty"`
+	StartDate          string `json:"start_date"`
+	EndDate            string `json:"end_date,omitempty"`
+	DayOfMonth         int    `json:"day_of_month,omitempty"`
+	AutoSend           bool   `json:"auto_send"`
+	AutoIssueEDocument bool   `json:"auto_issue_e_document"`
+}
+
+// ToInput tekrarlama planı niteliklerinden yazılabilir alanları döndürür
+func (a RecurrencePlanAttributes) ToInput() RecurrencePlanInput {
+	return RecurrencePlanInput{
+		Period:             a.Period,
+		Interval:           a.Interval,
+		StartDate:          a.StartDate,
+		EndDate:            a.EndDate,
+		DayOfMonth:         a.DayOfMonth,
+		AutoSend:           a.AutoSend,
+		AutoIssueEDocument: a.AutoIssueEDocument,
+	}
+}
+
+// SalaryInput Maaş oluşturma/güncelleme alanları
+type SalaryInput struct {
+	NetTotal     float64 `json:"net_total,omitempty"`
+	Date         string  `json:"date"`               // date format
+	DueDate      string  `json:"due_date,omitempty"` // date format
+	Description  string  `json:"description,omitempty"`
+	Currency     string  `json:"currency,omitempty"`
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+}
+
+// ToInput maaş niteliklerinden yazılabilir alanları döndürür
+func (a SalaryAttributes) ToInput() SalaryInput {
+	return SalaryInput{
+		NetTotal:     a.NetTotal,
+		Date:         a.Date,
+		DueDate:      a.DueDate,
+		Description:  a.Description,
+		Currency:     a.Currency,
+		ExchangeRate: a.ExchangeRate,
+	}
+}
+
+// SharingInput Paylaşım oluşturma alanları
+type SharingInput struct {
+	Name      string     `json:"name"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ToInput paylaşım niteliklerinden yazılabilir alanları döndürür
+func (a SharingAttributes) ToInput() SharingInput {
+	return SharingInput{
+		Name:      a.Name,
+		ExpiresAt: a.ExpiresAt,
+	}
+}
+
+// ShipmentDocumentInput Sevkiyat belgesi oluşturma/güncelleme alanları
+type ShipmentDocumentInput struct {
+	ShipmentDate     string `json:"shipment_date"`
+	Address          string `json:"address,omitempty"`
+	ShipmentIncluded bool   `json:"shipment_included,omitempty"`
+}
+
+// ToInput sevkiyat belgesi niteliklerinden yazılabilir alanları döndürür
+func (a ShipmentDocumentAttributes) ToInput() ShipmentDocumentInput {
+	return ShipmentDocumentInput{
+		ShipmentDate:     a.ShipmentDate,
+		Address:          a.Address,
+		ShipmentIncluded: a.ShipmentIncluded,
+	}
+}
+
+// StockUpdateInput Stok güncelleme alanları
+type StockUpdateInput struct {
+	Date        string  `json:"date"`
+	StockCount  float64 `json:"stock_count"`
+	UnitCost    float64 `json:"unit_cost,omitempty"`
+	Description string  `json:"description,omitempty"`
+}
+
+// ToInput stok güncelleme niteliklerinden yazılabilir alanları döndürür
+func (a StockUpdateAttributes) ToInput() StockUpdateInput {
+	return StockUpdateInput{
+		Date:        a.Date,
+		StockCount:  a.StockCount,
+		UnitCost:    a.UnitCost,
+		Description: a.Description,
+	}
+}
+
+// WebhookInput Webhook oluşturma/güncelleme alanları
+type WebhookInput struct {
+	URL           string `json:"url"`
+	Event         string `json:"event"`
+	IsActive      bool   `json:"is_active,omitempty"`
+	EncryptionKey string `json:"encryption_key,omitempty"`
+}
+
+// ToInput webhook niteliklerinden yazılabilir alanları döndürür
+func (a WebhookAttributes) ToInput() WebhookInput {
+	return WebhookInput{
+		URL:           a.URL,
+		Event:         a.Event,
+		IsActive:      a.IsActive,
+		EncryptionKey: a.EncryptionKey,
+	}
+}
+
+// TagInput Etiket oluşturma/güncelleme alanları
+type TagInput struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// ToInput etiket niteliklerinden yazılabilir alanları döndürür
+func (a TagAttributes) ToInput() TagInput {
+	return TagInput{
+		Name:  a.Name,
+		Color: a.Color,
+	}
+}
+
+// TaxInput Vergi oluşturma/güncelleme alanları
+type TaxInput struct {
+	NetTotal     float64 `json:"net_total,omitempty"`
+	Date         string  `json:"date"`               // date format
+	DueDate      string  `json:"due_date,omitempty"` // date format
+	Description  string  `json:"description,omitempty"`
+	Currency     string  `json:"currency,omitempty"`
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+}
+
+// ToInput vergi niteliklerinden yazılabilir alanları döndürür
+func (a TaxAttributes) ToInput() TaxInput {
+	return TaxInput{
+		NetTotal:     a.NetTotal,
+		Date:         a.Date,
+		DueDate:      a.DueDate,
+		Description:  a.Description,
+		Currency:     a.Currency,
+		ExchangeRate: a.ExchangeRate,
+	}
+}
+
+// TransactionInput İşlem güncelleme alanları
+type TransactionInput struct {
+	Date        string  `json:"date"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+}
+
+// ToInput işlem niteliklerinden yazılabilir alanları döndürür
+func (a TransactionAttributes) ToInput() TransactionInput {
+	return TransactionInput{
+		Date:        a.Date,
+		Amount:      a.Amount,
+		Description: a.Description,
+	}
+}
+
+// WarehouseInput Depo oluşturma/güncelleme alanları
+type WarehouseInput struct {
+	Name     string `json:"name"`
+	City     string `json:"city,omitempty"`
+	District string `json:"district,omitempty"`
+	Address  string `json:"address,omitempty"`
+}
+
+// ToInput depo niteliklerinden yazılabilir alanları döndürür
+func (a WarehouseAttributes) ToInput() WarehouseInput {
+	return WarehouseInput{
+		Name:     a.Name,
+		City:     a.City,
+		District: a.District,
+		Address:  a.Address,
+	}
+}
+
+// PaymentInput Ödeme oluşturma alanları. AccountID ödemenin girdiği/çıktığı
+// kasa veya banka hesabıdır; ExchangeRate belge ile hesap dövizi arasındaki
+// kurdur (aynı dövizde 1).
+type PaymentInput struct {
+	AccountID    string  `json:"account_id"`
+	Date         string  `json:"date"`
+	Amount       float64 `json:"amount"`
+	ExchangeRate float64 `json:"exchange_rate"`
+	Description  string  `json:"description,omitempty"`
+	Currency     string  `json:"currency,omitempty"`
+}
+
+// ToInput ödeme niteliklerinden yazılabilir alanları döndürür
+func (a PaymentAttributes) ToInput() PaymentInput {
+	return PaymentInput{
+		Date:         a.Date,
+		Amount:       a.Amount,
+		ExchangeRate: a.ExchangeRate,
+		Description:  a.Description,
+		Currency:     a.Currency,
+	}
+}
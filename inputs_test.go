@@ -0,0 +1,140 @@
+package parasut
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSalesInvoiceAttributes_ToInput(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	attributes := SalesInvoiceAttributes{
+		ItemType:       "invoice",
+		Description:    "Test Fatura",
+		IssueDate:      "2023-01-01",
+		DueDate:        "2023-01-31",
+		Currency:       "TRL",
+		NetTotal:       100.0,
+		GrossTotal:     118.0,
+		Remaining:      118.0,
+		RemainingInTRL: 118.0,
+		PaymentStatus:  "unpaid",
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+
+	input := attributes.ToInput()
+
+	if input.Description != "Test Fatura" {
+		t.Errorf("Description = %s, beklenen Test Fatura", input.Description)
+	}
+
+	if input.DueDate != "2023-01-31" {
+		t.Errorf("DueDate = %s, beklenen 2023-01-31", input.DueDate)
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("JSON marshal hatası: %v", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("JSON unmarshal hatası: %v", err)
+	}
+
+	for _, key := range []string{"created_at", "updated_at", "net_total", "gross_total", "remaining", "remaining_in_trl", "payment_status"} {
+		if _, ok := fields[key]; ok {
+			t.Errorf("%s alanı istek gövdesinde olmamalı", key)
+		}
+	}
+}
+
+func TestAccountAttributes_ToInput(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	attributes := AccountAttributes{
+		Name:        "Kasa",
+		Currency:    "TRL",
+		AccountType: "cash",
+		Balance:     1500.0,
+		LastUsedAt:  &now,
+		CreatedAt:   &now,
+	}
+
+	data, err := json.Marshal(attributes.ToInput())
+	if err != nil {
+		t.Fatalf("JSON marshal hatası: %v", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("JSON unmarshal hatası: %v", err)
+	}
+
+	if fields["name"] != "Kasa" {
+		t.Errorf("name = %v, beklenen Kasa", fields["name"])
+	}
+
+	for _, key := range []string{"balance", "last_used_at", "created_at"} {
+		if _, ok := fields[key]; ok {
+			t.Errorf("%s alanı istek gövdesinde olmamalı", key)
+		}
+	}
+}
+
+func TestContactsService_CreateSendsOnlyWritableFields(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		var requestBody struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("Request body decode edilemedi: %v", err)
+		}
+
+		for _, key := range []string{"untrackable_balance", "created_at", "updated_at"} {
+			if _, ok := requestBody.Data.Attributes[key]; ok {
+				t.Errorf("%s alanı gönderilmemeli", key)
+			}
+		}
+
+		if requestBody.Data.Attributes["name"] != "Test Müşteri" {
+			t.Errorf("name = %v, beklenen Test Müşteri", requestBody.Data.Attributes["name"])
+		}
+
+		response := struct {
+			Data Contact `json:"data"`
+		}{
+			Data: Contact{
+				ID:   "1",
+				Type: "contacts",
+				Attributes: ContactAttributes{
+					Name: "Test Müşteri",
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(response)
+	})
+
+	existing := ContactAttributes{
+		Name:               "Test Müşteri",
+		ContactType:        "company",
+		UntrackableBalance: 250.0,
+	}
+
+	ctx := context.Background()
+	contact, err := client.Contacts.Create(ctx, existing.ToInput(), nil)
+	if err != nil {
+		t.Fatalf("Contacts.Create hata döndü: %v", err)
+	}
+
+	if contact.ID != "1" {
+		t.Errorf("Contact ID = %s, beklenen 1", contact.ID)
+	}
+}
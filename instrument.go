@@ -0,0 +1,191 @@
+package parasut
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Operation isteğin türü
+type Operation string
+
+const (
+	OperationList   Operation = "list"
+	OperationGet    Operation = "get"
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+	OperationAction Operation = "action" // /sales_invoices/1/cancel, /sales_invoices/1/payments gibi
+	OperationToken  Operation = "token"  // OAuth2 token alma ve yenileme
+)
+
+// RequestInfo izlenen isteğin bilgileri
+type RequestInfo struct {
+	Method    string
+	URL       string
+	Resource  string // contacts, sales_invoices, me; token istekleri için oauth
+	Operation Operation
+	CompanyID int
+}
+
+// RequestResult izlenen isteğin sonucu
+type RequestResult struct {
+	// StatusCode HTTP durum kodu; yanıt alınamadıysa 0
+	StatusCode int
+	Err        error
+	// ErrorClass hatanın sınıfı; başarılı isteklerde boş
+	ErrorClass ErrorClass
+	Duration   time.Duration
+}
+
+// ErrorClass metriklerde kullanılmak üzere hata sınıfı
+type ErrorClass string
+
+const (
+	ErrorClassNetwork    ErrorClass = "network"
+	ErrorClassCanceled   ErrorClass = "canceled"
+	ErrorClassAuth       ErrorClass = "auth"       // 401, 403
+	ErrorClassNotFound   ErrorClass = "not_found"  // 404
+	ErrorClassValidation ErrorClass = "validation" // 400, 422
+	ErrorClassRateLimit  ErrorClass = "rate_limit" // 429
+	ErrorClassClient     ErrorClass = "client"     // diğer 4xx
+	ErrorClassServer     ErrorClass = "server"     // 5xx
+)
+
+// Instrumentation istekleri izlemek için kanca. OpenTelemetry, Prometheus vb.
+// için uyarlayıcı yazılabilir; her API ve token isteği için StartRequest
+// çağrılır, istek bitince dönen fonksiyon sonuçla çağrılır. Dönen context
+// isteğe aktarılır; böylece HTTP transport'ları aynı span'i görür.
+type Instrumentation interface {
+	StartRequest(ctx context.Context, info RequestInfo) (context.Context, func(RequestResult))
+}
+
+// HeaderInjector Instrumentation tarafından uygulanırsa her isteğin
+// başlıklarına iz bağlamı (ör. W3C traceparent) eklenebilir
+type HeaderInjector interface {
+	InjectHeaders(ctx context.Context, header http.Header)
+}
+
+// startRequest izleme açıksa isteği başlatır ve bitiş fonksiyonunu döndürür
+func (c *Client) startRequest(ctx context.Context, info RequestInfo) (context.Context, func(*http.Response, error)) {
+	if c.instrumentation == nil {
+		return ctx, func(*http.Response, error) {}
+	}
+
+	ctx, end := c.instrumentation.StartRequest(ctx, info)
+	start := time.Now()
+	return ctx, func(resp *http.Response, err error) {
+		result := RequestResult{Err: err, ErrorClass: classifyError(err), Duration: time.Since(start)}
+		var errResp *ErrorResponse
+		switch {
+		case resp != nil:
+			result.StatusCode = resp.StatusCode
+		case errors.As(err, &errResp):
+			result.StatusCode = errResp.StatusCode
+		}
+		end(result)
+	}
+}
+
+// injectHeaders iz bağlamını başlıklara ekler
+func (c *Client) injectHeaders(ctx context.Context, header http.Header) {
+	if injector, ok := c.instrumentation.(HeaderInjector); ok {
+		injector.InjectHeaders(ctx, header)
+	}
+}
+
+// requestInfo firma kapsamındaki veya /me isteğinin kaynak ve türünü çıkarır
+func (c *Client) requestInfo(method, url string) RequestInfo {
+	info := RequestInfo{Method: method, URL: url, CompanyID: c.companyID, Resource: c.cacheResource(url)}
+
+	path := strings.TrimPrefix(url, c.baseURL)
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 0 && segments[0] != info.Resource {
+		segments = segments[1:] // firma numarası
+	}
+
+	switch {
+	case method == http.MethodGet && (info.Resource == "me" || len(segments)%2 == 0):
+		info.Operation = OperationGet
+	case method == http.MethodGet:
+		info.Operation = OperationList
+	case method == http.MethodPost && len(segments) == 1:
+		info.Operation = OperationCreate
+	case (method == http.MethodPut || method == http.MethodPatch) && len(segments) == 2:
+		info.Operation = OperationUpdate
+	case method == http.MethodDelete && len(segments) == 2:
+		info.Operation = OperationDelete
+	default:
+		info.Operation = OperationAction
+	}
+	return info
+}
+
+// classifyError hatayı metrik sınıfına çevirir
+func classifyError(err error) ErrorClass {
+	if err == nil {
+		return ""
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassCanceled
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		return ErrorClassNetwork
+	}
+	switch status := errResp.StatusCode; {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrorClassAuth
+	case status == http.StatusNotFound:
+		return ErrorClassNotFound
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return ErrorClassValidation
+	case status == http.StatusTooManyRequests:
+		return ErrorClassRateLimit
+	case status >= 500:
+		return ErrorClassServer
+	}
+	return ErrorClassClient
+}
+
+// tokenTransport token uç noktasına giden istekleri (kod/şifre ile alma ve
+// oauth2 paketinin otomatik yenilemeleri) izler; diğer istekleri olduğu gibi
+// iletir
+type tokenTransport struct {
+	base   http.RoundTripper
+	client *Client
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	url := req.URL.String()
+	if !strings.HasPrefix(url, t.client.config.Endpoint.TokenURL) {
+		return base.RoundTrip(req)
+	}
+
+	ctx, end := t.client.startRequest(req.Context(), RequestInfo{
+		Method:    req.Method,
+		URL:       url,
+		Resource:  "oauth",
+		Operation: OperationToken,
+		CompanyID: t.client.companyID,
+	})
+	req = req.Clone(ctx)
+	t.client.injectHeaders(ctx, req.Header)
+
+	resp, err := base.RoundTrip(req)
+	if err == nil && resp.StatusCode >= 400 {
+		end(nil, &ErrorResponse{StatusCode: resp.StatusCode, Errors: []Error{{Title: resp.Status}}})
+		return resp, nil
+	}
+	end(resp, err)
+	return resp, err
+}
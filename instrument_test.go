@@ -0,0 +1,131 @@
+package parasut
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type spanKey struct{}
+
+// recordingInstrumentation başlatılan istekleri ve sonuçlarını kaydeder
+type recordingInstrumentation struct {
+	mu      sync.Mutex
+	infos   []RequestInfo
+	results []RequestResult
+}
+
+func (r *recordingInstrumentation) StartRequest(ctx context.Context, info RequestInfo) (context.Context, func(RequestResult)) {
+	r.mu.Lock()
+	r.infos = append(r.infos, info)
+	r.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, string(info.Operation)+" "+info.Resource), func(result RequestResult) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.results = append(r.results, result)
+	}
+}
+
+func (r *recordingInstrumentation) InjectHeaders(ctx context.Context, header http.Header) {
+	if span, ok := ctx.Value(spanKey{}).(string); ok {
+		header.Set("Traceparent", span)
+	}
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("Traceparent"))
+		switch {
+		case r.URL.Path == "/oauth/token":
+			w.Write([]byte(`{"access_token": "abc", "token_type": "bearer"}`))
+		case r.URL.Path == "/v4/123/contacts/404":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": [{"title": "Bulunamadı"}]}`))
+		case r.Method == http.MethodDelete || r.URL.Path == "/v4/123/sales_invoices/1/cancel":
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/v4/123/contacts" && r.Method == http.MethodGet:
+			w.Write([]byte(`{"data": [], "meta": {"current_page": 1, "total_pages": 1, "total_count": 0}}`))
+		default:
+			w.Write([]byte(`{"data": {"id": "1", "type": "contacts", "attributes": {}}}`))
+		}
+	}))
+	defer server.Close()
+
+	instrumentation := &recordingInstrumentation{}
+	client := NewClient(&Config{
+		CompanyID:       123,
+		BaseURL:         server.URL + "/v4",
+		TokenURL:        server.URL + "/oauth/token",
+		Instrumentation: instrumentation,
+	})
+	ctx := context.Background()
+
+	if err := client.SetTokenFromPassword(ctx, "a@b.com", "x"); err != nil {
+		t.Fatalf("SetTokenFromPassword hata döndü: %v", err)
+	}
+	client.Contacts.List(ctx, nil)
+	client.Contacts.Get(ctx, "1")
+	client.Contacts.Get(ctx, "404")
+	client.Contacts.Create(ctx, ContactInput{Name: "Acme"}, nil)
+	client.Contacts.Update(ctx, "1", ContactInput{Name: "Acme"}, nil)
+	client.Contacts.Delete(ctx, "1")
+	client.SalesInvoices.Cancel(ctx, "1")
+	client.Me.Get(ctx)
+
+	want := []struct {
+		resource   string
+		operation  Operation
+		status     int
+		errorClass ErrorClass
+	}{
+		{"oauth", OperationToken, 200, ""},
+		{"contacts", OperationList, 200, ""},
+		{"contacts", OperationGet, 200, ""},
+		{"contacts", OperationGet, 404, ErrorClassNotFound},
+		{"contacts", OperationCreate, 200, ""},
+		{"contacts", OperationUpdate, 200, ""},
+		{"contacts", OperationDelete, 204, ""},
+		{"sales_invoices", OperationAction, 204, ""},
+		{"me", OperationGet, 200, ""},
+	}
+	if len(instrumentation.infos) != len(want) || len(instrumentation.results) != len(want) {
+		t.Fatalf("İzlenen istekler = %+v", instrumentation.infos)
+	}
+	for i, w := range want {
+		info, result := instrumentation.infos[i], instrumentation.results[i]
+		if info.Resource != w.resource || info.Operation != w.operation || info.CompanyID != 123 {
+			t.Errorf("%d. istek = %+v", i, info)
+		}
+		if result.StatusCode != w.status || result.ErrorClass != w.errorClass {
+			t.Errorf("%d. sonuç = %+v", i, result)
+		}
+		if traceparents[i] != string(w.operation)+" "+w.resource {
+			t.Errorf("%d. traceparent = %q", i, traceparents[i])
+		}
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want ErrorClass
+	}{
+		{nil, ""},
+		{context.Canceled, ErrorClassCanceled},
+		{&ErrorResponse{StatusCode: 401}, ErrorClassAuth},
+		{&ErrorResponse{StatusCode: 422}, ErrorClassValidation},
+		{&ErrorResponse{StatusCode: 429}, ErrorClassRateLimit},
+		{&ErrorResponse{StatusCode: 409}, ErrorClassClient},
+		{&ErrorResponse{StatusCode: 503}, ErrorClassServer},
+		{http.ErrHandlerTimeout, ErrorClassNetwork},
+	}
+	for _, tt := range tests {
+		if got := classifyError(tt.err); got != tt.want {
+			t.Errorf("classifyError(%v) = %q, beklenen %q", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package parasut
+
+import (
+	"context"
+	"time"
+)
+
+// Servis arayüzleri. Client alanları somut servis tiplerini tutar; iş kodu
+// bu arayüzlere bağımlı olursa testlerde parasutmock paketindeki sahte
+// uygulamalar kullanılabilir.
+
+// MeAPI Kullanıcı bilgileri servisi arayüzü
+type MeAPI interface {
+	Get(ctx context.Context, includeParams ...string) (*Me, error)
+}
+
+// AccountsAPI Hesaplar servisi arayüzü
+type AccountsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Account, *Meta, error)
+	Get(ctx context.Context, id string) (*Account, error)
+	Create(ctx context.Context, attributes AccountInput) (*Account, error)
+	Update(ctx context.Context, id string, attributes AccountInput) (*Account, error)
+	Delete(ctx context.Context, id string) error
+	GetTransactions(ctx context.Context, accountID string, params *ListParams) ([]AccountTransaction, *Meta, error)
+	ListTransactionsPage(ctx context.Context, accountID string, filter AccountTransactionFilter, params *ListParams) ([]AccountTransaction, *Meta, error)
+	ListTransactions(ctx context.Context, accountID string, filter AccountTransactionFilter) ([]AccountTransaction, error)
+	CreateDebitTransaction(ctx context.Context, accountID string, attributes AccountTransactionInput) (*AccountTransaction, error)
+	CreateCreditTransaction(ctx context.Context, accountID string, attributes AccountTransactionInput) (*AccountTransaction, error)
+	Transfer(ctx context.Context, fromID, toID string, amount float64, date string, exchangeRate float64) (*AccountTransfer, error)
+}
+
+// BankFeesAPI Banka ücretleri servisi arayüzü
+type BankFeesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]BankFee, *Meta, error)
+	Get(ctx context.Context, id string) (*BankFee, error)
+	Create(ctx context.Context, attributes BankFeeInput) (*BankFee, error)
+	Update(ctx context.Context, id string, attributes BankFeeInput) (*BankFee, error)
+	Archive(ctx context.Context, id string) error
+	Unarchive(ctx context.Context, id string) error
+	CreatePayment(ctx context.Context, bankFeeID string, attributes PaymentInput) (*Payment, error)
+}
+
+// ContactsAPI Müşteri/Tedarikçi servisi arayüzü
+type ContactsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Contact, *Meta, error)
+	Get(ctx context.Context, id string, include ...string) (*Contact, error)
+	Create(ctx context.Context, attributes ContactInput, relationships *ContactRelationshipsInput) (*Contact, error)
+	Update(ctx context.Context, id string, attributes ContactInput, relationships *ContactRelationshipsInput) (*Contact, error)
+	Delete(ctx context.Context, id string) error
+	GetDebitTransactions(ctx context.Context, contactID string, params *ListParams) ([]ContactTransaction, *Meta, error)
+	GetCreditTransactions(ctx context.Context, contactID string, params *ListParams) ([]ContactTransaction, *Meta, error)
+	Statement(ctx context.Context, contactID string, from, to time.Time) (*Statement, error)
+}
+
+// EArchivesAPI E-Arşiv servisi arayüzü
+type EArchivesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]EArchive, *Meta, error)
+	Get(ctx context.Context, id string) (*EArchive, error)
+	Create(ctx context.Context, invoiceID string, attributes EArchiveInput) (*EArchive, error)
+	GetPDF(ctx context.Context, id string) ([]byte, error)
+}
+
+// EInvoiceInboxesAPI E-Fatura gelen kutusu servisi arayüzü
+type EInvoiceInboxesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]EInvoiceInbox, *Meta, error)
+}
+
+// EInvoicesAPI E-Fatura servisi arayüzü
+type EInvoicesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]EInvoice, *Meta, error)
+	Get(ctx context.Context, id string) (*EInvoice, error)
+	Create(ctx context.Context, attributes EInvoiceInput) (*EInvoice, error)
+	CreateForInvoice(ctx context.Context, invoiceID string, attributes EInvoiceInput) (*EInvoice, error)
+	GetPDF(ctx context.Context, id string) ([]byte, error)
+}
+
+// ESMMsAPI E-SMM servisi arayüzü
+type ESMMsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]ESMM, *Meta, error)
+	Get(ctx context.Context, id string) (*ESMM, error)
+	Create(ctx context.Context, attributes ESMMInput) (*ESMM, error)
+	GetPDF(ctx context.Context, id string) ([]byte, error)
+}
+
+// EmployeesAPI Çalışanlar servisi arayüzü
+type EmployeesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Employee, *Meta, error)
+	Get(ctx context.Context, id string) (*Employee, error)
+	Create(ctx context.Context, attributes EmployeeInput) (*Employee, error)
+	Update(ctx context.Context, id string, attributes EmployeeInput) (*Employee, error)
+	Archive(ctx context.Context, id string) error
+	Unarchive(ctx context.Context, id string) error
+}
+
+// ItemCategoriesAPI Ürün kategorileri servisi arayüzü
+type ItemCategoriesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]ItemCategory, *Meta, error)
+	Get(ctx context.Context, id string) (*ItemCategory, error)
+	Create(ctx context.Context, attributes ItemCategoryInput) (*ItemCategory, error)
+	Update(ctx context.Context, id string, attributes ItemCategoryInput) (*ItemCategory, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// PaymentsAPI Ödemeler servisi arayüzü
+type PaymentsAPI interface {
+	List(ctx context.Context, payableType, payableID string) ([]Payment, error)
+	Get(ctx context.Context, id string) (*Payment, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// ProductsAPI Ürünler servisi arayüzü
+type ProductsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Product, *Meta, error)
+	Get(ctx context.Context, id string) (*Product, error)
+	Create(ctx context.Context, attributes ProductInput) (*Product, error)
+	Update(ctx context.Context, id string, attributes ProductInput) (*Product, error)
+	Delete(ctx context.Context, id string) error
+	GetInventoryLevels(ctx context.Context, productID string) ([]InventoryLevel, *Meta, error)
+}
+
+// PurchaseBillsAPI Alış faturaları servisi arayüzü
+type PurchaseBillsAPI interface {
+	Aging(ctx context.Context, asOf time.Time) (*AgingReport, error)
+	List(ctx context.Context, params *ListParams) ([]PurchaseBill, *Meta, error)
+	Get(ctx context.Context, id string) (*PurchaseBill, error)
+	Create(ctx context.Context, attributes PurchaseBillInput, relationships *PurchaseBillRelationships) (*PurchaseBill, error)
+	Update(ctx context.Context, id string, attributes PurchaseBillInput, relationships *PurchaseBillRelationships) (*PurchaseBill, error)
+	CreatePayment(ctx context.Context, billID string, attributes PaymentInput) (*Payment, error)
+	Cancel(ctx context.Context, billID string) error
+	Recover(ctx context.Context, billID string) error
+	Archive(ctx context.Context, billID string) error
+	Unarchive(ctx context.Context, billID string) error
+	GetPDF(ctx context.Context, id string) ([]byte, error)
+}
+
+// SalariesAPI Maaşlar servisi arayüzü
+type SalariesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Salary, *Meta, error)
+	Get(ctx context.Context, id string) (*Salary, error)
+	Create(ctx context.Context, attributes SalaryInput, relationships *SalaryRelationships) (*Salary, error)
+	Update(ctx context.Context, id string, attributes SalaryInput, relationships *SalaryRelationships) (*Salary, error)
+	Archive(ctx context.Context, id string) error
+	Unarchive(ctx context.Context, id string) error
+	CreatePayment(ctx context.Context, salaryID string, attributes PaymentInput) (*Payment, error)
+}
+
+// RecurrencePlansAPI Tekrarlama planları servisi arayüzü
+type RecurrencePlansAPI interface {
+	List(ctx context.Context, params *ListParams) ([]RecurrencePlan, *Meta, error)
+	Get(ctx context.Context, id string) (*RecurrencePlan, error)
+	Create(ctx context.Context, attributes RecurrencePlanInput) (*RecurrencePlan, error)
+	Update(ctx context.Context, id string, attributes RecurrencePlanInput) (*RecurrencePlan, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// SalesInvoicesAPI Satış faturaları servisi arayüzü
+type SalesInvoicesAPI interface {
+	Aging(ctx context.Context, asOf time.Time) (*AgingReport, error)
+	List(ctx context.Context, params *ListParams) ([]SalesInvoice, *Meta, error)
+	Get(ctx context.Context, id string, include ...string) (*SalesInvoice, error)
+	Create(ctx context.Context, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships) (*SalesInvoice, error)
+	CreateWithDetails(ctx context.Context, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships, details []SalesInvoiceDetailInput) (*SalesInvoice, error)
+	Update(ctx context.Context, id string, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships) (*SalesInvoice, error)
+	Cancel(ctx context.Context, id string) error
+	Recover(ctx context.Context, id string) error
+	Archive(ctx context.Context, id string) error
+	Unarchive(ctx context.Context, id string) error
+	CreatePayment(ctx context.Context, invoiceID string, attributes PaymentInput) (*Payment, error)
+	AllocatePayment(ctx context.Context, contactID string, payment PaymentInput) (*PaymentAllocationResult, error)
+	ConvertToInvoice(ctx context.Context, invoiceID string) (*SalesInvoice, error)
+	CreateRefund(ctx context.Context, originalID string, lines []RefundLine, options RefundOptions) (*Refund, error)
+	CreateRecurring(ctx context.Context, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships, plan RecurrencePlanInput) (*SalesInvoice, *RecurrencePlan, error)
+	ListGenerated(ctx context.Context, planID string) ([]SalesInvoice, error)
+	GetPDF(ctx context.Context, id string) ([]byte, error)
+}
+
+// SalesOffersAPI Satış teklifleri servisi arayüzü
+type SalesOffersAPI interface {
+	List(ctx context.Context, params *ListParams) ([]SalesOffer, *Meta, error)
+	Get(ctx context.Context, id string, include ...string) (*SalesOffer, error)
+	Create(ctx context.Context, attributes SalesOfferInput, relationships *SalesOfferRelationships) (*SalesOffer, error)
+	Update(ctx context.Context, id string, attributes SalesOfferInput, relationships *SalesOfferRelationships) (*SalesOffer, error)
+	Delete(ctx context.Context, id string) error
+	Archive(ctx context.Context, id string) error
+	Unarchive(ctx context.Context, id string) error
+	GetPDF(ctx context.Context, id string) ([]byte, error)
+	GetDetails(ctx context.Context, id string) (*SalesOffer, error)
+	UpdateStatus(ctx context.Context, id string, status SalesOfferStatus) (*SalesOffer, error)
+	ConvertToInvoice(ctx context.Context, offerID string) (*SalesInvoice, error)
+	Pipeline(ctx context.Context, from, to time.Time) (*OfferPipeline, error)
+}
+
+// SharingsAPI Paylaşımlar servisi arayüzü
+type SharingsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Sharing, *Meta, error)
+	Create(ctx context.Context, attributes SharingInput) (*Sharing, error)
+}
+
+// ShipmentDocumentsAPI Sevkiyat belgeleri servisi arayüzü
+type ShipmentDocumentsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]ShipmentDocument, *Meta, error)
+	Get(ctx context.Context, id string) (*ShipmentDocument, error)
+	Create(ctx context.Context, attributes ShipmentDocumentInput) (*ShipmentDocument, error)
+	Update(ctx context.Context, id string, attributes ShipmentDocumentInput) (*ShipmentDocument, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// StockMovementsAPI Stok hareketleri servisi arayüzü
+type StockMovementsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]StockMovement, *Meta, error)
+}
+
+// StockUpdatesAPI Stok güncellemeleri servisi arayüzü
+type StockUpdatesAPI interface {
+	Create(ctx context.Context, attributes StockUpdateInput) (*StockUpdate, error)
+}
+
+// TagsAPI Etiketler servisi arayüzü
+type TagsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Tag, *Meta, error)
+	Get(ctx context.Context, id string) (*Tag, error)
+	Create(ctx context.Context, attributes TagInput) (*Tag, error)
+	Update(ctx context.Context, id string, attributes TagInput) (*Tag, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// TaxesAPI Vergiler servisi arayüzü
+type TaxesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Tax, *Meta, error)
+	Get(ctx context.Context, id string) (*Tax, error)
+	Create(ctx context.Context, attributes TaxInput, relationships *TaxRelationships) (*Tax, error)
+	Update(ctx context.Context, id string, attributes TaxInput, relationships *TaxRelationships) (*Tax, error)
+	Archive(ctx context.Context, id string) error
+	Unarchive(ctx context.Context, id string) error
+	CreatePayment(ctx context.Context, taxID string, attributes PaymentInput) (*Payment, error)
+}
+
+// TrackableJobsAPI İzlenebilir işler servisi arayüzü
+type TrackableJobsAPI interface {
+	Get(ctx context.Context, id string) (*TrackableJob, error)
+}
+
+// TransactionsAPI İşlemler servisi arayüzü
+type TransactionsAPI interface {
+	Get(ctx context.Context, id string) (*Transaction, error)
+	Update(ctx context.Context, id string, attributes TransactionInput) (*Transaction, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// WarehousesAPI Depolar servisi arayüzü
+type WarehousesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Warehouse, *Meta, error)
+	Get(ctx context.Context, id string) (*Warehouse, error)
+	Create(ctx context.Context, attributes WarehouseInput) (*Warehouse, error)
+	Update(ctx context.Context, id string, attributes WarehouseInput) (*Warehouse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// WebhooksAPI Webhooks servisi arayüzü
+type WebhooksAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Webhook, *Meta, error)
+	Get(ctx context.Context, id string) (*Webhook, error)
+	Create(ctx context.Context, attributes WebhookInput) (*Webhook, error)
+	Update(ctx context.Context, id string, attributes WebhookInput) (*Webhook, error)
+	Delete(ctx context.Context, id string) error
+}
+
+var (
+	_ MeAPI                = (*MeService)(nil)
+	_ AccountsAPI          = (*AccountsService)(nil)
+	_ BankFeesAPI          = (*BankFeesService)(nil)
+	_ ContactsAPI          = (*ContactsService)(nil)
+	_ EArchivesAPI         = (*EArchivesService)(nil)
+	_ EInvoiceInboxesAPI   = (*EInvoiceInboxesService)(nil)
+	_ EInvoicesAPI         = (*EInvoicesService)(nil)
+	_ ESMMsAPI             = (*ESMMsService)(nil)
+	_ EmployeesAPI         = (*EmployeesService)(nil)
+	_ ItemCategoriesAPI    = (*ItemCategoriesService)(nil)
+	_ PaymentsAPI          = (*PaymentsService)(nil)
+	_ ProductsAPI          = (*ProductsService)(nil)
+	_ PurchaseBillsAPI     = (*PurchaseBillsService)(nil)
+	_ RecurrencePlansAPI   = (*RecurrencePlansService)(nil)
+	_ SalariesAPI          = (*SalariesService)(nil)
+	_ SalesInvoicesAPI     = (*SalesInvoicesService)(nil)
+	_ SalesOffersAPI       = (*SalesOffersService)(nil)
+	_ SharingsAPI          = (*SharingsService)(nil)
+	_ ShipmentDocumentsAPI = (*ShipmentDocumentsService)(nil)
+	_ StockMovementsAPI    = (*StockMovementsService)(nil)
+	_ StockUpdatesAPI      = (*StockUpdatesService)(nil)
+	_ TagsAPI              = (*TagsService)(nil)
+	_ TaxesAPI             = (*TaxesService)(nil)
+	_ TrackableJobsAPI     = (*TrackableJobsService)(nil)
+	_ TransactionsAPI      = (*TransactionsService)(nil)
+	_ WarehousesAPI        = (*WarehousesService)(nil)
+	_ WebhooksAPI          = (*WebhooksService)(nil)
+)
@@ -1,6 +1,10 @@
 package parasut
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 	"time"
 )
 
@@ -42,11 +46,12 @@ type AccountTransaction struct {
 
 // AccountTransactionAttributes Hesap işlemi nitelikleri
 type AccountTransactionAttributes struct {
-	Date        string     `json:"date"`
-	Amount      float64    `json:"amount"`
-	Description string     `json:"description,omitempty"`
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Date            string     `json:"date"`
+	Amount          float64    `json:"amount"`
+	Description     string     `json:"description,omitempty"`
+	TransactionType string     `json:"transaction_type,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
 }
 
 // BankFee Banka ücreti modeli
@@ -75,10 +80,15 @@ type BankFeeAttributes struct {
 
 // Contact Müşteri/Tedarikçi modeli
 type Contact struct {
-	ID            string            `json:"id"`
-	Type          string            `json:"type"`
-	Attributes    ContactAttributes `json:"attributes"`
-	Relationships interface{}       `json:"relationships,omitempty"`
+	ID            string               `json:"id"`
+	Type          string               `json:"type"`
+	Attributes    ContactAttributes    `json:"attributes"`
+	Relationships ContactRelationships `json:"relationships,omitempty"`
+
+	// include parametresiyle istendiğinde doldurulan ilişkili kayıtlar
+	ContactPeople []ContactPerson `json:"-"`
+	Category      *ItemCategory   `json:"-"`
+	ContactPortal *ContactPortal  `json:"-"`
 }
 
 // ContactAttributes Müşteri/Tedarikçi nitelikleri
@@ -100,6 +110,47 @@ type ContactAttributes struct {
 	CreatedAt          *time.Time `json:"created_at,omitempty"`
 	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
 	AccountType        string     `json:"account_type,omitempty"` // customer, supplier, both
+	Country            string     `json:"country,omitempty"`
+	IBANs              []string   `json:"ibans,omitempty"`
+}
+
+// ContactRelationships Müşteri/Tedarikçi ilişkileri
+type ContactRelationships struct {
+	Category      *SingleRelationship `json:"category,omitempty"`
+	ContactPortal *SingleRelationship `json:"contact_portal,omitempty"`
+	ContactPeople *Relationship       `json:"contact_people,omitempty"`
+}
+
+// ContactPerson Müşteri/Tedarikçi yetkili kişi modeli
+type ContactPerson struct {
+	ID         string                  `json:"id"`
+	Type       string                  `json:"type"`
+	Attributes ContactPersonAttributes `json:"attributes"`
+}
+
+// ContactPersonAttributes Yetkili kişi nitelikleri
+type ContactPersonAttributes struct {
+	Name      string     `json:"name"`
+	Email     string     `json:"email,omitempty"`
+	Phone     string     `json:"phone,omitempty"`
+	Notes     string     `json:"notes,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ContactPortal Müşteri portalı modeli
+type ContactPortal struct {
+	ID         string                  `json:"id"`
+	Type       string                  `json:"type"`
+	Attributes ContactPortalAttributes `json:"attributes"`
+}
+
+// ContactPortalAttributes Müşteri portalı nitelikleri
+type ContactPortalAttributes struct {
+	URL       string     `json:"url,omitempty"`
+	Status    string     `json:"status,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
 // ContactTransaction Müşteri/Tedarikçi işlemi modeli
@@ -114,6 +165,7 @@ type ContactTransaction struct {
 type ContactTransactionAttributes struct {
 	Date        string     `json:"date"`
 	Amount      float64    `json:"amount"`
+	Currency    string     `json:"currency,omitempty"`
 	Description string     `json:"description,omitempty"`
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
@@ -280,35 +332,53 @@ type SalesOffer struct {
 	Type          string                  `json:"type"`
 	Attributes    SalesOfferAttributes    `json:"attributes"`
 	Relationships SalesOfferRelationships `json:"relationships,omitempty"`
+
+	// include=details ile istendiğinde doldurulan teklif kalemleri
+	Details []SalesOfferDetail `json:"-"`
 }
 
 // SalesOfferAttributes Satış teklifi nitelikleri
 type SalesOfferAttributes struct {
-	Archived               bool       `json:"archived,omitempty"`
-	NetTotal               float64    `json:"net_total,omitempty"`
-	GrossTotal             float64    `json:"gross_total,omitempty"`
-	TotalExciseDuty        float64    `json:"total_excise_duty,omitempty"`
-	TotalCommunicationsTax float64    `json:"total_communications_tax,omitempty"`
-	TotalVat               float64    `json:"total_vat,omitempty"`
-	TotalDiscount          float64    `json:"total_discount,omitempty"`
-	TotalInvoiceDiscount   float64    `json:"total_invoice_discount,omitempty"`
-	BeforeTaxesTotal       float64    `json:"before_taxes_total,omitempty"`
-	CreatedAt              *time.Time `json:"created_at,omitempty"`
-	UpdatedAt              *time.Time `json:"updated_at,omitempty"`
-	Description            string     `json:"description,omitempty"`
-	IssueDate              string     `json:"issue_date"` // date format
-	Currency               string     `json:"currency,omitempty"`
-	ExchangeRate           float64    `json:"exchange_rate,omitempty"`
-	InvoiceDiscountType    string     `json:"invoice_discount_type,omitempty"`
-	InvoiceDiscount        float64    `json:"invoice_discount,omitempty"`
-	Status                 string     `json:"status,omitempty"`
+	Archived               bool             `json:"archived,omitempty"`
+	NetTotal               float64          `json:"net_total,omitempty"`
+	GrossTotal             float64          `json:"gross_total,omitempty"`
+	TotalExciseDuty        float64          `json:"total_excise_duty,omitempty"`
+	TotalCommunicationsTax float64          `json:"total_communications_tax,omitempty"`
+	TotalVat               float64          `json:"total_vat,omitempty"`
+	TotalDiscount          float64          `json:"total_discount,omitempty"`
+	TotalInvoiceDiscount   float64          `json:"total_invoice_discount,omitempty"`
+	BeforeTaxesTotal       float64          `json:"before_taxes_total,omitempty"`
+	CreatedAt              *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt              *time.Time       `json:"updated_at,omitempty"`
+	Description            string           `json:"description,omitempty"`
+	IssueDate              string           `json:"issue_date"` // date format
+	Currency               string           `json:"currency,omitempty"`
+	ExchangeRate           float64          `json:"exchange_rate,omitempty"`
+	InvoiceDiscountType    string           `json:"invoice_discount_type,omitempty"`
+	InvoiceDiscount        float64          `json:"invoice_discount,omitempty"`
+	Status                 SalesOfferStatus `json:"status,omitempty"`
 }
 
 // SalesOfferRelationships Satış teklifi ilişkileri
 type SalesOfferRelationships struct {
-	Contact *RelationshipData  `json:"contact,omitempty"`
-	Details []RelationshipData `json:"details,omitempty"`
-	Tags    []RelationshipData `json:"tags,omitempty"`
+	Contact      *RelationshipData  `json:"contact,omitempty"`
+	Details      []RelationshipData `json:"details,omitempty"`
+	Tags         []RelationshipData `json:"tags,omitempty"`
+	SalesInvoice *RelationshipData  `json:"sales_invoice,omitempty"`
+}
+
+// UnmarshalJSON JSON:API ilişki nesnelerini ve düz ilişki verisini kabul eder
+func (r *SalesOfferRelationships) UnmarshalJSON(data []byte) error {
+	return unmarshalRelationships(data, r)
+}
+
+// SalesOfferDetail Satış teklifi kalemi modeli. Teklif kalemleri fatura
+// kalemleriyle aynı nitelikleri ve ilişkileri taşır.
+type SalesOfferDetail struct {
+	ID            string                          `json:"id"`
+	Type          string                          `json:"type"`
+	Attributes    SalesInvoiceDetailAttributes    `json:"attributes"`
+	Relationships SalesInvoiceDetailRelationships `json:"relationships,omitempty"`
 }
 
 // SalesInvoice Satış faturası modeli
@@ -317,6 +387,10 @@ type SalesInvoice struct {
 	Type          string                    `json:"type"`
 	Attributes    SalesInvoiceAttributes    `json:"attributes"`
 	Relationships SalesInvoiceRelationships `json:"relationships,omitempty"`
+
+	// include parametresiyle istendiğinde doldurulan ilişkili kayıtlar
+	Details  []SalesInvoiceDetail `json:"-"`
+	Payments []Payment            `json:"-"`
 }
 
 // SalesInvoiceAttributes Satış faturası nitelikleri
@@ -360,6 +434,7 @@ type SalesInvoiceAttributes struct {
 	IsAbroad               bool       `json:"is_abroad,omitempty"`
 	OrderNo                string     `json:"order_no,omitempty"`
 	OrderDate              string     `json:"order_date,omitempty"`
+	ShipmentIncluded       bool       `json:"shipment_included,omitempty"` // irsaliyeli fatura; stok hareketi oluşturur
 }
 
 // SalesInvoiceRelationships Satış faturası ilişkileri
@@ -371,6 +446,48 @@ type SalesInvoiceRelationships struct {
 	Sharings        []RelationshipData `json:"sharings,omitempty"`
 	RecurrencePlan  *RelationshipData  `json:"recurrence_plan,omitempty"`
 	ActiveEDocument *RelationshipData  `json:"active_e_document,omitempty"`
+	SalesOffer      *RelationshipData  `json:"sales_offer,omitempty"`
+	// OriginalInvoice iade faturasının (item_type refund) ait olduğu satış faturası
+	OriginalInvoice *RelationshipData `json:"original_invoice,omitempty"`
+}
+
+// UnmarshalJSON JSON:API ilişki nesnelerini ve düz ilişki verisini kabul eder
+func (r *SalesInvoiceRelationships) UnmarshalJSON(data []byte) error {
+	return unmarshalRelationships(data, r)
+}
+
+// SalesInvoiceDetail Satış faturası kalemi modeli
+type SalesInvoiceDetail struct {
+	ID            string                          `json:"id"`
+	Type          string                          `json:"type"`
+	Attributes    SalesInvoiceDetailAttributes    `json:"attributes"`
+	Relationships SalesInvoiceDetailRelationships `json:"relationships,omitempty"`
+}
+
+// SalesInvoiceDetailAttributes Satış faturası kalemi nitelikleri
+type SalesInvoiceDetailAttributes struct {
+	Quantity              float64    `json:"quantity"`
+	UnitPrice             float64    `json:"unit_price"`
+	VatRate               float64    `json:"vat_rate"`
+	NetTotal              float64    `json:"net_total,omitempty"`
+	DiscountType          string     `json:"discount_type,omitempty"` // percentage, amount
+	DiscountValue         float64    `json:"discount_value,omitempty"`
+	ExciseDutyType        string     `json:"excise_duty_type,omitempty"`
+	ExciseDutyValue       float64    `json:"excise_duty_value,omitempty"`
+	CommunicationsTaxRate float64    `json:"communications_tax_rate,omitempty"`
+	Description           string     `json:"description,omitempty"`
+	CreatedAt             *time.Time `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time `json:"updated_at,omitempty"`
+}
+
+// SalesInvoiceDetailRelationships Satış faturası kalemi ilişkileri
+type SalesInvoiceDetailRelationships struct {
+	Product *RelationshipData `json:"product,omitempty"`
+}
+
+// UnmarshalJSON JSON:API ilişki nesnelerini ve düz ilişki verisini kabul eder
+func (r *SalesInvoiceDetailRelationships) UnmarshalJSON(data []byte) error {
+	return unmarshalRelationships(data, r)
 }
 
 // RelationshipData İlişki verisi
@@ -379,6 +496,38 @@ type RelationshipData struct {
 	Type string `json:"type"`
 }
 
+// unmarshalRelationships ilişki struct'ını doldurur. Her alan için hem JSON:API
+// ilişki nesnesi ({"data": ...}) hem de doğrudan ilişki verisi kabul edilir.
+func unmarshalRelationships(data []byte, v interface{}) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := strings.Split(rt.Field(i).Tag.Get("json"), ",")[0]
+		value, ok := raw[name]
+		if !ok {
+			continue
+		}
+
+		var wrapper struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(value, &wrapper); err == nil && wrapper.Data != nil {
+			value = wrapper.Data
+		}
+
+		if err := json.Unmarshal(value, rv.Field(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("%s ilişkisi çözülemedi: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 // PurchaseBill Alış faturası modeli
 type PurchaseBill struct {
 	ID            string                    `json:"id"`
@@ -435,6 +584,36 @@ type PurchaseBillRelationships struct {
 	Tags     []RelationshipData `json:"tags,omitempty"`
 }
 
+// UnmarshalJSON JSON:API ilişki nesnelerini ve düz ilişki verisini kabul eder
+func (r *PurchaseBillRelationships) UnmarshalJSON(data []byte) error {
+	return unmarshalRelationships(data, r)
+}
+
+// RecurrencePlan Tekrarlama planı modeli. Tekrarlayan fatura şablonu
+// (item_type recurring_invoice veya recurring_estimate) bu plana göre yeni
+// faturalar oluşturur.
+type RecurrencePlan struct {
+	ID            string                   `json:"id"`
+	Type          string                   `json:"type"`
+	Attributes    RecurrencePlanAttributes `json:"attributes"`
+	Relationships interface{}              `json:"relationships,omitempty"`
+}
+
+// RecurrencePlanAttributes Tekrarlama planı nitelikleri
+type RecurrencePlanAttributes struct {
+	Period             string     `json:"period"`                 // daily, weekly, monthly, yearly
+	Interval           int        `json:"interval,omitempty"`     // kaç dönemde bir; 0 veya 1 her dönem
+	StartDate          string     `json:"start_date"`             // date format
+	EndDate            string     `json:"end_date,omitempty"`     // date format; boşsa süresiz
+	DayOfMonth         int        `json:"day_of_month,omitempty"` // monthly ve yearly için ayın günü
+	AutoSend           bool       `json:"auto_send,omitempty"`
+	AutoIssueEDocument bool       `json:"auto_issue_e_document,omitempty"`
+	NextIssueDate      string     `json:"next_issue_date,omitempty"`
+	LastIssueDate      string     `json:"last_issue_date,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+}
+
 // Salary Maaş modeli
 type Salary struct {
 	ID            string              `json:"id"`
@@ -445,15 +624,19 @@ type Salary struct {
 
 // SalaryAttributes Maaş nitelikleri
 type SalaryAttributes struct {
-	Archived     bool       `json:"archived,omitempty"`
-	NetTotal     float64    `json:"net_total,omitempty"`
-	GrossTotal   float64    `json:"gross_total,omitempty"`
-	CreatedAt    *time.Time `json:"created_at,omitempty"`
-	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
-	Date         string     `json:"date"` // date format
-	Description  string     `json:"description,omitempty"`
-	Currency     string     `json:"currency,omitempty"`
-	ExchangeRate float64    `json:"exchange_rate,omitempty"`
+	Archived       bool       `json:"archived,omitempty"`
+	NetTotal       float64    `json:"net_total,omitempty"`
+	GrossTotal     float64    `json:"gross_total,omitempty"`
+	TotalPaid      float64    `json:"total_paid,omitempty"`
+	Remaining      float64    `json:"remaining,omitempty"`
+	RemainingInTRL float64    `json:"remaining_in_trl,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	Date           string     `json:"date"`               // date format
+	DueDate        string     `json:"due_date,omitempty"` // date format
+	Description    string     `json:"description,omitempty"`
+	Currency       string     `json:"currency,omitempty"`
+	ExchangeRate   float64    `json:"exchange_rate,omitempty"`
 }
 
 // SalaryRelationships Maaş ilişkileri
@@ -463,6 +646,11 @@ type SalaryRelationships struct {
 	Tags     []RelationshipData `json:"tags,omitempty"`
 }
 
+// UnmarshalJSON JSON:API ilişki nesnelerini ve düz ilişki verisini kabul eder
+func (r *SalaryRelationships) UnmarshalJSON(data []byte) error {
+	return unmarshalRelationships(data, r)
+}
+
 // Sharing Paylaşım modeli
 type Sharing struct {
 	ID            string            `json:"id"`
@@ -559,15 +747,19 @@ type Tax struct {
 
 // TaxAttributes Vergi nitelikleri
 type TaxAttributes struct {
-	Archived     bool       `json:"archived,omitempty"`
-	NetTotal     float64    `json:"net_total,omitempty"`
-	GrossTotal   float64    `json:"gross_total,omitempty"`
-	CreatedAt    *time.Time `json:"created_at,omitempty"`
-	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
-	Date         string     `json:"date"` // date format
-	Description  string     `json:"description,omitempty"`
-	Currency     string     `json:"currency,omitempty"`
-	ExchangeRate float64    `json:"exchange_rate,omitempty"`
+	Archived       bool       `json:"archived,omitempty"`
+	NetTotal       float64    `json:"net_total,omitempty"`
+	GrossTotal     float64    `json:"gross_total,omitempty"`
+	TotalPaid      float64    `json:"total_paid,omitempty"`
+	Remaining      float64    `json:"remaining,omitempty"`
+	RemainingInTRL float64    `json:"remaining_in_trl,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	Date           string     `json:"date"`               // date format
+	DueDate        string     `json:"due_date,omitempty"` // date format
+	Description    string     `json:"description,omitempty"`
+	Currency       string     `json:"currency,omitempty"`
+	ExchangeRate   float64    `json:"exchange_rate,omitempty"`
 }
 
 // TaxRelationships Vergi ilişkileri
@@ -576,6 +768,11 @@ type TaxRelationships struct {
 	Payments []RelationshipData `json:"payments,omitempty"`
 }
 
+// UnmarshalJSON JSON:API ilişki nesnelerini ve düz ilişki verisini kabul eder
+func (r *TaxRelationships) UnmarshalJSON(data []byte) error {
+	return unmarshalRelationships(data, r)
+}
+
 // TrackableJob İzlenebilir iş modeli
 type TrackableJob struct {
 	ID            string                 `json:"id"`
@@ -648,20 +845,36 @@ type WarehouseAttributes struct {
 
 // Payment Ödeme modeli
 type Payment struct {
-	ID            string            `json:"id"`
-	Type          string            `json:"type"`
-	Attributes    PaymentAttributes `json:"attributes"`
-	Relationships interface{}       `json:"relationships,omitempty"`
+	ID            string               `json:"id"`
+	Type          string               `json:"type"`
+	Attributes    PaymentAttributes    `json:"attributes"`
+	Relationships PaymentRelationships `json:"relationships,omitempty"`
+
+	// include=transaction ile istendiğinde doldurulan hesap hareketi
+	Transaction *Transaction `json:"-"`
+}
+
+// PaymentRelationships Ödeme ilişkileri. Payable ödemenin ait olduğu belgedir
+// (sales_invoices, purchase_bills, salaries, taxes, bank_fees).
+type PaymentRelationships struct {
+	Payable     *RelationshipData `json:"payable,omitempty"`
+	Transaction *RelationshipData `json:"transaction,omitempty"`
+}
+
+// UnmarshalJSON JSON:API ilişki nesnelerini ve düz ilişki verisini kabul eder
+func (r *PaymentRelationships) UnmarshalJSON(data []byte) error {
+	return unmarshalRelationships(data, r)
 }
 
 // PaymentAttributes Ödeme nitelikleri
 type PaymentAttributes struct {
-	Date        string     `json:"date"`
-	Amount      float64    `json:"amount"`
-	Description string     `json:"description,omitempty"`
-	Currency    string     `json:"currency,omitempty"`
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Date         string     `json:"date"`
+	Amount       float64    `json:"amount"`
+	ExchangeRate float64    `json:"exchange_rate,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	Currency     string     `json:"currency,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
 }
 
 // Me represents current user information
@@ -687,3 +900,35 @@ type MeRelationships struct {
 type Relationship struct {
 	Data []RelationshipData `json:"data,omitempty"`
 }
+
+// SingleRelationship tekil JSON:API ilişki nesnesi
+type SingleRelationship struct {
+	Data *RelationshipData `json:"data,omitempty"`
+}
+
+// IncludedResource include parametresiyle yanıtın included dizisinde dönen kaynak
+type IncludedResource struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	Attributes    json.RawMessage `json:"attributes,omitempty"`
+	Relationships json.RawMessage `json:"relationships,omitempty"`
+}
+
+// findIncluded included dizisinde tip ve id ile kaynak arar
+func findIncluded(included []IncludedResource, ref RelationshipData) *IncludedResource {
+	for i := range included {
+		if included[i].Type == ref.Type && included[i].ID == ref.ID {
+			return &included[i]
+		}
+	}
+	return nil
+}
+
+// decodeIncluded included kaynağını verilen modele çözer
+func decodeIncluded(resource *IncludedResource, v interface{}) error {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
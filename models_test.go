@@ -624,3 +624,73 @@ func TestRelationshipDataJSON(t *testing.T) {
 		t.Errorf("Details[0] Type = %s, beklenen sales_invoice_details", relationships2.Details[0].Type)
 	}
 }
+
+func TestContactRelationshipsJSON(t *testing.T) {
+	jsonData := `{
+		"id": "1",
+		"type": "contacts",
+		"attributes": {
+			"name": "Test Müşteri",
+			"contact_type": "company",
+			"ibans": ["TR330006100519786457841326", "TR320010009999901234567890"]
+		},
+		"relationships": {
+			"category": {"data": {"id": "5", "type": "item_categories"}},
+			"contact_portal": {"data": {"id": "7", "type": "contact_portals"}},
+			"contact_people": {"data": [{"id": "10", "type": "contact_people"}]}
+		}
+	}`
+
+	var contact Contact
+	err := json.Unmarshal([]byte(jsonData), &contact)
+	if err != nil {
+		t.Fatalf("JSON unmarshal hatası: %v", err)
+	}
+
+	if len(contact.Attributes.IBANs) != 2 {
+		t.Errorf("IBAN sayısı = %d, beklenen 2", len(contact.Attributes.IBANs))
+	}
+
+	if contact.Relationships.Category.Data.ID != "5" {
+		t.Errorf("Category ID = %s, beklenen 5", contact.Relationships.Category.Data.ID)
+	}
+
+	if contact.Relationships.ContactPortal.Data.Type != "contact_portals" {
+		t.Errorf("ContactPortal Type = %s, beklenen contact_portals", contact.Relationships.ContactPortal.Data.Type)
+	}
+
+	if len(contact.Relationships.ContactPeople.Data) != 1 {
+		t.Errorf("ContactPeople sayısı = %d, beklenen 1", len(contact.Relationships.ContactPeople.Data))
+	}
+}
+
+func TestSalesInvoiceRelationshipsJSONAPI(t *testing.T) {
+	jsonData := `{
+		"id": "1",
+		"type": "sales_invoices",
+		"attributes": {"item_type": "invoice", "issue_date": "2024-01-01"},
+		"relationships": {
+			"contact": {"data": {"id": "123", "type": "contacts"}},
+			"details": {"data": [{"id": "1", "type": "sales_invoice_details"}]},
+			"recurrence_plan": {"data": null}
+		}
+	}`
+
+	var invoice SalesInvoice
+	err := json.Unmarshal([]byte(jsonData), &invoice)
+	if err != nil {
+		t.Fatalf("JSON unmarshal hatası: %v", err)
+	}
+
+	if invoice.Relationships.Contact == nil || invoice.Relationships.Contact.ID != "123" {
+		t.Errorf("Contact = %+v, beklenen 123", invoice.Relationships.Contact)
+	}
+
+	if len(invoice.Relationships.Details) != 1 {
+		t.Errorf("Details sayısı = %d, beklenen 1", len(invoice.Relationships.Details))
+	}
+
+	if invoice.Relationships.RecurrencePlan != nil {
+		t.Errorf("RecurrencePlan = %+v, beklenen nil", invoice.Relationships.RecurrencePlan)
+	}
+}
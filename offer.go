@@ -0,0 +1,253 @@
+package parasut
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SalesOfferStatus satış teklifinin durumu
+type SalesOfferStatus string
+
+const (
+	SalesOfferPending  SalesOfferStatus = "pending"
+	SalesOfferAccepted SalesOfferStatus = "accepted"
+	SalesOfferRejected SalesOfferStatus = "rejected"
+)
+
+// SalesOfferStatuses geçerli teklif durumları, iş akışı sırasıyla
+var SalesOfferStatuses = []SalesOfferStatus{SalesOfferPending, SalesOfferAccepted, SalesOfferRejected}
+
+// Valid durumun Paraşüt'ün kabul ettiği değerlerden biri olup olmadığını döndürür
+func (s SalesOfferStatus) Valid() bool {
+	for _, status := range SalesOfferStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ConvertToInvoice teklifin müşterisini, kalemlerini, dövizini, kurunu,
+// iskontolarını ve etiketlerini bugünün tarihli yeni bir satış faturasına
+// kopyalar. Fatura sales_offer ilişkisiyle teklife bağlanır ve kabul
+// edilmemiş teklifin durumu accepted yapılır. Reddedilmiş veya daha önce
+// faturalaştırılmış teklifler dönüştürülmez.
+func (s *SalesOffersService) ConvertToInvoice(ctx context.Context, offerID string) (*SalesInvoice, error) {
+	offer, err := s.Get(ctx, offerID, "details")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case offer.Relationships.SalesInvoice != nil:
+		return nil, fmt.Errorf("%s numaralı teklif zaten %s numaralı faturaya dönüştürülmüş", offerID, offer.Relationships.SalesInvoice.ID)
+	case offer.Attributes.Status == SalesOfferRejected:
+		return nil, fmt.Errorf("reddedilmiş teklif faturaya dönüştürülemez")
+	case offer.Relationships.Contact == nil:
+		return nil, fmt.Errorf("teklifin müşterisi yok")
+	case len(offer.Details) == 0 || len(offer.Details) != len(offer.Relationships.Details):
+		return nil, fmt.Errorf("teklif kalemleri alınamadı")
+	}
+
+	details := make([]SalesInvoiceDetailInput, 0, len(offer.Details))
+	for _, detail := range offer.Details {
+		details = append(details, detail.ToInput())
+	}
+
+	attributes := SalesInvoiceInput{
+		ItemType:            "invoice",
+		Description:         offer.Attributes.Description,
+		IssueDate:           time.Now().Format("2006-01-02"),
+		Currency:            offer.Attributes.Currency,
+		ExchangeRate:        offer.Attributes.ExchangeRate,
+		InvoiceDiscountType: offer.Attributes.InvoiceDiscountType,
+		InvoiceDiscount:     offer.Attributes.InvoiceDiscount,
+	}
+	relationships := &SalesInvoiceRelationships{
+		Contact:    offer.Relationships.Contact,
+		Tags:       offer.Relationships.Tags,
+		SalesOffer: &RelationshipData{ID: offer.ID, Type: "sales_offers"},
+	}
+
+	invoice, err := s.client.SalesInvoices.CreateWithDetails(ctx, attributes, relationships, details)
+	if err != nil {
+		return nil, err
+	}
+
+	if offer.Attributes.Status != SalesOfferAccepted {
+		if _, err := s.UpdateStatus(ctx, offer.ID, SalesOfferAccepted); err != nil {
+			return invoice, fmt.Errorf("%s numaralı fatura oluşturuldu ancak teklif durumu güncellenemedi: %w", invoice.ID, err)
+		}
+	}
+	return invoice, nil
+}
+
+// OfferPipelineStage bir durumdaki tekliflerin sayısı ve TRL cinsinden toplamları
+type OfferPipelineStage struct {
+	Status     SalesOfferStatus `json:"status,omitempty"`
+	Count      int              `json:"count"`
+	NetTotal   float64          `json:"net_total"`
+	GrossTotal float64          `json:"gross_total"`
+}
+
+func (s *OfferPipelineStage) add(net, gross float64) {
+	s.Count++
+	s.NetTotal = RoundAmount(s.NetTotal + net)
+	s.GrossTotal = RoundAmount(s.GrossTotal + gross)
+}
+
+// OfferPipeline satış tekliflerinin durumlara göre dağılımı
+type OfferPipeline struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// Stages durum aşamaları; bilinen durumlar iş akışı sırasıyla, diğerleri
+	// alfabetik sırayla gelir
+	Stages []OfferPipelineStage `json:"stages"`
+	// Invoiced faturaya dönüştürülmüş teklifler; bu teklifler kendi
+	// durumlarının aşamasında da sayılır
+	Invoiced OfferPipelineStage `json:"invoiced"`
+	Total    OfferPipelineStage `json:"total"`
+	// WinRate sonuçlanmış (kabul veya ret) tekliflerden kabul edilenlerin oranı
+	WinRate float64 `json:"win_rate"`
+}
+
+// Stage verilen durumun aşamasını döndürür; durumda teklif yoksa boş aşama döner
+func (p *OfferPipeline) Stage(status SalesOfferStatus) OfferPipelineStage {
+	for _, stage := range p.Stages {
+		if stage.Status == status {
+			return stage
+		}
+	}
+	return OfferPipelineStage{Status: status}
+}
+
+// WriteCSV aşamaları, faturalaşan teklifleri ve toplam satırını CSV olarak yazar
+func (p *OfferPipeline) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"status", "count", "net_total", "gross_total"}); err != nil {
+		return err
+	}
+
+	invoiced, total := p.Invoiced, p.Total
+	invoiced.Status, total.Status = "invoiced", "total"
+	rows := append(append([]OfferPipelineStage{}, p.Stages...), invoiced, total)
+	for _, row := range rows {
+		record := []string{
+			string(row.Status),
+			strconv.Itoa(row.Count),
+			formatAmount(row.NetTotal),
+			formatAmount(row.GrossTotal),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON raporu JSON olarak yazar
+func (p *OfferPipeline) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(p)
+}
+
+// Pipeline düzenlenme tarihi from ile to arasındaki (dahil; sıfır değer sınır
+// koymaz) arşivlenmemiş teklifleri durumlarına göre gruplar. Durumu boş
+// teklifler pending sayılır. Dövizli teklifler teklif kuru ile TRL'ye çevrilir.
+func (s *SalesOffersService) Pipeline(ctx context.Context, from, to time.Time) (*OfferPipeline, error) {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, fmt.Errorf("bitiş tarihi başlangıç tarihinden önce olamaz")
+	}
+
+	offers, err := ListAll(ctx, s.List, &ListParams{Sort: "issue_date"})
+	if err != nil {
+		return nil, err
+	}
+	return buildOfferPipeline(offers, from, to)
+}
+
+// buildOfferPipeline teklif listesinden aşama raporunu oluşturur
+func buildOfferPipeline(offers []SalesOffer, from, to time.Time) (*OfferPipeline, error) {
+	pipeline := &OfferPipeline{From: from, To: to}
+	stages := map[SalesOfferStatus]*OfferPipelineStage{}
+
+	for _, offer := range offers {
+		if offer.Attributes.Archived || !withinDates(offer.Attributes.IssueDate, from, to) {
+			continue
+		}
+
+		net, err := offerAmountInTRL(offer, offer.Attributes.NetTotal)
+		if err != nil {
+			return nil, err
+		}
+		gross, err := offerAmountInTRL(offer, offer.Attributes.GrossTotal)
+		if err != nil {
+			return nil, err
+		}
+
+		status := offer.Attributes.Status
+		if status == "" {
+			status = SalesOfferPending
+		}
+		stage, ok := stages[status]
+		if !ok {
+			stage = &OfferPipelineStage{Status: status}
+			stages[status] = stage
+		}
+		stage.add(net, gross)
+		pipeline.Total.add(net, gross)
+		if offer.Relationships.SalesInvoice != nil {
+			pipeline.Invoiced.add(net, gross)
+		}
+	}
+
+	for _, status := range SalesOfferStatuses {
+		if stage, ok := stages[status]; ok {
+			pipeline.Stages = append(pipeline.Stages, *stage)
+		}
+	}
+	var others []OfferPipelineStage
+	for status, stage := range stages {
+		if !status.Valid() {
+			others = append(others, *stage)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].Status < others[j].Status })
+	pipeline.Stages = append(pipeline.Stages, others...)
+
+	accepted, rejected := stages[SalesOfferAccepted], stages[SalesOfferRejected]
+	closed := 0
+	if accepted != nil {
+		closed += accepted.Count
+	}
+	if rejected != nil {
+		closed += rejected.Count
+	}
+	if closed > 0 && accepted != nil {
+		pipeline.WinRate = math.Round(float64(accepted.Count)/float64(closed)*10000) / 10000
+	}
+
+	return pipeline, nil
+}
+
+// offerAmountInTRL teklif tutarını teklif kuruyla TRL'ye çevirir
+func offerAmountInTRL(offer SalesOffer, amount float64) (float64, error) {
+	if CurrencyOrDefault(offer.Attributes.Currency) == "TRL" {
+		return amount, nil
+	}
+	if offer.Attributes.ExchangeRate <= 0 {
+		return 0, fmt.Errorf("%s numaralı %s teklifinin kuru yok", offer.ID, offer.Attributes.Currency)
+	}
+	return RoundAmount(amount * offer.Attributes.ExchangeRate), nil
+}
@@ -0,0 +1,180 @@
+package parasut
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSalesOfferStatus_Valid(t *testing.T) {
+	for _, status := range []SalesOfferStatus{SalesOfferPending, SalesOfferAccepted, SalesOfferRejected} {
+		if !status.Valid() {
+			t.Errorf("%s geçerli olmalı", status)
+		}
+	}
+	if SalesOfferStatus("won").Valid() {
+		t.Error("won geçersiz olmalı")
+	}
+
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Beklenmeyen istek: %s %s", r.Method, r.URL.Path)
+	})
+	if _, err := client.SalesOffers.UpdateStatus(context.Background(), "1", "won"); err == nil {
+		t.Error("Geçersiz durum için hata bekleniyordu")
+	}
+}
+
+const testOfferDocument = `{
+	"data": {
+		"id": "5", "type": "sales_offers",
+		"attributes": {"issue_date": "2024-05-01", "description": "Kurulum", "currency": "USD", "exchange_rate": 32.5, "invoice_discount_type": "percentage", "invoice_discount": 10, "status": "%s"},
+		"relationships": {
+			"contact": {"data": {"id": "3", "type": "contacts"}},
+			"details": {"data": [{"id": "8", "type": "sales_offer_details"}]},
+			"tags": {"data": [{"id": "2", "type": "tags"}]}%s
+		}
+	},
+	"included": [
+		{"id": "8", "type": "sales_offer_details", "attributes": {"quantity": 2, "unit_price": 100, "vat_rate": 20, "discount_type": "amount", "discount_value": 5, "description": "Sunucu"}, "relationships": {"product": {"data": {"id": "11", "type": "products"}}}}
+	]
+}`
+
+func TestSalesOffersService_ConvertToInvoice(t *testing.T) {
+	status, extra := "pending", ""
+	var invoiceBody map[string]interface{}
+	var statusBody []byte
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/sales_offers/5":
+			if r.URL.Query().Get("include") != "details" {
+				t.Errorf("include = %q", r.URL.Query().Get("include"))
+			}
+			w.Write([]byte(strings.Replace(strings.Replace(testOfferDocument, "%s", status, 1), "%s", extra, 1)))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/123/sales_invoices":
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &invoiceBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "40", "type": "sales_invoices", "attributes": {"item_type": "invoice"}}}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/v4/123/sales_offers/5/update_status":
+			statusBody, _ = io.ReadAll(r.Body)
+			w.Write([]byte(`{"data": {"id": "5", "type": "sales_offers", "attributes": {"status": "accepted"}}}`))
+		default:
+			t.Errorf("Beklenmeyen istek: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	invoice, err := client.SalesOffers.ConvertToInvoice(ctx, "5")
+	if err != nil {
+		t.Fatalf("ConvertToInvoice hata döndü: %v", err)
+	}
+	if invoice.ID != "40" {
+		t.Errorf("Fatura ID = %s", invoice.ID)
+	}
+
+	data := invoiceBody["data"].(map[string]interface{})
+	attributes := data["attributes"].(map[string]interface{})
+	if attributes["item_type"] != "invoice" || attributes["currency"] != "USD" || attributes["exchange_rate"] != 32.5 ||
+		attributes["invoice_discount"] != 10.0 || attributes["description"] != "Kurulum" {
+		t.Errorf("Fatura nitelikleri = %v", attributes)
+	}
+	relationships := data["relationships"].(map[string]interface{})
+	if offer := relationships["sales_offer"].(map[string]interface{}); offer["id"] != "5" {
+		t.Errorf("sales_offer = %v", offer)
+	}
+	if contact := relationships["contact"].(map[string]interface{}); contact["id"] != "3" {
+		t.Errorf("contact = %v", contact)
+	}
+	details := relationships["details"].(map[string]interface{})["data"].([]interface{})
+	if len(details) != 1 {
+		t.Fatalf("Kalem sayısı = %d", len(details))
+	}
+	detail := details[0].(map[string]interface{})
+	detailAttributes := detail["attributes"].(map[string]interface{})
+	if detail["type"] != "sales_invoice_details" || detailAttributes["quantity"] != 2.0 || detailAttributes["discount_value"] != 5.0 {
+		t.Errorf("Kalem = %v", detail)
+	}
+	product := detail["relationships"].(map[string]interface{})["product"].(map[string]interface{})["data"].(map[string]interface{})
+	if product["id"] != "11" {
+		t.Errorf("Ürün = %v", product)
+	}
+	if !bytes.Contains(statusBody, []byte(`"accepted"`)) {
+		t.Errorf("Durum gövdesi = %s", statusBody)
+	}
+
+	status = "rejected"
+	if _, err := client.SalesOffers.ConvertToInvoice(ctx, "5"); err == nil || !strings.Contains(err.Error(), "reddedilmiş") {
+		t.Errorf("Reddedilmiş teklif hatası = %v", err)
+	}
+
+	status, extra = "accepted", `, "sales_invoice": {"data": {"id": "40", "type": "sales_invoices"}}`
+	if _, err := client.SalesOffers.ConvertToInvoice(ctx, "5"); err == nil || !strings.Contains(err.Error(), "40") {
+		t.Errorf("Faturalaşmış teklif hatası = %v", err)
+	}
+}
+
+func TestBuildOfferPipeline(t *testing.T) {
+	offer := func(id, date, status, currency string, rate, net, gross float64, invoiced bool) SalesOffer {
+		o := SalesOffer{ID: id, Attributes: SalesOfferAttributes{
+			IssueDate: date, Status: SalesOfferStatus(status), Currency: currency, ExchangeRate: rate, NetTotal: net, GrossTotal: gross,
+		}}
+		if invoiced {
+			o.Relationships.SalesInvoice = &RelationshipData{ID: "9" + id, Type: "sales_invoices"}
+		}
+		return o
+	}
+	archived := offer("6", "2024-05-10", "pending", "TRL", 0, 1000, 1200, false)
+	archived.Attributes.Archived = true
+	offers := []SalesOffer{
+		offer("1", "2024-05-02", "", "TRL", 0, 100, 120, false),
+		offer("2", "2024-05-03", "pending", "USD", 30, 10, 12, false),
+		offer("3", "2024-05-04", "accepted", "TRL", 0, 200, 240, true),
+		offer("4", "2024-05-05", "rejected", "TRL", 0, 50, 60, false),
+		offer("5", "2024-04-30", "accepted", "TRL", 0, 999, 999, false),
+		offer("7", "2024-05-06", "expired", "TRL", 0, 10, 12, false),
+		archived,
+	}
+
+	pipeline, err := buildOfferPipeline(offers, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("buildOfferPipeline hata döndü: %v", err)
+	}
+
+	var order []SalesOfferStatus
+	for _, stage := range pipeline.Stages {
+		order = append(order, stage.Status)
+	}
+	if len(order) != 4 || order[0] != SalesOfferPending || order[3] != "expired" {
+		t.Errorf("Aşamalar = %v", order)
+	}
+	if pending := pipeline.Stage(SalesOfferPending); pending.Count != 2 || pending.NetTotal != 400 || pending.GrossTotal != 480 {
+		t.Errorf("pending = %+v", pending)
+	}
+	if pipeline.Invoiced.Count != 1 || pipeline.Invoiced.GrossTotal != 240 {
+		t.Errorf("Invoiced = %+v", pipeline.Invoiced)
+	}
+	if pipeline.Total.Count != 5 || pipeline.Total.GrossTotal != 792 {
+		t.Errorf("Total = %+v", pipeline.Total)
+	}
+	if pipeline.WinRate != 0.5 {
+		t.Errorf("WinRate = %v", pipeline.WinRate)
+	}
+
+	var buf bytes.Buffer
+	if err := pipeline.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV hata döndü: %v", err)
+	}
+	if !strings.Contains(buf.String(), "invoiced,1,200.00,240.00") || !strings.Contains(buf.String(), "total,5,") {
+		t.Errorf("CSV = %s", buf.String())
+	}
+
+	if _, err := buildOfferPipeline([]SalesOffer{offer("8", "2024-05-02", "pending", "EUR", 0, 1, 1, false)}, time.Time{}, time.Time{}); err == nil {
+		t.Error("Kuru olmayan dövizli teklif için hata bekleniyordu")
+	}
+}
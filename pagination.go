@@ -0,0 +1,51 @@
+package parasut
+
+import (
+	"context"
+)
+
+// ListAll verilen listeleme fonksiyonunu sayfa sayfa çağırarak tüm kayıtları toplar.
+// Servislerin List metodlarıyla doğrudan kullanılabilir:
+//
+//	invoices, err := parasut.ListAll(ctx, client.SalesInvoices.List, &parasut.ListParams{PageSize: 25})
+func ListAll[T any](ctx context.Context, fn func(context.Context, *ListParams) ([]T, *Meta, error), params *ListParams) ([]T, error) {
+	var all []T
+	err := EachPage(ctx, fn, params, func(items []T, meta *Meta) error {
+		all = append(all, items...)
+		return nil
+	})
+	return all, err
+}
+
+// EachPage listeleme fonksiyonunu sayfa sayfa çağırır ve her sayfayı callback'e iletir.
+// Callback hata döndürürse gezinme durur ve hata geri döndürülür.
+func EachPage[T any](ctx context.Context, fn func(context.Context, *ListParams) ([]T, *Meta, error), params *ListParams, callback func([]T, *Meta) error) error {
+	pageParams := ListParams{}
+	if params != nil {
+		pageParams = *params
+	}
+	if pageParams.Page <= 0 {
+		pageParams.Page = 1
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		items, meta, err := fn(ctx, &pageParams)
+		if err != nil {
+			return err
+		}
+
+		if err := callback(items, meta); err != nil {
+			return err
+		}
+
+		if len(items) == 0 || meta == nil || pageParams.Page >= meta.TotalPages {
+			return nil
+		}
+
+		pageParams.Page++
+	}
+}
@@ -0,0 +1,57 @@
+package parasut
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestListAll(t *testing.T) {
+	var requestedPages []int
+	fn := func(ctx context.Context, params *ListParams) ([]Tag, *Meta, error) {
+		requestedPages = append(requestedPages, params.Page)
+		if params.PageSize != 2 {
+			t.Errorf("PageSize = %d, beklenen 2", params.PageSize)
+		}
+
+		tags := []Tag{{ID: "a"}, {ID: "b"}}
+		if params.Page == 3 {
+			tags = tags[:1]
+		}
+		return tags, &Meta{CurrentPage: params.Page, TotalPages: 3, TotalCount: 5}, nil
+	}
+
+	tags, err := ListAll(context.Background(), fn, &ListParams{PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListAll hata döndü: %v", err)
+	}
+
+	if len(tags) != 5 {
+		t.Errorf("Kayıt sayısı = %d, beklenen 5", len(tags))
+	}
+
+	if len(requestedPages) != 3 || requestedPages[0] != 1 || requestedPages[2] != 3 {
+		t.Errorf("İstenen sayfalar = %v, beklenen [1 2 3]", requestedPages)
+	}
+}
+
+func TestEachPage_StopsOnCallbackError(t *testing.T) {
+	calls := 0
+	fn := func(ctx context.Context, params *ListParams) ([]Tag, *Meta, error) {
+		calls++
+		return []Tag{{ID: "a"}}, &Meta{CurrentPage: params.Page, TotalPages: 10}, nil
+	}
+
+	stop := errors.New("dur")
+	err := EachPage(context.Background(), fn, nil, func(tags []Tag, meta *Meta) error {
+		return stop
+	})
+
+	if !errors.Is(err, stop) {
+		t.Errorf("EachPage hatası = %v, beklenen %v", err, stop)
+	}
+
+	if calls != 1 {
+		t.Errorf("Çağrı sayısı = %d, beklenen 1", calls)
+	}
+}
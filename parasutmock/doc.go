@@ -0,0 +1,17 @@
+// Package parasutmock parasut servis arayüzlerinin (ContactsAPI,
+// SalesInvoicesAPI, ...) HTTP gerektirmeyen sahte uygulamalarını sunar.
+// Her sahte tip, metod başına bir XxxFunc alanı taşır ve yapılan çağrıları
+// kaydeder:
+//
+//	contacts := &parasutmock.ContactsAPI{
+//		GetFunc: func(ctx context.Context, id string, include ...string) (*parasut.Contact, error) {
+//			return &parasut.Contact{ID: id}, nil
+//		},
+//	}
+//	contacts.Get(ctx, "1")
+//	contacts.CallCount("Get") // 1
+//
+// XxxFunc tanımlı değilse metod sıfır değerler ve ErrNotConfigured döndürür.
+package parasutmock
+
+//go:generate go run gen.go
@@ -0,0 +1,175 @@
+//go:build ignore
+
+// gen.go ../interfaces.go dosyasındaki servis arayüzlerinden mocks.go'yu üretir.
+// Kullanım: go generate ./parasutmock
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "../interfaces.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var body bytes.Buffer
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			iface, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(typeSpec.Name.Name, "API") {
+				continue
+			}
+			writeMock(&body, typeSpec.Name.Name, iface)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package parasutmock\n\nimport (\n\t\"context\"\n")
+	if bytes.Contains(body.Bytes(), []byte("time.")) {
+		buf.WriteString("\t\"time\"\n")
+	}
+	buf.WriteString("\n\t\"github.com/parevo-lab/parasut\"\n)\n\n")
+	buf.Write(body.Bytes())
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("%v\n%s", err, buf.String())
+	}
+	if err := os.WriteFile("mocks.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type method struct {
+	name     string
+	params   []param
+	results  []string
+	variadic bool
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+func writeMock(buf *bytes.Buffer, name string, iface *ast.InterfaceType) {
+	var methods []method
+	for _, field := range iface.Methods.List {
+		fn := field.Type.(*ast.FuncType)
+		m := method{name: field.Names[0].Name}
+		for i, p := range fn.Params.List {
+			typ := typeString(p.Type)
+			if _, ok := p.Type.(*ast.Ellipsis); ok {
+				m.variadic = true
+			}
+			if len(p.Names) == 0 {
+				m.params = append(m.params, param{name: fmt.Sprintf("arg%d", i), typ: typ})
+			}
+			for _, n := range p.Names {
+				m.params = append(m.params, param{name: n.Name, typ: typ})
+			}
+		}
+		if fn.Results != nil {
+			for _, r := range fn.Results.List {
+				m.results = append(m.results, typeString(r.Type))
+			}
+		}
+		methods = append(methods, m)
+	}
+
+	fmt.Fprintf(buf, "// %s parasut.%s için sahte uygulama\n", name, name)
+	fmt.Fprintf(buf, "type %s struct {\n\tRecorder\n\n", name)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\t%sFunc func(%s) %s\n", m.name, paramList(m.params), resultList(m.results))
+	}
+	buf.WriteString("}\n\n")
+	fmt.Fprintf(buf, "var _ parasut.%s = (*%s)(nil)\n\n", name, name)
+
+	for _, m := range methods {
+		var args []string
+		for i, p := range m.params {
+			if m.variadic && i == len(m.params)-1 {
+				args = append(args, p.name+"...")
+				continue
+			}
+			args = append(args, p.name)
+		}
+		var recorded []string
+		for _, p := range m.params {
+			recorded = append(recorded, p.name)
+		}
+
+		fmt.Fprintf(buf, "// %s çağrıyı kaydeder ve %sFunc'u çağırır\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (m *%s) %s(%s) %s {\n", name, m.name, paramList(m.params), resultList(m.results))
+		fmt.Fprintf(buf, "\tm.record(%q, %s)\n", m.name, strings.Join(recorded, ", "))
+		fmt.Fprintf(buf, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", m.name, m.name, strings.Join(args, ", "))
+
+		var zeros []string
+		for i, r := range m.results {
+			if r == "error" {
+				zeros = append(zeros, fmt.Sprintf("notConfigured(%q, %q)", name, m.name))
+				continue
+			}
+			fmt.Fprintf(buf, "\tvar r%d %s\n", i, r)
+			zeros = append(zeros, fmt.Sprintf("r%d", i))
+		}
+		fmt.Fprintf(buf, "\treturn %s\n}\n\n", strings.Join(zeros, ", "))
+	}
+}
+
+func paramList(params []param) string {
+	var parts []string
+	for _, p := range params {
+		parts = append(parts, p.name+" "+p.typ)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func resultList(results []string) string {
+	if len(results) == 1 {
+		return results[0]
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// typeString tip ifadesini parasut paketi dışından kullanılacak biçimde yazar
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "parasut." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.SelectorExpr:
+		return t.X.(*ast.Ident).Name + "." + t.Sel.Name
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+	log.Fatalf("desteklenmeyen tip: %T", expr)
+	return ""
+}
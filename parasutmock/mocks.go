@@ -0,0 +1,1852 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package parasutmock
+
+import (
+	"context"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// MeAPI parasut.MeAPI için sahte uygulama
+type MeAPI struct {
+	Recorder
+
+	GetFunc func(ctx context.Context, includeParams ...string) (*parasut.Me, error)
+}
+
+var _ parasut.MeAPI = (*MeAPI)(nil)
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *MeAPI) Get(ctx context.Context, includeParams ...string) (*parasut.Me, error) {
+	m.record("Get", ctx, includeParams)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, includeParams...)
+	}
+	var r0 *parasut.Me
+	return r0, notConfigured("MeAPI", "Get")
+}
+
+// AccountsAPI parasut.AccountsAPI için sahte uygulama
+type AccountsAPI struct {
+	Recorder
+
+	ListFunc                    func(ctx context.Context, params *parasut.ListParams) ([]parasut.Account, *parasut.Meta, error)
+	GetFunc                     func(ctx context.Context, id string) (*parasut.Account, error)
+	CreateFunc                  func(ctx context.Context, attributes parasut.AccountInput) (*parasut.Account, error)
+	UpdateFunc                  func(ctx context.Context, id string, attributes parasut.AccountInput) (*parasut.Account, error)
+	DeleteFunc                  func(ctx context.Context, id string) error
+	GetTransactionsFunc         func(ctx context.Context, accountID string, params *parasut.ListParams) ([]parasut.AccountTransaction, *parasut.Meta, error)
+	ListTransactionsPageFunc    func(ctx context.Context, accountID string, filter parasut.AccountTransactionFilter, params *parasut.ListParams) ([]parasut.AccountTransaction, *parasut.Meta, error)
+	ListTransactionsFunc        func(ctx context.Context, accountID string, filter parasut.AccountTransactionFilter) ([]parasut.AccountTransaction, error)
+	CreateDebitTransactionFunc  func(ctx context.Context, accountID string, attributes parasut.AccountTransactionInput) (*parasut.AccountTransaction, error)
+	CreateCreditTransactionFunc func(ctx context.Context, accountID string, attributes parasut.AccountTransactionInput) (*parasut.AccountTransaction, error)
+	TransferFunc                func(ctx context.Context, fromID string, toID string, amount float64, date string, exchangeRate float64) (*parasut.AccountTransfer, error)
+}
+
+var _ parasut.AccountsAPI = (*AccountsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *AccountsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Account, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Account
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("AccountsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *AccountsAPI) Get(ctx context.Context, id string) (*parasut.Account, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Account
+	return r0, notConfigured("AccountsAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *AccountsAPI) Create(ctx context.Context, attributes parasut.AccountInput) (*parasut.Account, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.Account
+	return r0, notConfigured("AccountsAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *AccountsAPI) Update(ctx context.Context, id string, attributes parasut.AccountInput) (*parasut.Account, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.Account
+	return r0, notConfigured("AccountsAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *AccountsAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("AccountsAPI", "Delete")
+}
+
+// GetTransactions çağrıyı kaydeder ve GetTransactionsFunc'u çağırır
+func (m *AccountsAPI) GetTransactions(ctx context.Context, accountID string, params *parasut.ListParams) ([]parasut.AccountTransaction, *parasut.Meta, error) {
+	m.record("GetTransactions", ctx, accountID, params)
+	if m.GetTransactionsFunc != nil {
+		return m.GetTransactionsFunc(ctx, accountID, params)
+	}
+	var r0 []parasut.AccountTransaction
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("AccountsAPI", "GetTransactions")
+}
+
+// ListTransactionsPage çağrıyı kaydeder ve ListTransactionsPageFunc'u çağırır
+func (m *AccountsAPI) ListTransactionsPage(ctx context.Context, accountID string, filter parasut.AccountTransactionFilter, params *parasut.ListParams) ([]parasut.AccountTransaction, *parasut.Meta, error) {
+	m.record("ListTransactionsPage", ctx, accountID, filter, params)
+	if m.ListTransactionsPageFunc != nil {
+		return m.ListTransactionsPageFunc(ctx, accountID, filter, params)
+	}
+	var r0 []parasut.AccountTransaction
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("AccountsAPI", "ListTransactionsPage")
+}
+
+// ListTransactions çağrıyı kaydeder ve ListTransactionsFunc'u çağırır
+func (m *AccountsAPI) ListTransactions(ctx context.Context, accountID string, filter parasut.AccountTransactionFilter) ([]parasut.AccountTransaction, error) {
+	m.record("ListTransactions", ctx, accountID, filter)
+	if m.ListTransactionsFunc != nil {
+		return m.ListTransactionsFunc(ctx, accountID, filter)
+	}
+	var r0 []parasut.AccountTransaction
+	return r0, notConfigured("AccountsAPI", "ListTransactions")
+}
+
+// CreateDebitTransaction çağrıyı kaydeder ve CreateDebitTransactionFunc'u çağırır
+func (m *AccountsAPI) CreateDebitTransaction(ctx context.Context, accountID string, attributes parasut.AccountTransactionInput) (*parasut.AccountTransaction, error) {
+	m.record("CreateDebitTransaction", ctx, accountID, attributes)
+	if m.CreateDebitTransactionFunc != nil {
+		return m.CreateDebitTransactionFunc(ctx, accountID, attributes)
+	}
+	var r0 *parasut.AccountTransaction
+	return r0, notConfigured("AccountsAPI", "CreateDebitTransaction")
+}
+
+// CreateCreditTransaction çağrıyı kaydeder ve CreateCreditTransactionFunc'u çağırır
+func (m *AccountsAPI) CreateCreditTransaction(ctx context.Context, accountID string, attributes parasut.AccountTransactionInput) (*parasut.AccountTransaction, error) {
+	m.record("CreateCreditTransaction", ctx, accountID, attributes)
+	if m.CreateCreditTransactionFunc != nil {
+		return m.CreateCreditTransactionFunc(ctx, accountID, attributes)
+	}
+	var r0 *parasut.AccountTransaction
+	return r0, notConfigured("AccountsAPI", "CreateCreditTransaction")
+}
+
+// Transfer çağrıyı kaydeder ve TransferFunc'u çağırır
+func (m *AccountsAPI) Transfer(ctx context.Context, fromID string, toID string, amount float64, date string, exchangeRate float64) (*parasut.AccountTransfer, error) {
+	m.record("Transfer", ctx, fromID, toID, amount, date, exchangeRate)
+	if m.TransferFunc != nil {
+		return m.TransferFunc(ctx, fromID, toID, amount, date, exchangeRate)
+	}
+	var r0 *parasut.AccountTransfer
+	return r0, notConfigured("AccountsAPI", "Transfer")
+}
+
+// BankFeesAPI parasut.BankFeesAPI için sahte uygulama
+type BankFeesAPI struct {
+	Recorder
+
+	ListFunc          func(ctx context.Context, params *parasut.ListParams) ([]parasut.BankFee, *parasut.Meta, error)
+	GetFunc           func(ctx context.Context, id string) (*parasut.BankFee, error)
+	CreateFunc        func(ctx context.Context, attributes parasut.BankFeeInput) (*parasut.BankFee, error)
+	UpdateFunc        func(ctx context.Context, id string, attributes parasut.BankFeeInput) (*parasut.BankFee, error)
+	ArchiveFunc       func(ctx context.Context, id string) error
+	UnarchiveFunc     func(ctx context.Context, id string) error
+	CreatePaymentFunc func(ctx context.Context, bankFeeID string, attributes parasut.PaymentInput) (*parasut.Payment, error)
+}
+
+var _ parasut.BankFeesAPI = (*BankFeesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *BankFeesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.BankFee, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.BankFee
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("BankFeesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *BankFeesAPI) Get(ctx context.Context, id string) (*parasut.BankFee, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.BankFee
+	return r0, notConfigured("BankFeesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *BankFeesAPI) Create(ctx context.Context, attributes parasut.BankFeeInput) (*parasut.BankFee, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.BankFee
+	return r0, notConfigured("BankFeesAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *BankFeesAPI) Update(ctx context.Context, id string, attributes parasut.BankFeeInput) (*parasut.BankFee, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.BankFee
+	return r0, notConfigured("BankFeesAPI", "Update")
+}
+
+// Archive çağrıyı kaydeder ve ArchiveFunc'u çağırır
+func (m *BankFeesAPI) Archive(ctx context.Context, id string) error {
+	m.record("Archive", ctx, id)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id)
+	}
+	return notConfigured("BankFeesAPI", "Archive")
+}
+
+// Unarchive çağrıyı kaydeder ve UnarchiveFunc'u çağırır
+func (m *BankFeesAPI) Unarchive(ctx context.Context, id string) error {
+	m.record("Unarchive", ctx, id)
+	if m.UnarchiveFunc != nil {
+		return m.UnarchiveFunc(ctx, id)
+	}
+	return notConfigured("BankFeesAPI", "Unarchive")
+}
+
+// CreatePayment çağrıyı kaydeder ve CreatePaymentFunc'u çağırır
+func (m *BankFeesAPI) CreatePayment(ctx context.Context, bankFeeID string, attributes parasut.PaymentInput) (*parasut.Payment, error) {
+	m.record("CreatePayment", ctx, bankFeeID, attributes)
+	if m.CreatePaymentFunc != nil {
+		return m.CreatePaymentFunc(ctx, bankFeeID, attributes)
+	}
+	var r0 *parasut.Payment
+	return r0, notConfigured("BankFeesAPI", "CreatePayment")
+}
+
+// ContactsAPI parasut.ContactsAPI için sahte uygulama
+type ContactsAPI struct {
+	Recorder
+
+	ListFunc                  func(ctx context.Context, params *parasut.ListParams) ([]parasut.Contact, *parasut.Meta, error)
+	GetFunc                   func(ctx context.Context, id string, include ...string) (*parasut.Contact, error)
+	CreateFunc                func(ctx context.Context, attributes parasut.ContactInput, relationships *parasut.ContactRelationshipsInput) (*parasut.Contact, error)
+	UpdateFunc                func(ctx context.Context, id string, attributes parasut.ContactInput, relationships *parasut.ContactRelationshipsInput) (*parasut.Contact, error)
+	DeleteFunc                func(ctx context.Context, id string) error
+	GetDebitTransactionsFunc  func(ctx context.Context, contactID string, params *parasut.ListParams) ([]parasut.ContactTransaction, *parasut.Meta, error)
+	GetCreditTransactionsFunc func(ctx context.Context, contactID string, params *parasut.ListParams) ([]parasut.ContactTransaction, *parasut.Meta, error)
+	StatementFunc             func(ctx context.Context, contactID string, from time.Time, to time.Time) (*parasut.Statement, error)
+}
+
+var _ parasut.ContactsAPI = (*ContactsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *ContactsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Contact, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Contact
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ContactsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *ContactsAPI) Get(ctx context.Context, id string, include ...string) (*parasut.Contact, error) {
+	m.record("Get", ctx, id, include)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id, include...)
+	}
+	var r0 *parasut.Contact
+	return r0, notConfigured("ContactsAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *ContactsAPI) Create(ctx context.Context, attributes parasut.ContactInput, relationships *parasut.ContactRelationshipsInput) (*parasut.Contact, error) {
+	m.record("Create", ctx, attributes, relationships)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes, relationships)
+	}
+	var r0 *parasut.Contact
+	return r0, notConfigured("ContactsAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *ContactsAPI) Update(ctx context.Context, id string, attributes parasut.ContactInput, relationships *parasut.ContactRelationshipsInput) (*parasut.Contact, error) {
+	m.record("Update", ctx, id, attributes, relationships)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes, relationships)
+	}
+	var r0 *parasut.Contact
+	return r0, notConfigured("ContactsAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *ContactsAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("ContactsAPI", "Delete")
+}
+
+// GetDebitTransactions çağrıyı kaydeder ve GetDebitTransactionsFunc'u çağırır
+func (m *ContactsAPI) GetDebitTransactions(ctx context.Context, contactID string, params *parasut.ListParams) ([]parasut.ContactTransaction, *parasut.Meta, error) {
+	m.record("GetDebitTransactions", ctx, contactID, params)
+	if m.GetDebitTransactionsFunc != nil {
+		return m.GetDebitTransactionsFunc(ctx, contactID, params)
+	}
+	var r0 []parasut.ContactTransaction
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ContactsAPI", "GetDebitTransactions")
+}
+
+// GetCreditTransactions çağrıyı kaydeder ve GetCreditTransactionsFunc'u çağırır
+func (m *ContactsAPI) GetCreditTransactions(ctx context.Context, contactID string, params *parasut.ListParams) ([]parasut.ContactTransaction, *parasut.Meta, error) {
+	m.record("GetCreditTransactions", ctx, contactID, params)
+	if m.GetCreditTransactionsFunc != nil {
+		return m.GetCreditTransactionsFunc(ctx, contactID, params)
+	}
+	var r0 []parasut.ContactTransaction
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ContactsAPI", "GetCreditTransactions")
+}
+
+// Statement çağrıyı kaydeder ve StatementFunc'u çağırır
+func (m *ContactsAPI) Statement(ctx context.Context, contactID string, from time.Time, to time.Time) (*parasut.Statement, error) {
+	m.record("Statement", ctx, contactID, from, to)
+	if m.StatementFunc != nil {
+		return m.StatementFunc(ctx, contactID, from, to)
+	}
+	var r0 *parasut.Statement
+	return r0, notConfigured("ContactsAPI", "Statement")
+}
+
+// EArchivesAPI parasut.EArchivesAPI için sahte uygulama
+type EArchivesAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.EArchive, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.EArchive, error)
+	CreateFunc func(ctx context.Context, invoiceID string, attributes parasut.EArchiveInput) (*parasut.EArchive, error)
+	GetPDFFunc func(ctx context.Context, id string) ([]byte, error)
+}
+
+var _ parasut.EArchivesAPI = (*EArchivesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *EArchivesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.EArchive, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.EArchive
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("EArchivesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *EArchivesAPI) Get(ctx context.Context, id string) (*parasut.EArchive, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.EArchive
+	return r0, notConfigured("EArchivesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *EArchivesAPI) Create(ctx context.Context, invoiceID string, attributes parasut.EArchiveInput) (*parasut.EArchive, error) {
+	m.record("Create", ctx, invoiceID, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, invoiceID, attributes)
+	}
+	var r0 *parasut.EArchive
+	return r0, notConfigured("EArchivesAPI", "Create")
+}
+
+// GetPDF çağrıyı kaydeder ve GetPDFFunc'u çağırır
+func (m *EArchivesAPI) GetPDF(ctx context.Context, id string) ([]byte, error) {
+	m.record("GetPDF", ctx, id)
+	if m.GetPDFFunc != nil {
+		return m.GetPDFFunc(ctx, id)
+	}
+	var r0 []byte
+	return r0, notConfigured("EArchivesAPI", "GetPDF")
+}
+
+// EInvoiceInboxesAPI parasut.EInvoiceInboxesAPI için sahte uygulama
+type EInvoiceInboxesAPI struct {
+	Recorder
+
+	ListFunc func(ctx context.Context, params *parasut.ListParams) ([]parasut.EInvoiceInbox, *parasut.Meta, error)
+}
+
+var _ parasut.EInvoiceInboxesAPI = (*EInvoiceInboxesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *EInvoiceInboxesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.EInvoiceInbox, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.EInvoiceInbox
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("EInvoiceInboxesAPI", "List")
+}
+
+// EInvoicesAPI parasut.EInvoicesAPI için sahte uygulama
+type EInvoicesAPI struct {
+	Recorder
+
+	ListFunc             func(ctx context.Context, params *parasut.ListParams) ([]parasut.EInvoice, *parasut.Meta, error)
+	GetFunc              func(ctx context.Context, id string) (*parasut.EInvoice, error)
+	CreateFunc           func(ctx context.Context, attributes parasut.EInvoiceInput) (*parasut.EInvoice, error)
+	CreateForInvoiceFunc func(ctx context.Context, invoiceID string, attributes parasut.EInvoiceInput) (*parasut.EInvoice, error)
+	GetPDFFunc           func(ctx context.Context, id string) ([]byte, error)
+}
+
+var _ parasut.EInvoicesAPI = (*EInvoicesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *EInvoicesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.EInvoice, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.EInvoice
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("EInvoicesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *EInvoicesAPI) Get(ctx context.Context, id string) (*parasut.EInvoice, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.EInvoice
+	return r0, notConfigured("EInvoicesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *EInvoicesAPI) Create(ctx context.Context, attributes parasut.EInvoiceInput) (*parasut.EInvoice, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.EInvoice
+	return r0, notConfigured("EInvoicesAPI", "Create")
+}
+
+// CreateForInvoice çağrıyı kaydeder ve CreateForInvoiceFunc'u çağırır
+func (m *EInvoicesAPI) CreateForInvoice(ctx context.Context, invoiceID string, attributes parasut.EInvoiceInput) (*parasut.EInvoice, error) {
+	m.record("CreateForInvoice", ctx, invoiceID, attributes)
+	if m.CreateForInvoiceFunc != nil {
+		return m.CreateForInvoiceFunc(ctx, invoiceID, attributes)
+	}
+	var r0 *parasut.EInvoice
+	return r0, notConfigured("EInvoicesAPI", "CreateForInvoice")
+}
+
+// GetPDF çağrıyı kaydeder ve GetPDFFunc'u çağırır
+func (m *EInvoicesAPI) GetPDF(ctx context.Context, id string) ([]byte, error) {
+	m.record("GetPDF", ctx, id)
+	if m.GetPDFFunc != nil {
+		return m.GetPDFFunc(ctx, id)
+	}
+	var r0 []byte
+	return r0, notConfigured("EInvoicesAPI", "GetPDF")
+}
+
+// ESMMsAPI parasut.ESMMsAPI için sahte uygulama
+type ESMMsAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.ESMM, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.ESMM, error)
+	CreateFunc func(ctx context.Context, attributes parasut.ESMMInput) (*parasut.ESMM, error)
+	GetPDFFunc func(ctx context.Context, id string) ([]byte, error)
+}
+
+var _ parasut.ESMMsAPI = (*ESMMsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *ESMMsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.ESMM, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.ESMM
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ESMMsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *ESMMsAPI) Get(ctx context.Context, id string) (*parasut.ESMM, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.ESMM
+	return r0, notConfigured("ESMMsAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *ESMMsAPI) Create(ctx context.Context, attributes parasut.ESMMInput) (*parasut.ESMM, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.ESMM
+	return r0, notConfigured("ESMMsAPI", "Create")
+}
+
+// GetPDF çağrıyı kaydeder ve GetPDFFunc'u çağırır
+func (m *ESMMsAPI) GetPDF(ctx context.Context, id string) ([]byte, error) {
+	m.record("GetPDF", ctx, id)
+	if m.GetPDFFunc != nil {
+		return m.GetPDFFunc(ctx, id)
+	}
+	var r0 []byte
+	return r0, notConfigured("ESMMsAPI", "GetPDF")
+}
+
+// EmployeesAPI parasut.EmployeesAPI için sahte uygulama
+type EmployeesAPI struct {
+	Recorder
+
+	ListFunc      func(ctx context.Context, params *parasut.ListParams) ([]parasut.Employee, *parasut.Meta, error)
+	GetFunc       func(ctx context.Context, id string) (*parasut.Employee, error)
+	CreateFunc    func(ctx context.Context, attributes parasut.EmployeeInput) (*parasut.Employee, error)
+	UpdateFunc    func(ctx context.Context, id string, attributes parasut.EmployeeInput) (*parasut.Employee, error)
+	ArchiveFunc   func(ctx context.Context, id string) error
+	UnarchiveFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.EmployeesAPI = (*EmployeesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *EmployeesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Employee, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Employee
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("EmployeesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *EmployeesAPI) Get(ctx context.Context, id string) (*parasut.Employee, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Employee
+	return r0, notConfigured("EmployeesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *EmployeesAPI) Create(ctx context.Context, attributes parasut.EmployeeInput) (*parasut.Employee, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.Employee
+	return r0, notConfigured("EmployeesAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *EmployeesAPI) Update(ctx context.Context, id string, attributes parasut.EmployeeInput) (*parasut.Employee, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.Employee
+	return r0, notConfigured("EmployeesAPI", "Update")
+}
+
+// Archive çağrıyı kaydeder ve ArchiveFunc'u çağırır
+func (m *EmployeesAPI) Archive(ctx context.Context, id string) error {
+	m.record("Archive", ctx, id)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id)
+	}
+	return notConfigured("EmployeesAPI", "Archive")
+}
+
+// Unarchive çağrıyı kaydeder ve UnarchiveFunc'u çağırır
+func (m *EmployeesAPI) Unarchive(ctx context.Context, id string) error {
+	m.record("Unarchive", ctx, id)
+	if m.UnarchiveFunc != nil {
+		return m.UnarchiveFunc(ctx, id)
+	}
+	return notConfigured("EmployeesAPI", "Unarchive")
+}
+
+// ItemCategoriesAPI parasut.ItemCategoriesAPI için sahte uygulama
+type ItemCategoriesAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.ItemCategory, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.ItemCategory, error)
+	CreateFunc func(ctx context.Context, attributes parasut.ItemCategoryInput) (*parasut.ItemCategory, error)
+	UpdateFunc func(ctx context.Context, id string, attributes parasut.ItemCategoryInput) (*parasut.ItemCategory, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.ItemCategoriesAPI = (*ItemCategoriesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *ItemCategoriesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.ItemCategory, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.ItemCategory
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ItemCategoriesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *ItemCategoriesAPI) Get(ctx context.Context, id string) (*parasut.ItemCategory, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.ItemCategory
+	return r0, notConfigured("ItemCategoriesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *ItemCategoriesAPI) Create(ctx context.Context, attributes parasut.ItemCategoryInput) (*parasut.ItemCategory, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.ItemCategory
+	return r0, notConfigured("ItemCategoriesAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *ItemCategoriesAPI) Update(ctx context.Context, id string, attributes parasut.ItemCategoryInput) (*parasut.ItemCategory, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.ItemCategory
+	return r0, notConfigured("ItemCategoriesAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *ItemCategoriesAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("ItemCategoriesAPI", "Delete")
+}
+
+// PaymentsAPI parasut.PaymentsAPI için sahte uygulama
+type PaymentsAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, payableType string, payableID string) ([]parasut.Payment, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.Payment, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.PaymentsAPI = (*PaymentsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *PaymentsAPI) List(ctx context.Context, payableType string, payableID string) ([]parasut.Payment, error) {
+	m.record("List", ctx, payableType, payableID)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, payableType, payableID)
+	}
+	var r0 []parasut.Payment
+	return r0, notConfigured("PaymentsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *PaymentsAPI) Get(ctx context.Context, id string) (*parasut.Payment, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Payment
+	return r0, notConfigured("PaymentsAPI", "Get")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *PaymentsAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("PaymentsAPI", "Delete")
+}
+
+// ProductsAPI parasut.ProductsAPI için sahte uygulama
+type ProductsAPI struct {
+	Recorder
+
+	ListFunc               func(ctx context.Context, params *parasut.ListParams) ([]parasut.Product, *parasut.Meta, error)
+	GetFunc                func(ctx context.Context, id string) (*parasut.Product, error)
+	CreateFunc             func(ctx context.Context, attributes parasut.ProductInput) (*parasut.Product, error)
+	UpdateFunc             func(ctx context.Context, id string, attributes parasut.ProductInput) (*parasut.Product, error)
+	DeleteFunc             func(ctx context.Context, id string) error
+	GetInventoryLevelsFunc func(ctx context.Context, productID string) ([]parasut.InventoryLevel, *parasut.Meta, error)
+}
+
+var _ parasut.ProductsAPI = (*ProductsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *ProductsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Product, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Product
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ProductsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *ProductsAPI) Get(ctx context.Context, id string) (*parasut.Product, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Product
+	return r0, notConfigured("ProductsAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *ProductsAPI) Create(ctx context.Context, attributes parasut.ProductInput) (*parasut.Product, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.Product
+	return r0, notConfigured("ProductsAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *ProductsAPI) Update(ctx context.Context, id string, attributes parasut.ProductInput) (*parasut.Product, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.Product
+	return r0, notConfigured("ProductsAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *ProductsAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("ProductsAPI", "Delete")
+}
+
+// GetInventoryLevels çağrıyı kaydeder ve GetInventoryLevelsFunc'u çağırır
+func (m *ProductsAPI) GetInventoryLevels(ctx context.Context, productID string) ([]parasut.InventoryLevel, *parasut.Meta, error) {
+	m.record("GetInventoryLevels", ctx, productID)
+	if m.GetInventoryLevelsFunc != nil {
+		return m.GetInventoryLevelsFunc(ctx, productID)
+	}
+	var r0 []parasut.InventoryLevel
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ProductsAPI", "GetInventoryLevels")
+}
+
+// PurchaseBillsAPI parasut.PurchaseBillsAPI için sahte uygulama
+type PurchaseBillsAPI struct {
+	Recorder
+
+	AgingFunc         func(ctx context.Context, asOf time.Time) (*parasut.AgingReport, error)
+	ListFunc          func(ctx context.Context, params *parasut.ListParams) ([]parasut.PurchaseBill, *parasut.Meta, error)
+	GetFunc           func(ctx context.Context, id string) (*parasut.PurchaseBill, error)
+	CreateFunc        func(ctx context.Context, attributes parasut.PurchaseBillInput, relationships *parasut.PurchaseBillRelationships) (*parasut.PurchaseBill, error)
+	UpdateFunc        func(ctx context.Context, id string, attributes parasut.PurchaseBillInput, relationships *parasut.PurchaseBillRelationships) (*parasut.PurchaseBill, error)
+	CreatePaymentFunc func(ctx context.Context, billID string, attributes parasut.PaymentInput) (*parasut.Payment, error)
+	CancelFunc        func(ctx context.Context, billID string) error
+	RecoverFunc       func(ctx context.Context, billID string) error
+	ArchiveFunc       func(ctx context.Context, billID string) error
+	UnarchiveFunc     func(ctx context.Context, billID string) error
+	GetPDFFunc        func(ctx context.Context, id string) ([]byte, error)
+}
+
+var _ parasut.PurchaseBillsAPI = (*PurchaseBillsAPI)(nil)
+
+// Aging çağrıyı kaydeder ve AgingFunc'u çağırır
+func (m *PurchaseBillsAPI) Aging(ctx context.Context, asOf time.Time) (*parasut.AgingReport, error) {
+	m.record("Aging", ctx, asOf)
+	if m.AgingFunc != nil {
+		return m.AgingFunc(ctx, asOf)
+	}
+	var r0 *parasut.AgingReport
+	return r0, notConfigured("PurchaseBillsAPI", "Aging")
+}
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *PurchaseBillsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.PurchaseBill, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.PurchaseBill
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("PurchaseBillsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *PurchaseBillsAPI) Get(ctx context.Context, id string) (*parasut.PurchaseBill, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.PurchaseBill
+	return r0, notConfigured("PurchaseBillsAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *PurchaseBillsAPI) Create(ctx context.Context, attributes parasut.PurchaseBillInput, relationships *parasut.PurchaseBillRelationships) (*parasut.PurchaseBill, error) {
+	m.record("Create", ctx, attributes, relationships)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes, relationships)
+	}
+	var r0 *parasut.PurchaseBill
+	return r0, notConfigured("PurchaseBillsAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *PurchaseBillsAPI) Update(ctx context.Context, id string, attributes parasut.PurchaseBillInput, relationships *parasut.PurchaseBillRelationships) (*parasut.PurchaseBill, error) {
+	m.record("Update", ctx, id, attributes, relationships)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes, relationships)
+	}
+	var r0 *parasut.PurchaseBill
+	return r0, notConfigured("PurchaseBillsAPI", "Update")
+}
+
+// CreatePayment çağrıyı kaydeder ve CreatePaymentFunc'u çağırır
+func (m *PurchaseBillsAPI) CreatePayment(ctx context.Context, billID string, attributes parasut.PaymentInput) (*parasut.Payment, error) {
+	m.record("CreatePayment", ctx, billID, attributes)
+	if m.CreatePaymentFunc != nil {
+		return m.CreatePaymentFunc(ctx, billID, attributes)
+	}
+	var r0 *parasut.Payment
+	return r0, notConfigured("PurchaseBillsAPI", "CreatePayment")
+}
+
+// Cancel çağrıyı kaydeder ve CancelFunc'u çağırır
+func (m *PurchaseBillsAPI) Cancel(ctx context.Context, billID string) error {
+	m.record("Cancel", ctx, billID)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, billID)
+	}
+	return notConfigured("PurchaseBillsAPI", "Cancel")
+}
+
+// Recover çağrıyı kaydeder ve RecoverFunc'u çağırır
+func (m *PurchaseBillsAPI) Recover(ctx context.Context, billID string) error {
+	m.record("Recover", ctx, billID)
+	if m.RecoverFunc != nil {
+		return m.RecoverFunc(ctx, billID)
+	}
+	return notConfigured("PurchaseBillsAPI", "Recover")
+}
+
+// Archive çağrıyı kaydeder ve ArchiveFunc'u çağırır
+func (m *PurchaseBillsAPI) Archive(ctx context.Context, billID string) error {
+	m.record("Archive", ctx, billID)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, billID)
+	}
+	return notConfigured("PurchaseBillsAPI", "Archive")
+}
+
+// Unarchive çağrıyı kaydeder ve UnarchiveFunc'u çağırır
+func (m *PurchaseBillsAPI) Unarchive(ctx context.Context, billID string) error {
+	m.record("Unarchive", ctx, billID)
+	if m.UnarchiveFunc != nil {
+		return m.UnarchiveFunc(ctx, billID)
+	}
+	return notConfigured("PurchaseBillsAPI", "Unarchive")
+}
+
+// GetPDF çağrıyı kaydeder ve GetPDFFunc'u çağırır
+func (m *PurchaseBillsAPI) GetPDF(ctx context.Context, id string) ([]byte, error) {
+	m.record("GetPDF", ctx, id)
+	if m.GetPDFFunc != nil {
+		return m.GetPDFFunc(ctx, id)
+	}
+	var r0 []byte
+	return r0, notConfigured("PurchaseBillsAPI", "GetPDF")
+}
+
+// SalariesAPI parasut.SalariesAPI için sahte uygulama
+type SalariesAPI struct {
+	Recorder
+
+	ListFunc          func(ctx context.Context, params *parasut.ListParams) ([]parasut.Salary, *parasut.Meta, error)
+	GetFunc           func(ctx context.Context, id string) (*parasut.Salary, error)
+	CreateFunc        func(ctx context.Context, attributes parasut.SalaryInput, relationships *parasut.SalaryRelationships) (*parasut.Salary, error)
+	UpdateFunc        func(ctx context.Context, id string, attributes parasut.SalaryInput, relationships *parasut.SalaryRelationships) (*parasut.Salary, error)
+	ArchiveFunc       func(ctx context.Context, id string) error
+	UnarchiveFunc     func(ctx context.Context, id string) error
+	CreatePaymentFunc func(ctx context.Context, salaryID string, attributes parasut.PaymentInput) (*parasut.Payment, error)
+}
+
+var _ parasut.SalariesAPI = (*SalariesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *SalariesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Salary, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Salary
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("SalariesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *SalariesAPI) Get(ctx context.Context, id string) (*parasut.Salary, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Salary
+	return r0, notConfigured("SalariesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *SalariesAPI) Create(ctx context.Context, attributes parasut.SalaryInput, relationships *parasut.SalaryRelationships) (*parasut.Salary, error) {
+	m.record("Create", ctx, attributes, relationships)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes, relationships)
+	}
+	var r0 *parasut.Salary
+	return r0, notConfigured("SalariesAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *SalariesAPI) Update(ctx context.Context, id string, attributes parasut.SalaryInput, relationships *parasut.SalaryRelationships) (*parasut.Salary, error) {
+	m.record("Update", ctx, id, attributes, relationships)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes, relationships)
+	}
+	var r0 *parasut.Salary
+	return r0, notConfigured("SalariesAPI", "Update")
+}
+
+// Archive çağrıyı kaydeder ve ArchiveFunc'u çağırır
+func (m *SalariesAPI) Archive(ctx context.Context, id string) error {
+	m.record("Archive", ctx, id)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id)
+	}
+	return notConfigured("SalariesAPI", "Archive")
+}
+
+// Unarchive çağrıyı kaydeder ve UnarchiveFunc'u çağırır
+func (m *SalariesAPI) Unarchive(ctx context.Context, id string) error {
+	m.record("Unarchive", ctx, id)
+	if m.UnarchiveFunc != nil {
+		return m.UnarchiveFunc(ctx, id)
+	}
+	return notConfigured("SalariesAPI", "Unarchive")
+}
+
+// CreatePayment çağrıyı kaydeder ve CreatePaymentFunc'u çağırır
+func (m *SalariesAPI) CreatePayment(ctx context.Context, salaryID string, attributes parasut.PaymentInput) (*parasut.Payment, error) {
+	m.record("CreatePayment", ctx, salaryID, attributes)
+	if m.CreatePaymentFunc != nil {
+		return m.CreatePaymentFunc(ctx, salaryID, attributes)
+	}
+	var r0 *parasut.Payment
+	return r0, notConfigured("SalariesAPI", "CreatePayment")
+}
+
+// RecurrencePlansAPI parasut.RecurrencePlansAPI için sahte uygulama
+type RecurrencePlansAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.RecurrencePlan, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.RecurrencePlan, error)
+	CreateFunc func(ctx context.Context, attributes parasut.RecurrencePlanInput) (*parasut.RecurrencePlan, error)
+	UpdateFunc func(ctx context.Context, id string, attributes parasut.RecurrencePlanInput) (*parasut.RecurrencePlan, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.RecurrencePlansAPI = (*RecurrencePlansAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *RecurrencePlansAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.RecurrencePlan, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.RecurrencePlan
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("RecurrencePlansAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *RecurrencePlansAPI) Get(ctx context.Context, id string) (*parasut.RecurrencePlan, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.RecurrencePlan
+	return r0, notConfigured("RecurrencePlansAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *RecurrencePlansAPI) Create(ctx context.Context, attributes parasut.RecurrencePlanInput) (*parasut.RecurrencePlan, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.RecurrencePlan
+	return r0, notConfigured("RecurrencePlansAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *RecurrencePlansAPI) Update(ctx context.Context, id string, attributes parasut.RecurrencePlanInput) (*parasut.RecurrencePlan, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.RecurrencePlan
+	return r0, notConfigured("RecurrencePlansAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *RecurrencePlansAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("RecurrencePlansAPI", "Delete")
+}
+
+// SalesInvoicesAPI parasut.SalesInvoicesAPI için sahte uygulama
+type SalesInvoicesAPI struct {
+	Recorder
+
+	AgingFunc             func(ctx context.Context, asOf time.Time) (*parasut.AgingReport, error)
+	ListFunc              func(ctx context.Context, params *parasut.ListParams) ([]parasut.SalesInvoice, *parasut.Meta, error)
+	GetFunc               func(ctx context.Context, id string, include ...string) (*parasut.SalesInvoice, error)
+	CreateFunc            func(ctx context.Context, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships) (*parasut.SalesInvoice, error)
+	CreateWithDetailsFunc func(ctx context.Context, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships, details []parasut.SalesInvoiceDetailInput) (*parasut.SalesInvoice, error)
+	UpdateFunc            func(ctx context.Context, id string, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships) (*parasut.SalesInvoice, error)
+	CancelFunc            func(ctx context.Context, id string) error
+	RecoverFunc           func(ctx context.Context, id string) error
+	ArchiveFunc           func(ctx context.Context, id string) error
+	UnarchiveFunc         func(ctx context.Context, id string) error
+	CreatePaymentFunc     func(ctx context.Context, invoiceID string, attributes parasut.PaymentInput) (*parasut.Payment, error)
+	AllocatePaymentFunc   func(ctx context.Context, contactID string, payment parasut.PaymentInput) (*parasut.PaymentAllocationResult, error)
+	ConvertToInvoiceFunc  func(ctx context.Context, invoiceID string) (*parasut.SalesInvoice, error)
+	CreateRefundFunc      func(ctx context.Context, originalID string, lines []parasut.RefundLine, options parasut.RefundOptions) (*parasut.Refund, error)
+	CreateRecurringFunc   func(ctx context.Context, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships, plan parasut.RecurrencePlanInput) (*parasut.SalesInvoice, *parasut.RecurrencePlan, error)
+	ListGeneratedFunc     func(ctx context.Context, planID string) ([]parasut.SalesInvoice, error)
+	GetPDFFunc            func(ctx context.Context, id string) ([]byte, error)
+}
+
+var _ parasut.SalesInvoicesAPI = (*SalesInvoicesAPI)(nil)
+
+// Aging çağrıyı kaydeder ve AgingFunc'u çağırır
+func (m *SalesInvoicesAPI) Aging(ctx context.Context, asOf time.Time) (*parasut.AgingReport, error) {
+	m.record("Aging", ctx, asOf)
+	if m.AgingFunc != nil {
+		return m.AgingFunc(ctx, asOf)
+	}
+	var r0 *parasut.AgingReport
+	return r0, notConfigured("SalesInvoicesAPI", "Aging")
+}
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *SalesInvoicesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.SalesInvoice, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.SalesInvoice
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("SalesInvoicesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *SalesInvoicesAPI) Get(ctx context.Context, id string, include ...string) (*parasut.SalesInvoice, error) {
+	m.record("Get", ctx, id, include)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id, include...)
+	}
+	var r0 *parasut.SalesInvoice
+	return r0, notConfigured("SalesInvoicesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *SalesInvoicesAPI) Create(ctx context.Context, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships) (*parasut.SalesInvoice, error) {
+	m.record("Create", ctx, attributes, relationships)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes, relationships)
+	}
+	var r0 *parasut.SalesInvoice
+	return r0, notConfigured("SalesInvoicesAPI", "Create")
+}
+
+// CreateWithDetails çağrıyı kaydeder ve CreateWithDetailsFunc'u çağırır
+func (m *SalesInvoicesAPI) CreateWithDetails(ctx context.Context, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships, details []parasut.SalesInvoiceDetailInput) (*parasut.SalesInvoice, error) {
+	m.record("CreateWithDetails", ctx, attributes, relationships, details)
+	if m.CreateWithDetailsFunc != nil {
+		return m.CreateWithDetailsFunc(ctx, attributes, relationships, details)
+	}
+	var r0 *parasut.SalesInvoice
+	return r0, notConfigured("SalesInvoicesAPI", "CreateWithDetails")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *SalesInvoicesAPI) Update(ctx context.Context, id string, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships) (*parasut.SalesInvoice, error) {
+	m.record("Update", ctx, id, attributes, relationships)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes, relationships)
+	}
+	var r0 *parasut.SalesInvoice
+	return r0, notConfigured("SalesInvoicesAPI", "Update")
+}
+
+// Cancel çağrıyı kaydeder ve CancelFunc'u çağırır
+func (m *SalesInvoicesAPI) Cancel(ctx context.Context, id string) error {
+	m.record("Cancel", ctx, id)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, id)
+	}
+	return notConfigured("SalesInvoicesAPI", "Cancel")
+}
+
+// Recover çağrıyı kaydeder ve RecoverFunc'u çağırır
+func (m *SalesInvoicesAPI) Recover(ctx context.Context, id string) error {
+	m.record("Recover", ctx, id)
+	if m.RecoverFunc != nil {
+		return m.RecoverFunc(ctx, id)
+	}
+	return notConfigured("SalesInvoicesAPI", "Recover")
+}
+
+// Archive çağrıyı kaydeder ve ArchiveFunc'u çağırır
+func (m *SalesInvoicesAPI) Archive(ctx context.Context, id string) error {
+	m.record("Archive", ctx, id)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id)
+	}
+	return notConfigured("SalesInvoicesAPI", "Archive")
+}
+
+// Unarchive çağrıyı kaydeder ve UnarchiveFunc'u çağırır
+func (m *SalesInvoicesAPI) Unarchive(ctx context.Context, id string) error {
+	m.record("Unarchive", ctx, id)
+	if m.UnarchiveFunc != nil {
+		return m.UnarchiveFunc(ctx, id)
+	}
+	return notConfigured("SalesInvoicesAPI", "Unarchive")
+}
+
+// CreatePayment çağrıyı kaydeder ve CreatePaymentFunc'u çağırır
+func (m *SalesInvoicesAPI) CreatePayment(ctx context.Context, invoiceID string, attributes parasut.PaymentInput) (*parasut.Payment, error) {
+	m.record("CreatePayment", ctx, invoiceID, attributes)
+	if m.CreatePaymentFunc != nil {
+		return m.CreatePaymentFunc(ctx, invoiceID, attributes)
+	}
+	var r0 *parasut.Payment
+	return r0, notConfigured("SalesInvoicesAPI", "CreatePayment")
+}
+
+// AllocatePayment çağrıyı kaydeder ve AllocatePaymentFunc'u çağırır
+func (m *SalesInvoicesAPI) AllocatePayment(ctx context.Context, contactID string, payment parasut.PaymentInput) (*parasut.PaymentAllocationResult, error) {
+	m.record("AllocatePayment", ctx, contactID, payment)
+	if m.AllocatePaymentFunc != nil {
+		return m.AllocatePaymentFunc(ctx, contactID, payment)
+	}
+	var r0 *parasut.PaymentAllocationResult
+	return r0, notConfigured("SalesInvoicesAPI", "AllocatePayment")
+}
+
+// ConvertToInvoice çağrıyı kaydeder ve ConvertToInvoiceFunc'u çağırır
+func (m *SalesInvoicesAPI) ConvertToInvoice(ctx context.Context, invoiceID string) (*parasut.SalesInvoice, error) {
+	m.record("ConvertToInvoice", ctx, invoiceID)
+	if m.ConvertToInvoiceFunc != nil {
+		return m.ConvertToInvoiceFunc(ctx, invoiceID)
+	}
+	var r0 *parasut.SalesInvoice
+	return r0, notConfigured("SalesInvoicesAPI", "ConvertToInvoice")
+}
+
+// CreateRefund çağrıyı kaydeder ve CreateRefundFunc'u çağırır
+func (m *SalesInvoicesAPI) CreateRefund(ctx context.Context, originalID string, lines []parasut.RefundLine, options parasut.RefundOptions) (*parasut.Refund, error) {
+	m.record("CreateRefund", ctx, originalID, lines, options)
+	if m.CreateRefundFunc != nil {
+		return m.CreateRefundFunc(ctx, originalID, lines, options)
+	}
+	var r0 *parasut.Refund
+	return r0, notConfigured("SalesInvoicesAPI", "CreateRefund")
+}
+
+// CreateRecurring çağrıyı kaydeder ve CreateRecurringFunc'u çağırır
+func (m *SalesInvoicesAPI) CreateRecurring(ctx context.Context, attributes parasut.SalesInvoiceInput, relationships *parasut.SalesInvoiceRelationships, plan parasut.RecurrencePlanInput) (*parasut.SalesInvoice, *parasut.RecurrencePlan, error) {
+	m.record("CreateRecurring", ctx, attributes, relationships, plan)
+	if m.CreateRecurringFunc != nil {
+		return m.CreateRecurringFunc(ctx, attributes, relationships, plan)
+	}
+	var r0 *parasut.SalesInvoice
+	var r1 *parasut.RecurrencePlan
+	return r0, r1, notConfigured("SalesInvoicesAPI", "CreateRecurring")
+}
+
+// ListGenerated çağrıyı kaydeder ve ListGeneratedFunc'u çağırır
+func (m *SalesInvoicesAPI) ListGenerated(ctx context.Context, planID string) ([]parasut.SalesInvoice, error) {
+	m.record("ListGenerated", ctx, planID)
+	if m.ListGeneratedFunc != nil {
+		return m.ListGeneratedFunc(ctx, planID)
+	}
+	var r0 []parasut.SalesInvoice
+	return r0, notConfigured("SalesInvoicesAPI", "ListGenerated")
+}
+
+// GetPDF çağrıyı kaydeder ve GetPDFFunc'u çağırır
+func (m *SalesInvoicesAPI) GetPDF(ctx context.Context, id string) ([]byte, error) {
+	m.record("GetPDF", ctx, id)
+	if m.GetPDFFunc != nil {
+		return m.GetPDFFunc(ctx, id)
+	}
+	var r0 []byte
+	return r0, notConfigured("SalesInvoicesAPI", "GetPDF")
+}
+
+// SalesOffersAPI parasut.SalesOffersAPI için sahte uygulama
+type SalesOffersAPI struct {
+	Recorder
+
+	ListFunc             func(ctx context.Context, params *parasut.ListParams) ([]parasut.SalesOffer, *parasut.Meta, error)
+	GetFunc              func(ctx context.Context, id string, include ...string) (*parasut.SalesOffer, error)
+	CreateFunc           func(ctx context.Context, attributes parasut.SalesOfferInput, relationships *parasut.SalesOfferRelationships) (*parasut.SalesOffer, error)
+	UpdateFunc           func(ctx context.Context, id string, attributes parasut.SalesOfferInput, relationships *parasut.SalesOfferRelationships) (*parasut.SalesOffer, error)
+	DeleteFunc           func(ctx context.Context, id string) error
+	ArchiveFunc          func(ctx context.Context, id string) error
+	UnarchiveFunc        func(ctx context.Context, id string) error
+	GetPDFFunc           func(ctx context.Context, id string) ([]byte, error)
+	GetDetailsFunc       func(ctx context.Context, id string) (*parasut.SalesOffer, error)
+	UpdateStatusFunc     func(ctx context.Context, id string, status parasut.SalesOfferStatus) (*parasut.SalesOffer, error)
+	ConvertToInvoiceFunc func(ctx context.Context, offerID string) (*parasut.SalesInvoice, error)
+	PipelineFunc         func(ctx context.Context, from time.Time, to time.Time) (*parasut.OfferPipeline, error)
+}
+
+var _ parasut.SalesOffersAPI = (*SalesOffersAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *SalesOffersAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.SalesOffer, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.SalesOffer
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("SalesOffersAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *SalesOffersAPI) Get(ctx context.Context, id string, include ...string) (*parasut.SalesOffer, error) {
+	m.record("Get", ctx, id, include)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id, include...)
+	}
+	var r0 *parasut.SalesOffer
+	return r0, notConfigured("SalesOffersAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *SalesOffersAPI) Create(ctx context.Context, attributes parasut.SalesOfferInput, relationships *parasut.SalesOfferRelationships) (*parasut.SalesOffer, error) {
+	m.record("Create", ctx, attributes, relationships)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes, relationships)
+	}
+	var r0 *parasut.SalesOffer
+	return r0, notConfigured("SalesOffersAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *SalesOffersAPI) Update(ctx context.Context, id string, attributes parasut.SalesOfferInput, relationships *parasut.SalesOfferRelationships) (*parasut.SalesOffer, error) {
+	m.record("Update", ctx, id, attributes, relationships)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes, relationships)
+	}
+	var r0 *parasut.SalesOffer
+	return r0, notConfigured("SalesOffersAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *SalesOffersAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("SalesOffersAPI", "Delete")
+}
+
+// Archive çağrıyı kaydeder ve ArchiveFunc'u çağırır
+func (m *SalesOffersAPI) Archive(ctx context.Context, id string) error {
+	m.record("Archive", ctx, id)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id)
+	}
+	return notConfigured("SalesOffersAPI", "Archive")
+}
+
+// Unarchive çağrıyı kaydeder ve UnarchiveFunc'u çağırır
+func (m *SalesOffersAPI) Unarchive(ctx context.Context, id string) error {
+	m.record("Unarchive", ctx, id)
+	if m.UnarchiveFunc != nil {
+		return m.UnarchiveFunc(ctx, id)
+	}
+	return notConfigured("SalesOffersAPI", "Unarchive")
+}
+
+// GetPDF çağrıyı kaydeder ve GetPDFFunc'u çağırır
+func (m *SalesOffersAPI) GetPDF(ctx context.Context, id string) ([]byte, error) {
+	m.record("GetPDF", ctx, id)
+	if m.GetPDFFunc != nil {
+		return m.GetPDFFunc(ctx, id)
+	}
+	var r0 []byte
+	return r0, notConfigured("SalesOffersAPI", "GetPDF")
+}
+
+// GetDetails çağrıyı kaydeder ve GetDetailsFunc'u çağırır
+func (m *SalesOffersAPI) GetDetails(ctx context.Context, id string) (*parasut.SalesOffer, error) {
+	m.record("GetDetails", ctx, id)
+	if m.GetDetailsFunc != nil {
+		return m.GetDetailsFunc(ctx, id)
+	}
+	var r0 *parasut.SalesOffer
+	return r0, notConfigured("SalesOffersAPI", "GetDetails")
+}
+
+// UpdateStatus çağrıyı kaydeder ve UpdateStatusFunc'u çağırır
+func (m *SalesOffersAPI) UpdateStatus(ctx context.Context, id string, status parasut.SalesOfferStatus) (*parasut.SalesOffer, error) {
+	m.record("UpdateStatus", ctx, id, status)
+	if m.UpdateStatusFunc != nil {
+		return m.UpdateStatusFunc(ctx, id, status)
+	}
+	var r0 *parasut.SalesOffer
+	return r0, notConfigured("SalesOffersAPI", "UpdateStatus")
+}
+
+// ConvertToInvoice çağrıyı kaydeder ve ConvertToInvoiceFunc'u çağırır
+func (m *SalesOffersAPI) ConvertToInvoice(ctx context.Context, offerID string) (*parasut.SalesInvoice, error) {
+	m.record("ConvertToInvoice", ctx, offerID)
+	if m.ConvertToInvoiceFunc != nil {
+		return m.ConvertToInvoiceFunc(ctx, offerID)
+	}
+	var r0 *parasut.SalesInvoice
+	return r0, notConfigured("SalesOffersAPI", "ConvertToInvoice")
+}
+
+// Pipeline çağrıyı kaydeder ve PipelineFunc'u çağırır
+func (m *SalesOffersAPI) Pipeline(ctx context.Context, from time.Time, to time.Time) (*parasut.OfferPipeline, error) {
+	m.record("Pipeline", ctx, from, to)
+	if m.PipelineFunc != nil {
+		return m.PipelineFunc(ctx, from, to)
+	}
+	var r0 *parasut.OfferPipeline
+	return r0, notConfigured("SalesOffersAPI", "Pipeline")
+}
+
+// SharingsAPI parasut.SharingsAPI için sahte uygulama
+type SharingsAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.Sharing, *parasut.Meta, error)
+	CreateFunc func(ctx context.Context, attributes parasut.SharingInput) (*parasut.Sharing, error)
+}
+
+var _ parasut.SharingsAPI = (*SharingsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *SharingsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Sharing, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Sharing
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("SharingsAPI", "List")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *SharingsAPI) Create(ctx context.Context, attributes parasut.SharingInput) (*parasut.Sharing, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.Sharing
+	return r0, notConfigured("SharingsAPI", "Create")
+}
+
+// ShipmentDocumentsAPI parasut.ShipmentDocumentsAPI için sahte uygulama
+type ShipmentDocumentsAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.ShipmentDocument, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.ShipmentDocument, error)
+	CreateFunc func(ctx context.Context, attributes parasut.ShipmentDocumentInput) (*parasut.ShipmentDocument, error)
+	UpdateFunc func(ctx context.Context, id string, attributes parasut.ShipmentDocumentInput) (*parasut.ShipmentDocument, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.ShipmentDocumentsAPI = (*ShipmentDocumentsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *ShipmentDocumentsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.ShipmentDocument, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.ShipmentDocument
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("ShipmentDocumentsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *ShipmentDocumentsAPI) Get(ctx context.Context, id string) (*parasut.ShipmentDocument, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.ShipmentDocument
+	return r0, notConfigured("ShipmentDocumentsAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *ShipmentDocumentsAPI) Create(ctx context.Context, attributes parasut.ShipmentDocumentInput) (*parasut.ShipmentDocument, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.ShipmentDocument
+	return r0, notConfigured("ShipmentDocumentsAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *ShipmentDocumentsAPI) Update(ctx context.Context, id string, attributes parasut.ShipmentDocumentInput) (*parasut.ShipmentDocument, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.ShipmentDocument
+	return r0, notConfigured("ShipmentDocumentsAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *ShipmentDocumentsAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("ShipmentDocumentsAPI", "Delete")
+}
+
+// StockMovementsAPI parasut.StockMovementsAPI için sahte uygulama
+type StockMovementsAPI struct {
+	Recorder
+
+	ListFunc func(ctx context.Context, params *parasut.ListParams) ([]parasut.StockMovement, *parasut.Meta, error)
+}
+
+var _ parasut.StockMovementsAPI = (*StockMovementsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *StockMovementsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.StockMovement, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.StockMovement
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("StockMovementsAPI", "List")
+}
+
+// StockUpdatesAPI parasut.StockUpdatesAPI için sahte uygulama
+type StockUpdatesAPI struct {
+	Recorder
+
+	CreateFunc func(ctx context.Context, attributes parasut.StockUpdateInput) (*parasut.StockUpdate, error)
+}
+
+var _ parasut.StockUpdatesAPI = (*StockUpdatesAPI)(nil)
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *StockUpdatesAPI) Create(ctx context.Context, attributes parasut.StockUpdateInput) (*parasut.StockUpdate, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.StockUpdate
+	return r0, notConfigured("StockUpdatesAPI", "Create")
+}
+
+// TagsAPI parasut.TagsAPI için sahte uygulama
+type TagsAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.Tag, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.Tag, error)
+	CreateFunc func(ctx context.Context, attributes parasut.TagInput) (*parasut.Tag, error)
+	UpdateFunc func(ctx context.Context, id string, attributes parasut.TagInput) (*parasut.Tag, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.TagsAPI = (*TagsAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *TagsAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Tag, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Tag
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("TagsAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *TagsAPI) Get(ctx context.Context, id string) (*parasut.Tag, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Tag
+	return r0, notConfigured("TagsAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *TagsAPI) Create(ctx context.Context, attributes parasut.TagInput) (*parasut.Tag, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.Tag
+	return r0, notConfigured("TagsAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *TagsAPI) Update(ctx context.Context, id string, attributes parasut.TagInput) (*parasut.Tag, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.Tag
+	return r0, notConfigured("TagsAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *TagsAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("TagsAPI", "Delete")
+}
+
+// TaxesAPI parasut.TaxesAPI için sahte uygulama
+type TaxesAPI struct {
+	Recorder
+
+	ListFunc          func(ctx context.Context, params *parasut.ListParams) ([]parasut.Tax, *parasut.Meta, error)
+	GetFunc           func(ctx context.Context, id string) (*parasut.Tax, error)
+	CreateFunc        func(ctx context.Context, attributes parasut.TaxInput, relationships *parasut.TaxRelationships) (*parasut.Tax, error)
+	UpdateFunc        func(ctx context.Context, id string, attributes parasut.TaxInput, relationships *parasut.TaxRelationships) (*parasut.Tax, error)
+	ArchiveFunc       func(ctx context.Context, id string) error
+	UnarchiveFunc     func(ctx context.Context, id string) error
+	CreatePaymentFunc func(ctx context.Context, taxID string, attributes parasut.PaymentInput) (*parasut.Payment, error)
+}
+
+var _ parasut.TaxesAPI = (*TaxesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *TaxesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Tax, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Tax
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("TaxesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *TaxesAPI) Get(ctx context.Context, id string) (*parasut.Tax, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Tax
+	return r0, notConfigured("TaxesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *TaxesAPI) Create(ctx context.Context, attributes parasut.TaxInput, relationships *parasut.TaxRelationships) (*parasut.Tax, error) {
+	m.record("Create", ctx, attributes, relationships)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes, relationships)
+	}
+	var r0 *parasut.Tax
+	return r0, notConfigured("TaxesAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *TaxesAPI) Update(ctx context.Context, id string, attributes parasut.TaxInput, relationships *parasut.TaxRelationships) (*parasut.Tax, error) {
+	m.record("Update", ctx, id, attributes, relationships)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes, relationships)
+	}
+	var r0 *parasut.Tax
+	return r0, notConfigured("TaxesAPI", "Update")
+}
+
+// Archive çağrıyı kaydeder ve ArchiveFunc'u çağırır
+func (m *TaxesAPI) Archive(ctx context.Context, id string) error {
+	m.record("Archive", ctx, id)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id)
+	}
+	return notConfigured("TaxesAPI", "Archive")
+}
+
+// Unarchive çağrıyı kaydeder ve UnarchiveFunc'u çağırır
+func (m *TaxesAPI) Unarchive(ctx context.Context, id string) error {
+	m.record("Unarchive", ctx, id)
+	if m.UnarchiveFunc != nil {
+		return m.UnarchiveFunc(ctx, id)
+	}
+	return notConfigured("TaxesAPI", "Unarchive")
+}
+
+// CreatePayment çağrıyı kaydeder ve CreatePaymentFunc'u çağırır
+func (m *TaxesAPI) CreatePayment(ctx context.Context, taxID string, attributes parasut.PaymentInput) (*parasut.Payment, error) {
+	m.record("CreatePayment", ctx, taxID, attributes)
+	if m.CreatePaymentFunc != nil {
+		return m.CreatePaymentFunc(ctx, taxID, attributes)
+	}
+	var r0 *parasut.Payment
+	return r0, notConfigured("TaxesAPI", "CreatePayment")
+}
+
+// TrackableJobsAPI parasut.TrackableJobsAPI için sahte uygulama
+type TrackableJobsAPI struct {
+	Recorder
+
+	GetFunc func(ctx context.Context, id string) (*parasut.TrackableJob, error)
+}
+
+var _ parasut.TrackableJobsAPI = (*TrackableJobsAPI)(nil)
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *TrackableJobsAPI) Get(ctx context.Context, id string) (*parasut.TrackableJob, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.TrackableJob
+	return r0, notConfigured("TrackableJobsAPI", "Get")
+}
+
+// TransactionsAPI parasut.TransactionsAPI için sahte uygulama
+type TransactionsAPI struct {
+	Recorder
+
+	GetFunc    func(ctx context.Context, id string) (*parasut.Transaction, error)
+	UpdateFunc func(ctx context.Context, id string, attributes parasut.TransactionInput) (*parasut.Transaction, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.TransactionsAPI = (*TransactionsAPI)(nil)
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *TransactionsAPI) Get(ctx context.Context, id string) (*parasut.Transaction, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Transaction
+	return r0, notConfigured("TransactionsAPI", "Get")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *TransactionsAPI) Update(ctx context.Context, id string, attributes parasut.TransactionInput) (*parasut.Transaction, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.Transaction
+	return r0, notConfigured("TransactionsAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *TransactionsAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("TransactionsAPI", "Delete")
+}
+
+// WarehousesAPI parasut.WarehousesAPI için sahte uygulama
+type WarehousesAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.Warehouse, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.Warehouse, error)
+	CreateFunc func(ctx context.Context, attributes parasut.WarehouseInput) (*parasut.Warehouse, error)
+	UpdateFunc func(ctx context.Context, id string, attributes parasut.WarehouseInput) (*parasut.Warehouse, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.WarehousesAPI = (*WarehousesAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *WarehousesAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Warehouse, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Warehouse
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("WarehousesAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *WarehousesAPI) Get(ctx context.Context, id string) (*parasut.Warehouse, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Warehouse
+	return r0, notConfigured("WarehousesAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *WarehousesAPI) Create(ctx context.Context, attributes parasut.WarehouseInput) (*parasut.Warehouse, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.Warehouse
+	return r0, notConfigured("WarehousesAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *WarehousesAPI) Update(ctx context.Context, id string, attributes parasut.WarehouseInput) (*parasut.Warehouse, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.Warehouse
+	return r0, notConfigured("WarehousesAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *WarehousesAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("WarehousesAPI", "Delete")
+}
+
+// WebhooksAPI parasut.WebhooksAPI için sahte uygulama
+type WebhooksAPI struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, params *parasut.ListParams) ([]parasut.Webhook, *parasut.Meta, error)
+	GetFunc    func(ctx context.Context, id string) (*parasut.Webhook, error)
+	CreateFunc func(ctx context.Context, attributes parasut.WebhookInput) (*parasut.Webhook, error)
+	UpdateFunc func(ctx context.Context, id string, attributes parasut.WebhookInput) (*parasut.Webhook, error)
+	DeleteFunc func(ctx context.Context, id string) error
+}
+
+var _ parasut.WebhooksAPI = (*WebhooksAPI)(nil)
+
+// List çağrıyı kaydeder ve ListFunc'u çağırır
+func (m *WebhooksAPI) List(ctx context.Context, params *parasut.ListParams) ([]parasut.Webhook, *parasut.Meta, error) {
+	m.record("List", ctx, params)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, params)
+	}
+	var r0 []parasut.Webhook
+	var r1 *parasut.Meta
+	return r0, r1, notConfigured("WebhooksAPI", "List")
+}
+
+// Get çağrıyı kaydeder ve GetFunc'u çağırır
+func (m *WebhooksAPI) Get(ctx context.Context, id string) (*parasut.Webhook, error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	var r0 *parasut.Webhook
+	return r0, notConfigured("WebhooksAPI", "Get")
+}
+
+// Create çağrıyı kaydeder ve CreateFunc'u çağırır
+func (m *WebhooksAPI) Create(ctx context.Context, attributes parasut.WebhookInput) (*parasut.Webhook, error) {
+	m.record("Create", ctx, attributes)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, attributes)
+	}
+	var r0 *parasut.Webhook
+	return r0, notConfigured("WebhooksAPI", "Create")
+}
+
+// Update çağrıyı kaydeder ve UpdateFunc'u çağırır
+func (m *WebhooksAPI) Update(ctx context.Context, id string, attributes parasut.WebhookInput) (*parasut.Webhook, error) {
+	m.record("Update", ctx, id, attributes)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, attributes)
+	}
+	var r0 *parasut.Webhook
+	return r0, notConfigured("WebhooksAPI", "Update")
+}
+
+// Delete çağrıyı kaydeder ve DeleteFunc'u çağırır
+func (m *WebhooksAPI) Delete(ctx context.Context, id string) error {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return notConfigured("WebhooksAPI", "Delete")
+}
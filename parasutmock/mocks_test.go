@@ -0,0 +1,62 @@
+package parasutmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// contactName arayüze bağımlı örnek iş kodu
+func contactName(ctx context.Context, contacts parasut.ContactsAPI, id string) (string, error) {
+	contact, err := contacts.Get(ctx, id, "contact_people")
+	if err != nil {
+		return "", err
+	}
+	return contact.Attributes.Name, nil
+}
+
+func TestContactsAPI_RecordsCalls(t *testing.T) {
+	contacts := &ContactsAPI{
+		GetFunc: func(ctx context.Context, id string, include ...string) (*parasut.Contact, error) {
+			return &parasut.Contact{ID: id, Attributes: parasut.ContactAttributes{Name: "Alfa Ltd."}}, nil
+		},
+	}
+
+	name, err := contactName(context.Background(), contacts, "7")
+	if err != nil {
+		t.Fatalf("contactName hata döndü: %v", err)
+	}
+
+	if name != "Alfa Ltd." {
+		t.Errorf("name = %s, beklenen Alfa Ltd.", name)
+	}
+
+	calls := contacts.CallsTo("Get")
+	if len(calls) != 1 || calls[0].Args[1] != "7" {
+		t.Fatalf("Get çağrıları = %+v", calls)
+	}
+
+	if include := calls[0].Args[2].([]string); len(include) != 1 || include[0] != "contact_people" {
+		t.Errorf("include = %v", include)
+	}
+
+	contacts.Reset()
+	if contacts.CallCount("Get") != 0 {
+		t.Error("Reset sonrası çağrı kaydı boş olmalı")
+	}
+}
+
+func TestSalesInvoicesAPI_NotConfigured(t *testing.T) {
+	invoices := &SalesInvoicesAPI{}
+
+	_, _, err := invoices.List(context.Background(), nil)
+	if !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("List hatası = %v, beklenen ErrNotConfigured", err)
+	}
+
+	if invoices.CallCount("List") != 1 {
+		t.Errorf("List çağrı sayısı = %d, beklenen 1", invoices.CallCount("List"))
+	}
+}
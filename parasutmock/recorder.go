@@ -0,0 +1,68 @@
+package parasutmock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotConfigured XxxFunc alanı tanımlanmamış metod çağrıldığında döner
+var ErrNotConfigured = errors.New("parasutmock: metod tanımlı değil")
+
+// Call kaydedilmiş metod çağrısı. Args context dahil tüm argümanları içerir.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder sahte servislere gömülen, eşzamanlı kullanıma uygun çağrı kaydı
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls tüm çağrıları sırasıyla döndürür
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo verilen metoda yapılan çağrıları döndürür
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// CallCount verilen metodun kaç kez çağrıldığını döndürür
+func (r *Recorder) CallCount(method string) int {
+	return len(r.CallsTo(method))
+}
+
+// Reset çağrı kaydını temizler
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func notConfigured(service, method string) error {
+	return fmt.Errorf("%w: %s.%s", ErrNotConfigured, service, method)
+}
@@ -0,0 +1,409 @@
+package parasuttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// RecordEnv bu ortam değişkeni "1" ise Cassette gerçek API'ye giden
+// istekleri kaydeder, aksi halde kayıttan oynatır.
+const RecordEnv = "PARASUT_RECORD"
+
+// redacted gizlenen değerlerin yerine yazılan metin
+const redacted = "REDACTED"
+
+// sensitiveKeys JSON gövdesinde ve form/sorgu parametrelerinde değeri gizlenen alanlar
+var sensitiveKeys = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"password":      true,
+	"client_secret": true,
+	"code":          true,
+	"username":      true,
+}
+
+// sensitiveSuffixes adı bu eklerle biten alanlar da gizlenir
+// (supplier_tax_number, contact_phone, receiver_vkn gibi)
+var sensitiveSuffixes = []string{"tax_number", "vkn", "tckn", "phone", "fax", "iban", "ibans"}
+
+// isSensitiveKey alan adını küçük harfe çevirip filter[...] sarmalayıcısını
+// atarak hassas olup olmadığına bakar
+func isSensitiveKey(key string) bool {
+	name := strings.ToLower(strings.ReplaceAll(key, "-", "_"))
+	if strings.HasPrefix(name, "filter[") && strings.HasSuffix(name, "]") {
+		name = name[len("filter[") : len(name)-1]
+	}
+	if sensitiveKeys[name] {
+		return true
+	}
+	for _, suffix := range sensitiveSuffixes {
+		if name == suffix || strings.HasSuffix(name, "_"+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// CassetteRequest kaydedilmiş isteğin eşleştirmede kullanılan kısmı
+type CassetteRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// CassetteResponse kaydedilmiş yanıt
+type CassetteResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// Interaction tek bir istek/yanıt çifti
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteFile kaset dosyasının içeriği
+type CassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// LoadCassette kaset dosyasını okur
+func LoadCassette(path string) (*CassetteFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette CassetteFile
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("kaset okunamadı (%s): %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save kaseti girintili JSON olarak yazar, gerekirse klasörü oluşturur
+func (c *CassetteFile) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Recorder istekleri asıl transport'a ileten ve temizlenmiş istek/yanıt
+// çiftlerini kaydeden RoundTripper. Config.Transport olarak verilir.
+type Recorder struct {
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette CassetteFile
+}
+
+// NewRecorder path'e yazacak bir kaydedici oluşturur. transport boşsa
+// http.DefaultTransport kullanılır.
+func NewRecorder(path string, transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{path: path, transport: transport}
+}
+
+// RoundTrip isteği iletir ve etkileşimi kaydeder
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	contentType := resp.Header.Get("Content-Type")
+	interaction := Interaction{
+		Request: newCassetteRequest(req, body),
+		Response: CassetteResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: contentType,
+			Body:        sanitizeBody(respBody, contentType),
+		},
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Interactions şimdiye kadar kaydedilen etkileşimleri döndürür
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Interaction(nil), r.cassette.Interactions...)
+}
+
+// Save kaydedilen etkileşimleri kaset dosyasına yazar
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// UnmatchedRequestError kasette karşılığı olmayan istek hatası
+type UnmatchedRequestError struct {
+	Request CassetteRequest
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	target := e.Request.Path
+	if e.Request.Query != "" {
+		target += "?" + e.Request.Query
+	}
+	if e.Request.Body != "" {
+		return fmt.Sprintf("parasuttest: kasette eşleşen kayıt yok: %s %s gövde=%s", e.Request.Method, target, e.Request.Body)
+	}
+	return fmt.Sprintf("parasuttest: kasette eşleşen kayıt yok: %s %s", e.Request.Method, target)
+}
+
+// Replayer kaset dosyasındaki yanıtları döndüren RoundTripper. İstekler
+// method, path, sorgu ve gövdeye göre eşleştirilir; her kayıt bir kez
+// kullanılır. Eşleşmeyen istekler *UnmatchedRequestError ile başarısız olur.
+type Replayer struct {
+	// OnUnmatched eşleşmeyen istekte çağrılır (ör. t.Errorf)
+	OnUnmatched func(err error)
+
+	mu       sync.Mutex
+	cassette *CassetteFile
+	used     []bool
+}
+
+// NewReplayer kaset dosyasını okuyarak oynatıcı oluşturur
+func NewReplayer(path string) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Replayer{cassette: cassette, used: make([]bool, len(cassette.Interactions))}, nil
+}
+
+// RoundTrip kayıtlı yanıtı döndürür
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	want := newCassetteRequest(req, body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Request != want {
+			continue
+		}
+		r.used[i] = true
+
+		header := http.Header{}
+		if interaction.Response.ContentType != "" {
+			header.Set("Content-Type", interaction.Response.ContentType)
+		}
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode: interaction.Response.StatusCode,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			Request:    req,
+		}, nil
+	}
+
+	unmatched := &UnmatchedRequestError{Request: want}
+	if r.OnUnmatched != nil {
+		r.OnUnmatched(unmatched)
+	}
+	return nil, unmatched
+}
+
+// Unused oynatılmamış etkileşimleri döndürür
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+// Cassette testte kullanılacak transport'u döndürür. PARASUT_RECORD=1 ise
+// istekler gerçek API'ye gider ve test sonunda path'e kaydedilir; aksi halde
+// path'teki kaset oynatılır, eşleşmeyen veya kullanılmayan kayıtlar testi
+// başarısız yapar.
+func Cassette(t testing.TB, path string) http.RoundTripper {
+	t.Helper()
+
+	if os.Getenv(RecordEnv) == "1" {
+		recorder := NewRecorder(path, nil)
+		t.Cleanup(func() {
+			if err := recorder.Save(); err != nil {
+				t.Errorf("kaset kaydedilemedi: %v", err)
+			}
+		})
+		return recorder
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("kaset açılamadı (kaydetmek için %s=1): %v", RecordEnv, err)
+	}
+	replayer.OnUnmatched = func(err error) {
+		t.Errorf("%v", err)
+	}
+	t.Cleanup(func() {
+		for _, interaction := range replayer.Unused() {
+			t.Errorf("kasetteki kayıt kullanılmadı: %s %s", interaction.Request.Method, interaction.Request.Path)
+		}
+	})
+	return replayer
+}
+
+// readRequestBody gövdeyi okur ve isteğe geri koyar
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// newCassetteRequest isteğin temizlenmiş, karşılaştırılabilir biçimini oluşturur
+func newCassetteRequest(req *http.Request, body []byte) CassetteRequest {
+	return CassetteRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  sanitizeValues(req.URL.Query()),
+		Body:   sanitizeBody(body, req.Header.Get("Content-Type")),
+	}
+}
+
+// sanitizeBody token, e-posta, vergi numarası, telefon ve IBAN değerlerini gizler. JSON ve form
+// gövdeleri anahtar sırası sabit olacak şekilde yeniden yazılır.
+func sanitizeBody(body []byte, contentType string) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return sanitizeValues(values)
+		}
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		encoded, err := json.Marshal(sanitizeJSON(decoded))
+		if err == nil {
+			return string(encoded)
+		}
+	}
+
+	return emailPattern.ReplaceAllString(string(body), "redacted@example.com")
+}
+
+// sanitizeJSON JSON ağacında hassas alanları ve e-postaları gizler
+func sanitizeJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				v[key] = redactValue(item)
+				continue
+			}
+			v[key] = sanitizeJSON(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = sanitizeJSON(item)
+		}
+		return v
+	case string:
+		return emailPattern.ReplaceAllString(v, "redacted@example.com")
+	}
+	return value
+}
+
+// redactValue hassas alanın değerini gizler; boş değerler ve null korunur,
+// listelerde her eleman ayrı gizlenir
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return v
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	}
+	return redacted
+}
+
+// sanitizeValues sorgu/form parametrelerini gizleyerek sıralı biçimde kodlar
+func sanitizeValues(values url.Values) string {
+	for key, items := range values {
+		sensitive := isSensitiveKey(key)
+		for i, item := range items {
+			if sensitive && item != "" {
+				items[i] = redacted
+				continue
+			}
+			items[i] = emailPattern.ReplaceAllString(item, "redacted@example.com")
+		}
+	}
+	return values.Encode()
+}
@@ -0,0 +1,140 @@
+package parasuttest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/parevo-lab/parasut"
+)
+
+func TestRecorderAndReplayer(t *testing.T) {
+	server := NewServer()
+	server.Password = "gizli-sifre"
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "contacts.json")
+	recorder := NewRecorder(path, nil)
+
+	config := server.Config()
+	config.Transport = recorder
+	client := parasut.NewClient(config)
+	ctx := context.Background()
+
+	if err := client.SetTokenFromPassword(ctx, DefaultEmail, "gizli-sifre"); err != nil {
+		t.Fatalf("SetTokenFromPassword hata döndü: %v", err)
+	}
+
+	contact, err := client.Contacts.Create(ctx, parasut.ContactInput{Name: "Alfa Ltd.", Email: "muhasebe@alfa.com", TaxNumber: "1234567890"}, nil)
+	if err != nil {
+		t.Fatalf("Contacts.Create hata döndü: %v", err)
+	}
+
+	if _, _, err := client.Contacts.List(ctx, &parasut.ListParams{Filter: map[string]string{"email": "muhasebe@alfa.com"}}); err != nil {
+		t.Fatalf("Contacts.List hata döndü: %v", err)
+	}
+
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save hata döndü: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Kaset okunamadı: %v", err)
+	}
+
+	for _, secret := range []string{DefaultToken, DefaultRefreshToken, "gizli-sifre", "muhasebe@alfa.com", "1234567890", DefaultEmail} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Kaset gizlenmemiş değer içeriyor: %s", secret)
+		}
+	}
+
+	// Sunucu kapatıldıktan sonra aynı akış kasetten oynatılır
+	server.Close()
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("NewReplayer hata döndü: %v", err)
+	}
+
+	config.Transport = replayer
+	replayed := parasut.NewClient(config)
+
+	if err := replayed.SetTokenFromPassword(ctx, DefaultEmail, "gizli-sifre"); err != nil {
+		t.Fatalf("Oynatmada SetTokenFromPassword hata döndü: %v", err)
+	}
+
+	got, err := replayed.Contacts.Create(ctx, parasut.ContactInput{Name: "Alfa Ltd.", Email: "muhasebe@alfa.com", TaxNumber: "1234567890"}, nil)
+	if err != nil {
+		t.Fatalf("Oynatmada Contacts.Create hata döndü: %v", err)
+	}
+
+	if got.ID != contact.ID || got.Attributes.TaxNumber != "REDACTED" {
+		t.Errorf("Oynatılan müşteri = %+v", got)
+	}
+
+	_, _, err = replayed.Contacts.List(ctx, &parasut.ListParams{Filter: map[string]string{"name": "Beta"}})
+	var unmatched *UnmatchedRequestError
+	if !errors.As(err, &unmatched) {
+		t.Fatalf("Eşleşmeyen istek hatası = %v", err)
+	}
+
+	if unused := replayer.Unused(); len(unused) != 1 || unused[0].Request.Method != "GET" {
+		t.Errorf("Kullanılmayan kayıtlar = %+v", unused)
+	}
+}
+
+func TestRecorder_RedactsTaxNumbers(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Seed("e_invoice_inboxes", map[string]interface{}{"vkn": "9876543210"}, nil)
+
+	path := filepath.Join(t.TempDir(), "inboxes.json")
+	recorder := NewRecorder(path, nil)
+	config := server.Config()
+	config.Transport = recorder
+	client := parasut.NewClient(config)
+	ctx := context.Background()
+
+	if err := client.SetTokenFromPassword(ctx, DefaultEmail, DefaultPassword); err != nil {
+		t.Fatalf("SetTokenFromPassword hata döndü: %v", err)
+	}
+	if _, err := client.Contacts.Create(ctx, parasut.ContactInput{
+		Name: "Beta A.Ş.", TaxNumber: "9876543210", Phone: "+90 555 111 22 33", IBANs: []string{"TR330006100519786457841326"},
+	}, nil); err != nil {
+		t.Fatalf("Contacts.Create hata döndü: %v", err)
+	}
+	inboxes, _, err := client.EInvoiceInboxes.List(ctx, &parasut.ListParams{Filter: map[string]string{"vkn": "9876543210"}})
+	if err != nil || len(inboxes) != 1 {
+		t.Fatalf("EInvoiceInboxes.List = %v, %v", inboxes, err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save hata döndü: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Kaset okunamadı: %v", err)
+	}
+	for _, secret := range []string{"9876543210", "555 111", "TR330006100519786457841326"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Kaset gizlenmemiş değer içeriyor: %s", secret)
+		}
+	}
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	for _, key := range []string{"tax_number", "supplier_tax_number", "filter[tax_number]", "filter[vkn]", "receiver_vkn", "phone", "contact-phone", "iban", "ibans", "Access_Token"} {
+		if !isSensitiveKey(key) {
+			t.Errorf("%s gizlenmeli", key)
+		}
+	}
+	for _, key := range []string{"name", "postal_code", "filter[name]", "tax_office", "phone_number_type"} {
+		if isSensitiveKey(key) {
+			t.Errorf("%s gizlenmemeli", key)
+		}
+	}
+}
@@ -0,0 +1,1110 @@
+// Package parasuttest Paraşüt v4 API'sini bellekte taklit eden, durum tutan
+// sahte bir sunucu sunar. Aşağı akış kodunun testlerinde her senaryo için
+// ayrı httptest handler'ı yazmak yerine kullanılır:
+//
+//	client, server := parasuttest.NewClient(t)
+//	server.Seed("contacts", map[string]interface{}{"name": "Alfa Ltd."}, nil)
+//	contacts, _, err := client.Contacts.List(ctx, nil)
+package parasuttest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/parevo-lab/parasut"
+)
+
+const (
+	// DefaultCompanyID sahte sunucunun firma numarası
+	DefaultCompanyID = 1
+	// DefaultToken sahte sunucunun kabul ettiği erişim token'ı
+	DefaultToken = "parasuttest-token"
+	// DefaultRefreshToken refresh_token akışında kabul edilen token
+	DefaultRefreshToken = "parasuttest-refresh-token"
+	// DefaultAuthCode authorization_code akışında kabul edilen kod
+	DefaultAuthCode = "parasuttest-code"
+	// DefaultEmail ve DefaultPassword password akışında kabul edilen kullanıcı bilgileri
+	DefaultEmail    = "test@parasut.com"
+	DefaultPassword = "parasuttest"
+
+	defaultPageSize = 25
+)
+
+// requiredFields kaynak tipine göre boş bırakılamayan nitelikler
+var requiredFields = map[string][]string{
+	"contacts":         {"name"},
+	"products":         {"name"},
+	"sales_invoices":   {"item_type", "issue_date"},
+	"sales_offers":     {"issue_date"},
+	"purchase_bills":   {"item_type", "issue_date"},
+	"recurrence_plans": {"period", "start_date"},
+	"payments":         {"account_id", "date", "amount", "exchange_rate"},
+	"accounts":         {"name"},
+	"employees":        {"name"},
+	"item_categories":  {"name", "category_type"},
+	"tags":             {"name"},
+	"warehouses":       {"name"},
+}
+
+// payableTypes ödeme alabilen kaynak tipleri
+var payableTypes = map[string]bool{
+	"sales_invoices": true,
+	"purchase_bills": true,
+	"salaries":       true,
+	"taxes":          true,
+	"bank_fees":      true,
+}
+
+// Resource sunucuda saklanan JSON:API kaynağı. Relationships değerleri
+// RelationshipData veya []RelationshipData'dır.
+type Resource struct {
+	ID            string
+	Type          string
+	Attributes    map[string]interface{}
+	Relationships map[string]interface{}
+
+	previousItemType interface{}
+}
+
+// Request sunucuya gelen istek kaydı
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
+}
+
+// failure FailNext ile kuyruğa alınan hata
+type failure struct {
+	method string
+	path   string
+	status int
+	errors []parasut.Error
+}
+
+// Server bellekte çalışan sahte Paraşüt sunucusu
+type Server struct {
+	// URL sunucunun kök adresi (ör. http://127.0.0.1:1234)
+	URL string
+
+	CompanyID    int
+	Token        string
+	RefreshToken string
+	AuthCode     string
+	Email        string
+	Password     string
+
+	// Now oluşturma/güncelleme zamanları için kullanılır
+	Now func() time.Time
+
+	server    *httptest.Server
+	mu        sync.Mutex
+	resources map[string]map[string]*Resource
+	order     map[string][]string
+	nextID    int
+	failures  []failure
+	requests  []Request
+}
+
+// NewServer yeni bir sahte sunucu başlatır. İş bitince Close çağrılmalıdır.
+func NewServer() *Server {
+	s := &Server{
+		CompanyID:    DefaultCompanyID,
+		Token:        DefaultToken,
+		RefreshToken: DefaultRefreshToken,
+		AuthCode:     DefaultAuthCode,
+		Email:        DefaultEmail,
+		Password:     DefaultPassword,
+		Now:          time.Now,
+		resources:    map[string]map[string]*Resource{},
+		order:        map[string][]string{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = s.server.URL
+	return s
+}
+
+// NewClient sahte sunucu başlatır ve ona bağlı, token'ı ayarlanmış bir
+// istemci döndürür. Sunucu test bitiminde kapatılır.
+func NewClient(t testing.TB) (*parasut.Client, *Server) {
+	t.Helper()
+	s := NewServer()
+	t.Cleanup(s.Close)
+	return s.Client(), s
+}
+
+// Close sunucuyu kapatır
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Config sunucuya yönlendirilmiş istemci ayarlarını döndürür
+func (s *Server) Config() *parasut.Config {
+	return &parasut.Config{
+		ClientID:     "parasuttest",
+		ClientSecret: "parasuttest",
+		RedirectURL:  "urn:ietf:wg:oauth:2.0:oob",
+		CompanyID:    s.CompanyID,
+		BaseURL:      s.URL + "/v4",
+		AuthURL:      s.URL + "/oauth/authorize",
+		TokenURL:     s.URL + "/oauth/token",
+	}
+}
+
+// Client sunucuya bağlı ve token'ı ayarlanmış yeni bir istemci döndürür
+func (s *Server) Client() *parasut.Client {
+	client := parasut.NewClient(s.Config())
+	client.SetToken(&oauth2.Token{AccessToken: s.Token, TokenType: "Bearer"})
+	return client
+}
+
+// Seed verilen tipte kaynak ekler ve id'sini döndürür. Relationships değerleri
+// parasut.RelationshipData, []parasut.RelationshipData veya JSON:API biçiminde
+// map olabilir.
+func (s *Server) Seed(resourceType string, attributes map[string]interface{}, relationships map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, _ := json.Marshal(relationships)
+	var raw map[string]interface{}
+	json.Unmarshal(data, &raw)
+
+	resource := s.insert(resourceType, normalizeAttributes(attributes), nil)
+	resource.Relationships = s.normalizeRelationships(raw)
+	return resource.ID
+}
+
+// Resource kaynağın bir kopyasını döndürür
+func (s *Server) Resource(resourceType, id string) (Resource, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resource, ok := s.resources[resourceType][id]
+	if !ok {
+		return Resource{}, false
+	}
+	return resource.clone(), true
+}
+
+// Resources verilen tipteki tüm kaynakların kopyalarını ekleme sırasıyla döndürür
+func (s *Server) Resources(resourceType string) []Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []Resource
+	for _, id := range s.order[resourceType] {
+		result = append(result, s.resources[resourceType][id].clone())
+	}
+	return result
+}
+
+// FailNext method ve path ile eşleşen sonraki isteğin verilen durum koduyla
+// başarısız olmasını sağlar. Path firma numarası olmadan yazılır (ör.
+// "/contacts/1"); method boşsa tüm metodlarla eşleşir. Hata verilmezse durum
+// metni kullanılır.
+func (s *Server) FailNext(method, path string, status int, errs ...parasut.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(errs) == 0 {
+		errs = []parasut.Error{{Title: http.StatusText(status)}}
+	}
+	s.failures = append(s.failures, failure{method: method, path: path, status: status, errors: errs})
+}
+
+// Requests sunucuya gelen isteklerin kopyasını döndürür
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset tüm kaynakları, bekleyen hataları ve istek kayıtlarını siler
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resources = map[string]map[string]*Resource{}
+	s.order = map[string][]string{}
+	s.failures = nil
+	s.requests = nil
+}
+
+// handle gelen isteği yönlendirir
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Body: body})
+
+	if r.URL.Path == "/oauth/token" {
+		s.handleToken(w, r, body)
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/v4/") {
+		writeError(w, http.StatusNotFound, "Bulunamadı", r.URL.Path)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+s.Token {
+		writeError(w, http.StatusUnauthorized, "Yetkisiz", "geçersiz erişim token'ı")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v4")
+	if path == "/me" {
+		s.handleMe(w)
+		return
+	}
+
+	prefix := fmt.Sprintf("/%d", s.CompanyID)
+	if !strings.HasPrefix(path, prefix+"/") {
+		writeError(w, http.StatusNotFound, "Bulunamadı", "firma bulunamadı")
+		return
+	}
+	path = strings.TrimPrefix(path, prefix)
+
+	if s.injectFailure(w, r.Method, path) {
+		return
+	}
+
+	var payload map[string]interface{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, "Geçersiz istek", err.Error())
+			return
+		}
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	resourceType := segments[0]
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.handleList(w, r.URL.Query(), s.all(resourceType))
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.handleCreate(w, resourceType, payload, nil)
+	case len(segments) == 2:
+		s.handleResource(w, r, resourceType, segments[1], payload)
+	case len(segments) == 3:
+		s.handleNested(w, r, resourceType, segments[1], segments[2], payload)
+	default:
+		writeError(w, http.StatusNotFound, "Bulunamadı", path)
+	}
+}
+
+// injectFailure bekleyen bir hata varsa yanıtı yazar ve true döndürür
+func (s *Server) injectFailure(w http.ResponseWriter, method, path string) bool {
+	for i, f := range s.failures {
+		if (f.method == "" || f.method == method) && f.path == path {
+			s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			writeJSON(w, f.status, map[string]interface{}{"errors": f.errors})
+			return true
+		}
+	}
+	return false
+}
+
+// handleResource tek kaynak üzerindeki istekleri işler
+func (s *Server) handleResource(w http.ResponseWriter, r *http.Request, resourceType, id string, payload map[string]interface{}) {
+	resource, ok := s.resources[resourceType][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Bulunamadı", fmt.Sprintf("%s %s bulunamadı", resourceType, id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.writeDocument(w, http.StatusOK, resource, r.URL.Query().Get("include"))
+	case http.MethodPut, http.MethodPatch:
+		data, _ := payload["data"].(map[string]interface{})
+		if attributes, ok := data["attributes"].(map[string]interface{}); ok {
+			for key, value := range attributes {
+				resource.Attributes[key] = value
+			}
+		}
+		if relationships, ok := data["relationships"].(map[string]interface{}); ok {
+			for key, value := range s.normalizeRelationships(relationships) {
+				resource.Relationships[key] = value
+			}
+		}
+		if errs := validate(resource); len(errs) > 0 {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": errs})
+			return
+		}
+		resource.Attributes["updated_at"] = s.timestamp()
+		s.writeDocument(w, http.StatusOK, resource, "")
+	case http.MethodDelete:
+		if resourceType == "payments" {
+			s.reversePayment(resource)
+		}
+		if resourceType == "transactions" {
+			s.reverseAccountTransaction(resource)
+		}
+		s.remove(resourceType, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Desteklenmeyen metod", r.Method)
+	}
+}
+
+// handleNested /{type}/{id}/{alt} biçimindeki istekleri işler
+func (s *Server) handleNested(w http.ResponseWriter, r *http.Request, resourceType, id, sub string, payload map[string]interface{}) {
+	parent, ok := s.resources[resourceType][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Bulunamadı", fmt.Sprintf("%s %s bulunamadı", resourceType, id))
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPatch:
+		s.handleAction(w, parent, sub, payload)
+	case r.Method == http.MethodPost && sub == "payments":
+		s.handlePayment(w, parent, payload)
+	case r.Method == http.MethodPost && parent.Type == "accounts" && (sub == "debit_transactions" || sub == "credit_transactions"):
+		s.handleAccountTransaction(w, parent, sub, payload)
+	case r.Method == http.MethodPost:
+		ref := parasut.RelationshipData{ID: parent.ID, Type: parent.Type}
+		s.handleCreate(w, "", payload, map[string]interface{}{singular(parent.Type): ref})
+	case r.Method == http.MethodGet && sub == "pdf":
+		writeJSON(w, http.StatusOK, base64.StdEncoding.EncodeToString([]byte("%PDF-1.4 parasuttest")))
+	case r.Method == http.MethodGet:
+		var children []*Resource
+		for _, child := range s.all(sub) {
+			if child.references(parent) {
+				children = append(children, child)
+			}
+		}
+		s.handleList(w, r.URL.Query(), children)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Desteklenmeyen metod", r.Method)
+	}
+}
+
+// handleAction archive, unarchive, cancel, recover, convert_to_invoice ve
+// update_status aksiyonlarını uygular
+func (s *Server) handleAction(w http.ResponseWriter, resource *Resource, action string, payload map[string]interface{}) {
+	switch action {
+	case "archive":
+		resource.Attributes["archived"] = true
+	case "unarchive":
+		resource.Attributes["archived"] = false
+	case "cancel":
+		if resource.Attributes["item_type"] == "cancelled" {
+			writeError(w, http.StatusUnprocessableEntity, "Geçersiz işlem", "kaynak zaten iptal edilmiş")
+			return
+		}
+		resource.previousItemType = resource.Attributes["item_type"]
+		resource.Attributes["item_type"] = "cancelled"
+	case "recover":
+		if resource.Attributes["item_type"] != "cancelled" {
+			writeError(w, http.StatusUnprocessableEntity, "Geçersiz işlem", "kaynak iptal edilmemiş")
+			return
+		}
+		resource.Attributes["item_type"] = resource.previousItemType
+		resource.previousItemType = nil
+	case "convert_to_invoice":
+		resource.Attributes["item_type"] = "invoice"
+	case "update_status":
+		data, _ := payload["data"].(map[string]interface{})
+		attributes, _ := data["attributes"].(map[string]interface{})
+		status, _ := attributes["status"].(string)
+		if status == "" {
+			writeError(w, http.StatusUnprocessableEntity, "Geçersiz işlem", "status alanı eksik")
+			return
+		}
+		resource.Attributes["status"] = status
+	default:
+		writeError(w, http.StatusNotFound, "Bulunamadı", action)
+		return
+	}
+
+	resource.Attributes["updated_at"] = s.timestamp()
+	s.writeDocument(w, http.StatusOK, resource, "")
+}
+
+// handlePayment ödeme oluşturur ve belgenin kalan tutarını düşer
+func (s *Server) handlePayment(w http.ResponseWriter, parent *Resource, payload map[string]interface{}) {
+	if !payableTypes[parent.Type] {
+		writeError(w, http.StatusNotFound, "Bulunamadı", parent.Type+" ödeme almaz")
+		return
+	}
+
+	data, _ := payload["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	payment := &Resource{Type: "payments", Attributes: normalizeAttributes(attributes)}
+	if errs := validate(payment); len(errs) > 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": errs})
+		return
+	}
+
+	amount := number(payment.Attributes["amount"])
+	total := number(parent.Attributes["net_total"])
+	if total == 0 {
+		total = number(parent.Attributes["gross_total"])
+	}
+	remaining, ok := parent.Attributes["remaining"]
+	if !ok {
+		remaining = total
+	}
+	left := math.Round((number(remaining)-amount)*100) / 100
+	if left < 0 {
+		writeError(w, http.StatusUnprocessableEntity, "Geçersiz tutar", "ödeme tutarı kalan tutardan büyük")
+		return
+	}
+
+	parent.Attributes["remaining"] = left
+	parent.Attributes["total_paid"] = math.Round((number(parent.Attributes["total_paid"])+amount)*100) / 100
+	if left == 0 {
+		parent.Attributes["payment_status"] = "paid"
+	} else {
+		parent.Attributes["payment_status"] = "partially_paid"
+	}
+	parent.Attributes["updated_at"] = s.timestamp()
+
+	// Ödeme hesap hareketi olarak hesabın bakiyesine işlenir
+	account := parasut.RelationshipData{ID: fmt.Sprint(payment.Attributes["account_id"]), Type: "accounts"}
+	s.adjustBalance(account.ID, parent.Type, amount)
+	transactionType := "payment"
+	if parent.Type == "sales_invoices" {
+		transactionType = "collection"
+	}
+	transaction := s.insert("transactions", map[string]interface{}{
+		"date":             payment.Attributes["date"],
+		"amount":           amount,
+		"description":      payment.Attributes["description"],
+		"transaction_type": transactionType,
+	}, map[string]interface{}{"account": account})
+
+	created := s.insert("payments", payment.Attributes, map[string]interface{}{
+		"payable":     parasut.RelationshipData{ID: parent.ID, Type: parent.Type},
+		"account":     account,
+		"transaction": parasut.RelationshipData{ID: transaction.ID, Type: "transactions"},
+	})
+	if parent.Relationships == nil {
+		parent.Relationships = map[string]interface{}{}
+	}
+	parent.Relationships["payments"] = append(parent.refs("payments"), parasut.RelationshipData{ID: created.ID, Type: "payments"})
+	s.writeDocument(w, http.StatusCreated, created, "")
+}
+
+// adjustBalance ödemeyi hesabın bakiyesine işler: satış faturası tahsilatı
+// hesaba girer, diğer ödemeler hesaptan çıkar. amount negatifse işlem geri alınır.
+func (s *Server) adjustBalance(accountID, payableType string, amount float64) {
+	account, ok := s.resources["accounts"][accountID]
+	if !ok {
+		return
+	}
+	if payableType != "sales_invoices" {
+		amount = -amount
+	}
+	account.Attributes["balance"] = math.Round((number(account.Attributes["balance"])+amount)*100) / 100
+}
+
+// reversePayment silinen ödemeyi belgenin kalan tutarından, hesap bakiyesinden
+// ve belgenin ilişkilerinden geri alır; ödemenin hesap hareketini siler
+func (s *Server) reversePayment(payment *Resource) {
+	amount := number(payment.Attributes["amount"])
+	for _, ref := range payment.refs("payable") {
+		parent, ok := s.resources[ref.Type][ref.ID]
+		if !ok {
+			continue
+		}
+		parent.Attributes["remaining"] = math.Round((number(parent.Attributes["remaining"])+amount)*100) / 100
+		parent.Attributes["total_paid"] = math.Round((number(parent.Attributes["total_paid"])-amount)*100) / 100
+		if number(parent.Attributes["total_paid"]) <= 0 {
+			parent.Attributes["payment_status"] = "unpaid"
+		} else {
+			parent.Attributes["payment_status"] = "partially_paid"
+		}
+		parent.Attributes["updated_at"] = s.timestamp()
+
+		var remaining []parasut.RelationshipData
+		for _, existing := range parent.refs("payments") {
+			if existing.ID != payment.ID {
+				remaining = append(remaining, existing)
+			}
+		}
+		parent.Relationships["payments"] = remaining
+
+		for _, account := range payment.refs("account") {
+			s.adjustBalance(account.ID, parent.Type, -amount)
+		}
+	}
+	for _, ref := range payment.refs("transaction") {
+		s.remove(ref.Type, ref.ID)
+	}
+}
+
+// handleAccountTransaction hesaba elle girilen para çıkışını (debit) veya
+// girişini (credit) hesap hareketi olarak kaydeder ve bakiyeye işler
+func (s *Server) handleAccountTransaction(w http.ResponseWriter, account *Resource, sub string, payload map[string]interface{}) {
+	data, _ := payload["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	attributes = normalizeAttributes(attributes)
+	amount := number(attributes["amount"])
+	if attributes["date"] == nil || attributes["date"] == "" || amount <= 0 {
+		writeError(w, http.StatusUnprocessableEntity, "Geçersiz hareket", "date ve pozitif amount zorunludur")
+		return
+	}
+
+	attributes["transaction_type"] = "account_credit"
+	if sub == "debit_transactions" {
+		attributes["transaction_type"] = "account_debit"
+		amount = -amount
+	}
+	account.Attributes["balance"] = math.Round((number(account.Attributes["balance"])+amount)*100) / 100
+
+	transaction := s.insert("transactions", attributes, map[string]interface{}{
+		"account": parasut.RelationshipData{ID: account.ID, Type: "accounts"},
+	})
+	s.writeDocument(w, http.StatusCreated, transaction, "")
+}
+
+// reverseAccountTransaction silinen elle girilmiş hesap hareketini bakiyeden geri alır
+func (s *Server) reverseAccountTransaction(transaction *Resource) {
+	amount := number(transaction.Attributes["amount"])
+	switch transaction.Attributes["transaction_type"] {
+	case "account_credit":
+		amount = -amount
+	case "account_debit":
+	default:
+		return
+	}
+	for _, ref := range transaction.refs("account") {
+		if account, ok := s.resources["accounts"][ref.ID]; ok {
+			account.Attributes["balance"] = math.Round((number(account.Attributes["balance"])+amount)*100) / 100
+		}
+	}
+}
+
+// remove kaynağı siler
+func (s *Server) remove(resourceType, id string) {
+	delete(s.resources[resourceType], id)
+	order := s.order[resourceType]
+	for i, existing := range order {
+		if existing == id {
+			s.order[resourceType] = append(order[:i], order[i+1:]...)
+			break
+		}
+	}
+}
+
+// handleCreate gövdedeki kaynağı oluşturur. resourceType boşsa gövdedeki tip kullanılır.
+func (s *Server) handleCreate(w http.ResponseWriter, resourceType string, payload map[string]interface{}, extra map[string]interface{}) {
+	data, ok := payload["data"].(map[string]interface{})
+	if !ok {
+		writeError(w, http.StatusBadRequest, "Geçersiz istek", "data alanı eksik")
+		return
+	}
+	if resourceType == "" {
+		resourceType, _ = data["type"].(string)
+	}
+	if resourceType == "" {
+		writeError(w, http.StatusBadRequest, "Geçersiz istek", "type alanı eksik")
+		return
+	}
+
+	attributes, _ := data["attributes"].(map[string]interface{})
+	candidate := &Resource{Type: resourceType, Attributes: normalizeAttributes(attributes)}
+	if errs := validate(candidate); len(errs) > 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": errs})
+		return
+	}
+
+	relationships, _ := data["relationships"].(map[string]interface{})
+	normalized := s.normalizeRelationships(relationships)
+	for key, value := range extra {
+		normalized[key] = value
+	}
+
+	resource := s.insert(resourceType, candidate.Attributes, normalized)
+	// Tekliften oluşturulan fatura teklifin sales_invoice ilişkisine yazılır
+	if offer, ok := normalized["sales_offer"].(parasut.RelationshipData); ok && resourceType == "sales_invoices" {
+		if parent, ok := s.resources["sales_offers"][offer.ID]; ok {
+			parent.Relationships["sales_invoice"] = parasut.RelationshipData{ID: resource.ID, Type: resourceType}
+		}
+	}
+	if resourceType == "sales_invoices" && resource.Attributes["shipment_included"] == true {
+		s.recordStockMovements(resource)
+	}
+	s.writeDocument(w, http.StatusCreated, resource, "")
+}
+
+// recordStockMovements irsaliyeli faturanın stok takibi yapılan ürünlü
+// kalemleri için stok hareketi oluşturur: faturalar stoktan çıkış, iadeler
+// stoka giriştir
+func (s *Server) recordStockMovements(invoice *Resource) {
+	movementType := "out"
+	if invoice.Attributes["item_type"] == "refund" {
+		movementType = "in"
+	}
+	for _, ref := range invoice.refs("details") {
+		detail, ok := s.resources[ref.Type][ref.ID]
+		if !ok {
+			continue
+		}
+		products := detail.refs("product")
+		if len(products) == 0 {
+			continue
+		}
+		product, ok := s.resources["products"][products[0].ID]
+		if !ok || product.Attributes["inventory_tracking"] != true {
+			continue
+		}
+		s.insert("stock_movements", map[string]interface{}{
+			"date":          invoice.Attributes["issue_date"],
+			"movement_type": movementType,
+			"quantity":      detail.Attributes["quantity"],
+		}, map[string]interface{}{
+			"product":       parasut.RelationshipData{ID: product.ID, Type: "products"},
+			"sales_invoice": parasut.RelationshipData{ID: invoice.ID, Type: "sales_invoices"},
+		})
+	}
+}
+
+// handleList filtreleme, sıralama ve sayfalama uygulayarak liste döndürür
+func (s *Server) handleList(w http.ResponseWriter, query url.Values, resources []*Resource) {
+	var filtered []*Resource
+	for _, resource := range resources {
+		if matchesFilters(resource, query) {
+			filtered = append(filtered, resource)
+		}
+	}
+
+	if sortParam := query.Get("sort"); sortParam != "" {
+		sortResources(filtered, strings.Split(sortParam, ","))
+	}
+
+	page, _ := strconv.Atoi(query.Get("page[number]"))
+	if page < 1 {
+		page = 1
+	}
+	size, _ := strconv.Atoi(query.Get("page[size]"))
+	if size < 1 {
+		size = defaultPageSize
+	}
+
+	totalPages := (len(filtered) + size - 1) / size
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	start := (page - 1) * size
+	if start > len(filtered) {
+		start = len(filtered)
+	}
+	end := start + size
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	data := []interface{}{}
+	for _, resource := range filtered[start:end] {
+		data = append(data, resource.document())
+	}
+
+	response := map[string]interface{}{
+		"data": data,
+		"meta": parasut.Meta{CurrentPage: page, TotalPages: totalPages, TotalCount: len(filtered)},
+	}
+	if included := s.included(filtered[start:end], query.Get("include")); len(included) > 0 {
+		response["included"] = included
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleMe oturumdaki kullanıcıyı döndürür
+func (s *Server) handleMe(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":   "1",
+			"type": "users",
+			"attributes": map[string]interface{}{
+				"name":         "Paraşüt Test",
+				"email":        s.Email,
+				"is_confirmed": true,
+			},
+			"relationships": map[string]interface{}{
+				"companies": map[string]interface{}{
+					"data": []parasut.RelationshipData{{ID: strconv.Itoa(s.CompanyID), Type: "companies"}},
+				},
+			},
+		},
+	})
+}
+
+// handleToken password, authorization_code ve refresh_token akışlarını destekler
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request, body []byte) {
+	form, _ := url.ParseQuery(string(body))
+
+	var valid bool
+	switch form.Get("grant_type") {
+	case "password":
+		valid = form.Get("username") == s.Email && form.Get("password") == s.Password
+	case "authorization_code":
+		valid = form.Get("code") == s.AuthCode
+	case "refresh_token":
+		valid = form.Get("refresh_token") == s.RefreshToken
+	}
+
+	if !valid {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"error":  "invalid_grant",
+			"errors": []parasut.Error{{Title: "invalid_grant", Detail: "kimlik bilgileri geçersiz"}},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  s.Token,
+		"token_type":    "bearer",
+		"expires_in":    7200,
+		"refresh_token": s.RefreshToken,
+		"created_at":    s.Now().Unix(),
+	})
+}
+
+// insert kaynağı yeni bir id ile saklar
+func (s *Server) insert(resourceType string, attributes, relationships map[string]interface{}) *Resource {
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+	if relationships == nil {
+		relationships = map[string]interface{}{}
+	}
+	now := s.timestamp()
+	if _, ok := attributes["created_at"]; !ok {
+		attributes["created_at"] = now
+	}
+	if _, ok := attributes["updated_at"]; !ok {
+		attributes["updated_at"] = now
+	}
+
+	resource := &Resource{ID: id, Type: resourceType, Attributes: attributes, Relationships: relationships}
+	if s.resources[resourceType] == nil {
+		s.resources[resourceType] = map[string]*Resource{}
+	}
+	s.resources[resourceType][id] = resource
+	s.order[resourceType] = append(s.order[resourceType], id)
+	return resource
+}
+
+// all verilen tipteki kaynakları ekleme sırasıyla döndürür
+func (s *Server) all(resourceType string) []*Resource {
+	var result []*Resource
+	for _, id := range s.order[resourceType] {
+		result = append(result, s.resources[resourceType][id])
+	}
+	return result
+}
+
+// normalizeRelationships hem {"data": ...} hem de çıplak biçimi kabul eder.
+// Nitelik taşıyan ve id'si olmayan öğeler (ör. contact_people) yeni kaynak olarak oluşturulur.
+func (s *Server) normalizeRelationships(relationships map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range relationships {
+		object, ok := value.(map[string]interface{})
+		if ok {
+			if data, wrapped := object["data"]; wrapped {
+				value = data
+			}
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			result[key] = s.reference(v)
+		case []interface{}:
+			refs := []parasut.RelationshipData{}
+			for _, item := range v {
+				if object, ok := item.(map[string]interface{}); ok {
+					refs = append(refs, s.reference(object))
+				}
+			}
+			result[key] = refs
+		}
+	}
+	return result
+}
+
+// reference ilişki öğesini RelationshipData'ya çevirir, gerekirse kaynağı oluşturur
+func (s *Server) reference(object map[string]interface{}) parasut.RelationshipData {
+	id, _ := object["id"].(string)
+	resourceType, _ := object["type"].(string)
+
+	attributes, hasAttributes := object["attributes"].(map[string]interface{})
+	if !hasAttributes {
+		return parasut.RelationshipData{ID: id, Type: resourceType}
+	}
+
+	if existing, ok := s.resources[resourceType][id]; ok {
+		for key, value := range attributes {
+			existing.Attributes[key] = value
+		}
+		return parasut.RelationshipData{ID: id, Type: resourceType}
+	}
+
+	relationships, _ := object["relationships"].(map[string]interface{})
+	created := s.insert(resourceType, normalizeAttributes(attributes), s.normalizeRelationships(relationships))
+	return parasut.RelationshipData{ID: created.ID, Type: resourceType}
+}
+
+// included istenen ilişkilerdeki kaynakları tekrarsız döndürür
+func (s *Server) included(resources []*Resource, include string) []interface{} {
+	if include == "" {
+		return nil
+	}
+
+	seen := map[parasut.RelationshipData]bool{}
+	var result []interface{}
+	for _, name := range strings.Split(include, ",") {
+		// payments.transaction gibi iç içe ilişkiler adım adım izlenir
+		current := resources
+		for _, step := range strings.Split(strings.TrimSpace(name), ".") {
+			var next []*Resource
+			for _, resource := range current {
+				for _, ref := range resource.refs(step) {
+					target, ok := s.resources[ref.Type][ref.ID]
+					if !ok {
+						continue
+					}
+					next = append(next, target)
+					if !seen[ref] {
+						seen[ref] = true
+						result = append(result, target.document())
+					}
+				}
+			}
+			current = next
+		}
+	}
+	return result
+}
+
+// writeDocument tek kaynaklı JSON:API yanıtı yazar
+func (s *Server) writeDocument(w http.ResponseWriter, status int, resource *Resource, include string) {
+	response := map[string]interface{}{"data": resource.document()}
+	if included := s.included([]*Resource{resource}, include); len(included) > 0 {
+		response["included"] = included
+	}
+	writeJSON(w, status, response)
+}
+
+func (s *Server) timestamp() string {
+	return s.Now().UTC().Format(time.RFC3339)
+}
+
+// document kaynağı JSON:API biçimine çevirir
+func (r *Resource) document() map[string]interface{} {
+	relationships := map[string]interface{}{}
+	for key, value := range r.Relationships {
+		relationships[key] = map[string]interface{}{"data": value}
+	}
+	return map[string]interface{}{
+		"id":            r.ID,
+		"type":          r.Type,
+		"attributes":    r.Attributes,
+		"relationships": relationships,
+	}
+}
+
+// refs ilişkideki referansları döndürür
+func (r *Resource) refs(name string) []parasut.RelationshipData {
+	switch v := r.Relationships[name].(type) {
+	case parasut.RelationshipData:
+		return []parasut.RelationshipData{v}
+	case []parasut.RelationshipData:
+		return v
+	}
+	return nil
+}
+
+// references kaynağın herhangi bir ilişkisinin target'ı gösterip göstermediğini döndürür
+func (r *Resource) references(target *Resource) bool {
+	for name := range r.Relationships {
+		for _, ref := range r.refs(name) {
+			if ref.ID == target.ID && ref.Type == target.Type {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Resource) clone() Resource {
+	copied := Resource{ID: r.ID, Type: r.Type, Attributes: map[string]interface{}{}, Relationships: map[string]interface{}{}}
+	for key, value := range r.Attributes {
+		copied.Attributes[key] = value
+	}
+	for key, value := range r.Relationships {
+		copied.Relationships[key] = value
+	}
+	return copied
+}
+
+// matchesFilters filter[alan] parametrelerini nitelik veya ilişki id'si ile karşılaştırır.
+// filter[contact_id] gibi _id ile biten filtreler ilişkiye bakar. Nitelik
+// filtrelerinde "a,b" değerlerden birini, "2024-05-01..2024-05-31" aralığı
+// (uçlar dahil, boş uç sınırsız) ifade eder.
+func matchesFilters(resource *Resource, query url.Values) bool {
+	for key, values := range query {
+		if !strings.HasPrefix(key, "filter[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		field := key[len("filter[") : len(key)-1]
+		want := values[0]
+
+		if value, ok := resource.Attributes[field]; ok {
+			if !matchesValue(fmt.Sprint(value), want) {
+				return false
+			}
+			continue
+		}
+
+		if strings.HasSuffix(field, "_id") {
+			matched := false
+			for _, ref := range resource.refs(strings.TrimSuffix(field, "_id")) {
+				if ref.ID == want {
+					matched = true
+				}
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+
+		return false
+	}
+	return true
+}
+
+// matchesValue nitelik değerini filtre değeriyle, liste ve aralık
+// biçimlerini de gözeterek karşılaştırır
+func matchesValue(value, want string) bool {
+	if value == want {
+		return true
+	}
+	if from, to, ok := strings.Cut(want, ".."); ok {
+		bound := func(b string) string {
+			if len(value) > len(b) {
+				return value[:len(b)]
+			}
+			return value
+		}
+		return (from == "" || bound(from) >= from) && (to == "" || bound(to) <= to)
+	}
+	for _, item := range strings.Split(want, ",") {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sortResources JSON:API sort parametresine göre sıralar; "-" azalan sıra demektir
+func sortResources(resources []*Resource, fields []string) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		for _, field := range fields {
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+
+			cmp := compare(resources[i].Attributes[field], resources[j].Attributes[field])
+			if cmp == 0 {
+				continue
+			}
+			if desc {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	})
+}
+
+func compare(a, b interface{}) int {
+	if x, ok := a.(float64); ok {
+		if y, ok := b.(float64); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// validate zorunlu niteliklerin dolu olduğunu kontrol eder
+func validate(resource *Resource) []parasut.Error {
+	var errs []parasut.Error
+	for _, field := range requiredFields[resource.Type] {
+		value, ok := resource.Attributes[field]
+		if !ok || value == nil || value == "" {
+			errs = append(errs, parasut.Error{Title: "Geçersiz kayıt", Detail: field + " boş olamaz"})
+		}
+	}
+	return errs
+}
+
+// normalizeAttributes nitelikleri JSON'dan çözülmüş biçime getirir (ör. int -> float64)
+func normalizeAttributes(attributes map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	if attributes == nil {
+		return result
+	}
+	data, _ := json.Marshal(attributes)
+	json.Unmarshal(data, &result)
+	return result
+}
+
+func number(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return 0
+}
+
+// singular çoğul kaynak tipinden ilişki adını türetir (contacts -> contact)
+func singular(resourceType string) string {
+	return strings.TrimSuffix(resourceType, "s")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, title, detail string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []parasut.Error{{Title: title, Detail: detail}},
+	})
+}
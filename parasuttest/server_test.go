@@ -0,0 +1,465 @@
+package parasuttest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+func TestServer_ContactsCRUD(t *testing.T) {
+	client, server := NewClient(t)
+	ctx := context.Background()
+
+	contact, err := client.Contacts.Create(ctx, parasut.ContactInput{Name: "Alfa Ltd.", AccountType: "customer"}, &parasut.ContactRelationshipsInput{
+		ContactPeople: []parasut.ContactPersonInput{{Name: "Ayşe", Email: "ayse@alfa.com"}},
+	})
+	if err != nil {
+		t.Fatalf("Contacts.Create hata döndü: %v", err)
+	}
+
+	if contact.ID == "" || contact.Attributes.Name != "Alfa Ltd." {
+		t.Errorf("Oluşturulan müşteri = %+v", contact)
+	}
+
+	if people := server.Resources("contact_people"); len(people) != 1 || people[0].Attributes["name"] != "Ayşe" {
+		t.Errorf("contact_people = %+v", people)
+	}
+
+	fetched, err := client.Contacts.Get(ctx, contact.ID, "contact_people")
+	if err != nil {
+		t.Fatalf("Contacts.Get hata döndü: %v", err)
+	}
+
+	if len(fetched.ContactPeople) != 1 || fetched.ContactPeople[0].Attributes.Email != "ayse@alfa.com" {
+		t.Errorf("ContactPeople = %+v", fetched.ContactPeople)
+	}
+
+	updated, err := client.Contacts.Update(ctx, contact.ID, parasut.ContactInput{Name: "Alfa A.Ş.", City: "İstanbul"}, nil)
+	if err != nil {
+		t.Fatalf("Contacts.Update hata döndü: %v", err)
+	}
+
+	if updated.Attributes.Name != "Alfa A.Ş." || updated.Attributes.City != "İstanbul" || updated.Attributes.AccountType != "customer" {
+		t.Errorf("Güncellenen müşteri = %+v", updated.Attributes)
+	}
+
+	if err := client.Contacts.Delete(ctx, contact.ID); err != nil {
+		t.Fatalf("Contacts.Delete hata döndü: %v", err)
+	}
+
+	_, err = client.Contacts.Get(ctx, contact.ID)
+	var apiErr *parasut.ErrorResponse
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Silinen müşteri için hata = %v, beklenen 404", err)
+	}
+}
+
+func TestServer_Validation(t *testing.T) {
+	client, _ := NewClient(t)
+
+	_, err := client.Products.Create(context.Background(), parasut.ProductInput{Code: "P1"})
+
+	var apiErr *parasut.ErrorResponse
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("Hata = %v, beklenen 422", err)
+	}
+
+	if apiErr.Errors[0].Detail != "name boş olamaz" {
+		t.Errorf("Detail = %s", apiErr.Errors[0].Detail)
+	}
+}
+
+func TestServer_ListFilterSortAndPaging(t *testing.T) {
+	client, server := NewClient(t)
+
+	alfa := server.Seed("contacts", map[string]interface{}{"name": "Alfa"}, nil)
+	beta := server.Seed("contacts", map[string]interface{}{"name": "Beta"}, nil)
+	for i, amount := range []float64{300, 100, 200} {
+		contact := alfa
+		if i == 2 {
+			contact = beta
+		}
+		server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01", "net_total": amount}, map[string]interface{}{
+			"contact": parasut.RelationshipData{ID: contact, Type: "contacts"},
+		})
+	}
+
+	invoices, meta, err := client.SalesInvoices.List(context.Background(), &parasut.ListParams{
+		Filter:   map[string]string{"contact_id": alfa},
+		Sort:     "net_total",
+		PageSize: 1,
+		Page:     2,
+	})
+	if err != nil {
+		t.Fatalf("SalesInvoices.List hata döndü: %v", err)
+	}
+
+	if meta.TotalCount != 2 || meta.TotalPages != 2 || meta.CurrentPage != 2 {
+		t.Errorf("Meta = %+v", meta)
+	}
+
+	if len(invoices) != 1 || invoices[0].Attributes.NetTotal != 300 {
+		t.Errorf("Faturalar = %+v", invoices)
+	}
+
+	all, err := parasut.ListAll(context.Background(), client.SalesInvoices.List, &parasut.ListParams{PageSize: 2, Sort: "-net_total"})
+	if err != nil {
+		t.Fatalf("ListAll hata döndü: %v", err)
+	}
+
+	if len(all) != 3 || all[0].Attributes.NetTotal != 300 || all[2].Attributes.NetTotal != 100 {
+		t.Errorf("Tüm faturalar = %+v", all)
+	}
+}
+
+func TestServer_PaymentsAndActions(t *testing.T) {
+	client, server := NewClient(t)
+	ctx := context.Background()
+
+	id := server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01", "net_total": 1000}, nil)
+	account := server.Seed("accounts", map[string]interface{}{"name": "Kasa", "balance": 100}, nil)
+
+	payment, err := client.SalesInvoices.CreatePayment(ctx, id, parasut.PaymentInput{AccountID: account, Date: "2024-05-02", Amount: 400, ExchangeRate: 1})
+	if err != nil {
+		t.Fatalf("CreatePayment hata döndü: %v", err)
+	}
+	if resource, _ := server.Resource("payments", payment.ID); resource.Relationships["account"] != (parasut.RelationshipData{ID: account, Type: "accounts"}) {
+		t.Errorf("Ödeme hesabı = %v", resource.Relationships["account"])
+	}
+	if resource, _ := server.Resource("accounts", account); resource.Attributes["balance"] != 500.0 {
+		t.Errorf("Hesap bakiyesi = %v, beklenen 500", resource.Attributes["balance"])
+	}
+
+	invoice, err := client.SalesInvoices.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("SalesInvoices.Get hata döndü: %v", err)
+	}
+
+	if invoice.Attributes.Remaining != 600 || invoice.Attributes.PaymentStatus != "partially_paid" {
+		t.Errorf("Ödeme sonrası fatura = %+v", invoice.Attributes)
+	}
+
+	if _, err := client.SalesInvoices.CreatePayment(ctx, id, parasut.PaymentInput{AccountID: "9", Date: "2024-05-03", Amount: 700, ExchangeRate: 1}); err == nil {
+		t.Error("Kalan tutardan büyük ödeme için hata bekleniyordu")
+	}
+
+	if err := client.SalesInvoices.Cancel(ctx, id); err != nil {
+		t.Fatalf("Cancel hata döndü: %v", err)
+	}
+
+	if resource, _ := server.Resource("sales_invoices", id); resource.Attributes["item_type"] != "cancelled" {
+		t.Errorf("İptal sonrası item_type = %v", resource.Attributes["item_type"])
+	}
+
+	if err := client.SalesInvoices.Recover(ctx, id); err != nil {
+		t.Fatalf("Recover hata döndü: %v", err)
+	}
+
+	if err := client.SalesInvoices.Archive(ctx, id); err != nil {
+		t.Fatalf("Archive hata döndü: %v", err)
+	}
+
+	resource, _ := server.Resource("sales_invoices", id)
+	if resource.Attributes["item_type"] != "invoice" || resource.Attributes["archived"] != true {
+		t.Errorf("Fatura nitelikleri = %+v", resource.Attributes)
+	}
+}
+
+func TestServer_FailNext(t *testing.T) {
+	client, server := NewClient(t)
+	server.FailNext(http.MethodGet, "/tags", http.StatusServiceUnavailable, parasut.Error{Title: "Bakım", Detail: "tekrar deneyin"})
+
+	_, _, err := client.Tags.List(context.Background(), nil)
+	var apiErr *parasut.ErrorResponse
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable || apiErr.Errors[0].Title != "Bakım" {
+		t.Fatalf("Hata = %v, beklenen 503", err)
+	}
+
+	if _, _, err := client.Tags.List(context.Background(), nil); err != nil {
+		t.Errorf("İkinci istek hata döndü: %v", err)
+	}
+
+	if requests := server.Requests(); len(requests) != 2 || requests[0].Path != "/v4/1/tags" {
+		t.Errorf("İstekler = %+v", requests)
+	}
+}
+
+func TestServer_TokenAndMe(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	client := parasut.NewClient(server.Config())
+	ctx := context.Background()
+
+	if err := client.SetTokenFromPassword(ctx, DefaultEmail, "yanlış"); err == nil {
+		t.Error("Yanlış şifre için hata bekleniyordu")
+	}
+
+	if err := client.SetTokenFromPassword(ctx, DefaultEmail, DefaultPassword); err != nil {
+		t.Fatalf("SetTokenFromPassword hata döndü: %v", err)
+	}
+
+	me, err := client.Me.Get(ctx)
+	if err != nil {
+		t.Fatalf("Me.Get hata döndü: %v", err)
+	}
+
+	if me.Attributes.Email != DefaultEmail {
+		t.Errorf("Email = %s", me.Attributes.Email)
+	}
+
+	jobID := server.Seed("trackable_jobs", map[string]interface{}{"status": "done"}, nil)
+	job, err := client.TrackableJobs.Get(ctx, jobID)
+	if err != nil {
+		t.Fatalf("TrackableJobs.Get hata döndü: %v", err)
+	}
+
+	if job.Attributes.Status != "done" {
+		t.Errorf("Status = %s", job.Attributes.Status)
+	}
+
+	unauthorized := parasut.NewClient(server.Config())
+	if _, _, err := unauthorized.Tags.List(ctx, nil); err == nil {
+		t.Error("Token'sız istek için hata bekleniyordu")
+	}
+}
+
+func TestServer_ListAndDeletePayments(t *testing.T) {
+	client, server := NewClient(t)
+	ctx := context.Background()
+
+	account := server.Seed("accounts", map[string]interface{}{"name": "Banka", "balance": 0}, nil)
+	id := server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01", "net_total": 1000}, nil)
+	for _, amount := range []float64{300, 200} {
+		if _, err := client.SalesInvoices.CreatePayment(ctx, id, parasut.PaymentInput{AccountID: account, Date: "2024-05-02", Amount: amount, ExchangeRate: 1}); err != nil {
+			t.Fatalf("CreatePayment hata döndü: %v", err)
+		}
+	}
+
+	payments, err := client.Payments.List(ctx, "sales_invoices", id)
+	if err != nil {
+		t.Fatalf("Payments.List hata döndü: %v", err)
+	}
+	if len(payments) != 2 || payments[0].Transaction == nil || payments[0].Transaction.Attributes.Amount != 300 {
+		t.Fatalf("Ödemeler = %+v", payments)
+	}
+
+	if err := client.Payments.Delete(ctx, payments[0].ID); err != nil {
+		t.Fatalf("Payments.Delete hata döndü: %v", err)
+	}
+
+	invoice, _ := server.Resource("sales_invoices", id)
+	if invoice.Attributes["remaining"] != 800.0 || invoice.Attributes["payment_status"] != "partially_paid" {
+		t.Errorf("Silme sonrası fatura = %+v", invoice.Attributes)
+	}
+	if resource, _ := server.Resource("accounts", account); resource.Attributes["balance"] != 200.0 {
+		t.Errorf("Silme sonrası bakiye = %v", resource.Attributes["balance"])
+	}
+	if _, ok := server.Resource("transactions", payments[0].Transaction.ID); ok {
+		t.Error("Ödemenin hesap hareketi silinmeliydi")
+	}
+	if remaining, err := client.Payments.List(ctx, "sales_invoices", id); err != nil || len(remaining) != 1 || remaining[0].ID != payments[1].ID {
+		t.Errorf("Kalan ödemeler = %+v, %v", remaining, err)
+	}
+}
+
+func TestServer_AccountTransfer(t *testing.T) {
+	client, server := NewClient(t)
+	ctx := context.Background()
+
+	cash := server.Seed("accounts", map[string]interface{}{"name": "Kasa", "currency": "TRL", "balance": 1000}, nil)
+	bank := server.Seed("accounts", map[string]interface{}{"name": "Banka", "currency": "TRL", "balance": 0}, nil)
+
+	transfer, err := client.Accounts.Transfer(ctx, cash, bank, 400, "2024-05-02", 1)
+	if err != nil {
+		t.Fatalf("Transfer hata döndü: %v", err)
+	}
+	if transfer.Debit.Attributes.TransactionType != "account_debit" || transfer.Credit.Attributes.TransactionType != "account_credit" {
+		t.Errorf("Virman = %+v", transfer)
+	}
+	for id, want := range map[string]float64{cash: 600, bank: 400} {
+		if resource, _ := server.Resource("accounts", id); resource.Attributes["balance"] != want {
+			t.Errorf("%s bakiyesi = %v, beklenen %v", id, resource.Attributes["balance"], want)
+		}
+	}
+
+	transactions, err := client.Accounts.ListTransactions(ctx, bank, parasut.AccountTransactionFilter{Types: []string{"account_credit"}})
+	if err != nil || len(transactions) != 1 || transactions[0].Attributes.Amount != 400 {
+		t.Errorf("Banka hareketleri = %+v, %v", transactions, err)
+	}
+
+	if _, err := client.Accounts.Transfer(ctx, cash, bank, 50, "2024-06-10", 1); err != nil {
+		t.Fatalf("Transfer hata döndü: %v", err)
+	}
+	page, meta, err := client.Accounts.ListTransactionsPage(ctx, bank, parasut.AccountTransactionFilter{
+		From: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	}, &parasut.ListParams{PageSize: 1})
+	if err != nil || len(page) != 1 || page[0].Attributes.Amount != 50 || meta.TotalCount != 1 {
+		t.Errorf("Haziran hareketleri = %+v, %+v, %v", page, meta, err)
+	}
+
+	// Giriş hareketi başarısız olursa çıkış hareketi silinir ve bakiye geri alınır
+	server.FailNext(http.MethodPost, "/accounts/"+bank+"/credit_transactions", http.StatusUnprocessableEntity)
+	if _, err := client.Accounts.Transfer(ctx, cash, bank, 100, "2024-05-03", 1); err == nil {
+		t.Fatal("Başarısız virman için hata bekleniyordu")
+	}
+	if resource, _ := server.Resource("accounts", cash); resource.Attributes["balance"] != 550.0 {
+		t.Errorf("Geri alınan virman sonrası kasa bakiyesi = %v", resource.Attributes["balance"])
+	}
+	if transactions, _ := client.Accounts.ListTransactions(ctx, cash, parasut.AccountTransactionFilter{}); len(transactions) != 2 {
+		t.Errorf("Kasa hareketleri = %+v", transactions)
+	}
+}
+
+func TestServer_RecurringInvoices(t *testing.T) {
+	client, server := NewClient(t)
+	ctx := context.Background()
+
+	template, plan, err := client.SalesInvoices.CreateRecurring(ctx, parasut.SalesInvoiceInput{Description: "Abonelik"}, nil, parasut.RecurrencePlanInput{
+		Period: parasut.RecurrenceMonthly, StartDate: "2024-05-01", DayOfMonth: 1,
+	})
+	if err != nil {
+		t.Fatalf("CreateRecurring hata döndü: %v", err)
+	}
+	if template.Attributes.ItemType != "recurring_invoice" || template.Relationships.RecurrencePlan.ID != plan.ID {
+		t.Fatalf("Şablon = %+v", template)
+	}
+
+	planRef := parasut.RelationshipData{ID: plan.ID, Type: "recurrence_plans"}
+	for _, date := range []string{"2024-06-01", "2024-05-01"} {
+		server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": date}, map[string]interface{}{"recurrence_plan": planRef})
+	}
+	server.Seed("sales_invoices", map[string]interface{}{"item_type": "invoice", "issue_date": "2024-05-01"}, nil)
+
+	generated, err := client.SalesInvoices.ListGenerated(ctx, plan.ID)
+	if err != nil {
+		t.Fatalf("ListGenerated hata döndü: %v", err)
+	}
+	if len(generated) != 2 || generated[0].Attributes.IssueDate != "2024-05-01" {
+		t.Errorf("Oluşturulan faturalar = %+v", generated)
+	}
+
+	updated, err := client.RecurrencePlans.Update(ctx, plan.ID, parasut.RecurrencePlanInput{Period: parasut.RecurrenceMonthly, StartDate: "2024-05-01", EndDate: "2024-12-31"})
+	if err != nil || updated.Attributes.EndDate != "2024-12-31" {
+		t.Errorf("Update = %+v, %v", updated, err)
+	}
+}
+
+func TestServer_OfferConversion(t *testing.T) {
+	client, server := NewClient(t)
+	ctx := context.Background()
+
+	contact := server.Seed("contacts", map[string]interface{}{"name": "Acme"}, nil)
+	product := server.Seed("products", map[string]interface{}{"name": "Sunucu"}, nil)
+	detail := server.Seed("sales_offer_details", map[string]interface{}{"quantity": 2, "unit_price": 100, "vat_rate": 20}, map[string]interface{}{
+		"product": parasut.RelationshipData{ID: product, Type: "products"},
+	})
+	offerID := server.Seed("sales_offers", map[string]interface{}{"issue_date": "2024-05-01", "net_total": 200, "gross_total": 240, "status": "pending"}, map[string]interface{}{
+		"contact": parasut.RelationshipData{ID: contact, Type: "contacts"},
+		"details": []parasut.RelationshipData{{ID: detail, Type: "sales_offer_details"}},
+	})
+	server.Seed("sales_offers", map[string]interface{}{"issue_date": "2024-05-02", "net_total": 50, "gross_total": 60, "status": "rejected"}, nil)
+
+	invoice, err := client.SalesOffers.ConvertToInvoice(ctx, offerID)
+	if err != nil {
+		t.Fatalf("ConvertToInvoice hata döndü: %v", err)
+	}
+	if invoice.Relationships.SalesOffer == nil || invoice.Relationships.SalesOffer.ID != offerID {
+		t.Errorf("Fatura ilişkileri = %+v", invoice.Relationships)
+	}
+
+	created, err := client.SalesInvoices.Get(ctx, invoice.ID, "details")
+	if err != nil || len(created.Details) != 1 || created.Details[0].Attributes.Quantity != 2 ||
+		created.Details[0].Relationships.Product == nil || created.Details[0].Relationships.Product.ID != product {
+		t.Errorf("Fatura kalemleri = %+v, %v", created, err)
+	}
+
+	offer, _ := client.SalesOffers.Get(ctx, offerID)
+	if offer.Attributes.Status != parasut.SalesOfferAccepted || offer.Relationships.SalesInvoice == nil || offer.Relationships.SalesInvoice.ID != invoice.ID {
+		t.Errorf("Teklif = %+v", offer)
+	}
+	if _, err := client.SalesOffers.ConvertToInvoice(ctx, offerID); err == nil {
+		t.Error("İkinci dönüştürme için hata bekleniyordu")
+	}
+
+	pipeline, err := client.SalesOffers.Pipeline(ctx, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("Pipeline hata döndü: %v", err)
+	}
+	if pipeline.Stage(parasut.SalesOfferAccepted).GrossTotal != 240 || pipeline.Invoiced.Count != 1 || pipeline.WinRate != 0.5 {
+		t.Errorf("Pipeline = %+v", pipeline)
+	}
+}
+
+func TestServer_Refunds(t *testing.T) {
+	client, server := NewClient(t)
+	ctx := context.Background()
+
+	contact := server.Seed("contacts", map[string]interface{}{"name": "Acme"}, nil)
+	tracked := server.Seed("products", map[string]interface{}{"name": "Sunucu", "inventory_tracking": true}, nil)
+	product := parasut.RelationshipData{ID: tracked, Type: "products"}
+
+	original, err := client.SalesInvoices.CreateWithDetails(ctx,
+		parasut.SalesInvoiceInput{ItemType: "invoice", IssueDate: "2024-05-01", ShipmentIncluded: true},
+		&parasut.SalesInvoiceRelationships{Contact: &parasut.RelationshipData{ID: contact, Type: "contacts"}},
+		[]parasut.SalesInvoiceDetailInput{
+			{Quantity: 3, UnitPrice: 100, VatRate: 20, Product: &product},
+			{Quantity: 1, UnitPrice: 50, VatRate: 20, Description: "Kurulum"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("CreateWithDetails hata döndü: %v", err)
+	}
+	withDetails, _ := client.SalesInvoices.Get(ctx, original.ID, "details")
+
+	first, err := client.SalesInvoices.CreateRefund(ctx, original.ID, []parasut.RefundLine{{DetailID: withDetails.Details[0].ID, Quantity: 2}}, parasut.RefundOptions{
+		IssueDate: "2024-05-10", EDocument: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateRefund hata döndü: %v", err)
+	}
+	if first.Invoice.Attributes.ItemType != "refund" || first.Invoice.Relationships.OriginalInvoice.ID != original.ID || first.EArchive == nil {
+		t.Errorf("İade = %+v", first)
+	}
+
+	movements := server.Resources("stock_movements")
+	if len(movements) != 2 || movements[0].Attributes["movement_type"] != "out" || movements[1].Attributes["movement_type"] != "in" ||
+		movements[1].Attributes["quantity"] != 2.0 {
+		t.Errorf("Stok hareketleri = %+v", movements)
+	}
+
+	// Kalan tek ürün ve hizmet kalemi iade edilir
+	second, err := client.SalesInvoices.CreateRefund(ctx, original.ID, nil, parasut.RefundOptions{IssueDate: "2024-05-11"})
+	if err != nil {
+		t.Fatalf("İkinci CreateRefund hata döndü: %v", err)
+	}
+	refund, _ := client.SalesInvoices.Get(ctx, second.Invoice.ID, "details")
+	if len(refund.Details) != 2 || refund.Details[0].Attributes.Quantity != 1 {
+		t.Errorf("İkinci iade kalemleri = %+v", refund.Details)
+	}
+
+	if _, err := client.SalesInvoices.CreateRefund(ctx, original.ID, nil, parasut.RefundOptions{}); err == nil {
+		t.Error("Tamamen iade edilmiş fatura için hata bekleniyordu")
+	}
+
+	// Ayrı irsaliyeyle sevk edilen faturanın iadesi de stoğa geri girer
+	separate, err := client.SalesInvoices.CreateWithDetails(ctx,
+		parasut.SalesInvoiceInput{ItemType: "invoice", IssueDate: "2024-05-12"},
+		&parasut.SalesInvoiceRelationships{Contact: &parasut.RelationshipData{ID: contact, Type: "contacts"}},
+		[]parasut.SalesInvoiceDetailInput{{Quantity: 4, UnitPrice: 100, VatRate: 20, Product: &product}},
+	)
+	if err != nil {
+		t.Fatalf("CreateWithDetails hata döndü: %v", err)
+	}
+	if _, err := client.SalesInvoices.CreateRefund(ctx, separate.ID, nil, parasut.RefundOptions{IssueDate: "2024-05-15"}); err != nil {
+		t.Fatalf("Üçüncü CreateRefund hata döndü: %v", err)
+	}
+	movements = server.Resources("stock_movements")
+	if last := movements[len(movements)-1]; len(movements) != 4 || last.Attributes["movement_type"] != "in" || last.Attributes["quantity"] != 4.0 {
+		t.Errorf("Stok hareketleri = %+v", movements)
+	}
+}
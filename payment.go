@@ -0,0 +1,136 @@
+package parasut
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// validate ödemenin API'ye gönderilmeden önce zorunlu alanlarını denetler
+func (p PaymentInput) validate() error {
+	if p.AccountID == "" {
+		return fmt.Errorf("ödeme için kasa/banka hesabı (AccountID) zorunludur")
+	}
+	if p.ExchangeRate <= 0 {
+		return fmt.Errorf("ödeme kuru (ExchangeRate) sıfırdan büyük olmalıdır; aynı dövizde 1 verin")
+	}
+	if p.Amount <= 0 {
+		return fmt.Errorf("ödeme tutarı sıfırdan büyük olmalıdır")
+	}
+	return nil
+}
+
+// PaymentAllocation tahsilatın bir faturaya düşen kısmı
+type PaymentAllocation struct {
+	InvoiceID string  `json:"invoice_id"`
+	IssueDate string  `json:"issue_date"`
+	Amount    float64 `json:"amount"`
+	// Remaining ödeme sonrası faturada kalan tutar
+	Remaining float64  `json:"remaining"`
+	Payment   *Payment `json:"payment,omitempty"`
+}
+
+// PaymentAllocationResult tahsilatın faturalara dağıtım sonucu
+type PaymentAllocationResult struct {
+	ContactID   string              `json:"contact_id"`
+	Amount      float64             `json:"amount"`
+	Allocated   float64             `json:"allocated"`
+	Allocations []PaymentAllocation `json:"allocations"`
+	// Credit hiçbir faturaya düşmeyen, müşteri lehine kalan tutar
+	Credit float64 `json:"credit"`
+	// Skipped dövizi tahsilattan farklı olduğu için atlanan açık faturalar
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// AllocatePayment müşteriden gelen tek bir tahsilatı müşterinin açık satış
+// faturalarına (item_type invoice) en eskiden başlayarak dağıtır ve her fatura
+// için ödeme oluşturur. Proforma ve tekrarlayan fatura şablonlarına ödeme
+// girilmez. Faturaların kalan tutarını aşan kısım ödeme olarak girilmez,
+// Credit alanında raporlanır. Bir ödeme başarısız olursa o ana kadar
+// oluşturulan ödemeler ve dağıtılamayan tutar (Credit) hatayla birlikte
+// döndürülür.
+//
+// Yalnızca dövizi payment.Currency (boşsa TRL) ile aynı olan faturalara
+// dağıtılır; diğer açık faturalar Skipped alanında raporlanır. AccountID ve
+// ExchangeRate her ödemeye aynen aktarılır; kur boşsa ve istemcide kur
+// sağlayıcısı varsa dağıtımdan önce hesabın dövizine göre doldurulur.
+func (s *SalesInvoicesService) AllocatePayment(ctx context.Context, contactID string, payment PaymentInput) (*PaymentAllocationResult, error) {
+	if err := s.client.fillPaymentRate(ctx, &payment); err != nil {
+		return nil, err
+	}
+	if err := payment.validate(); err != nil {
+		return nil, err
+	}
+
+	invoices, err := ListAll(ctx, s.List, &ListParams{
+		Sort:   "issue_date",
+		Filter: map[string]string{"contact_id": contactID, "item_type": "invoice"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := allocatePayment(contactID, payment.Amount, CurrencyOrDefault(payment.Currency), invoices)
+	for i := range result.Allocations {
+		allocation := &result.Allocations[i]
+		input := payment
+		input.Amount = allocation.Amount
+		created, err := s.CreatePayment(ctx, allocation.InvoiceID, input)
+		if err != nil {
+			result.Allocations = result.Allocations[:i]
+			result.Allocated = 0
+			for _, done := range result.Allocations {
+				result.Allocated = RoundAmount(result.Allocated + done.Amount)
+			}
+			result.Credit = RoundAmount(result.Amount - result.Allocated)
+			return result, fmt.Errorf("%s numaralı faturaya ödeme girilemedi: %w", allocation.InvoiceID, err)
+		}
+		allocation.Payment = created
+	}
+	return result, nil
+}
+
+// allocatePayment tutarı currency dövizindeki açık faturalara düzenlenme
+// tarihi sırasıyla dağıtır
+func allocatePayment(contactID string, amount float64, currency string, invoices []SalesInvoice) *PaymentAllocationResult {
+	result := &PaymentAllocationResult{ContactID: contactID, Amount: amount}
+	var open []SalesInvoice
+	for _, invoice := range invoices {
+		attributes := invoice.Attributes
+		if attributes.ItemType != "invoice" || attributes.PaymentStatus == "paid" || attributes.Remaining <= 0 {
+			continue
+		}
+		if CurrencyOrDefault(attributes.Currency) != currency {
+			result.Skipped = append(result.Skipped, invoice.ID)
+			continue
+		}
+		open = append(open, invoice)
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		if open[i].Attributes.IssueDate != open[j].Attributes.IssueDate {
+			return open[i].Attributes.IssueDate < open[j].Attributes.IssueDate
+		}
+		return open[i].Attributes.DueDate < open[j].Attributes.DueDate
+	})
+
+	left := RoundAmount(amount)
+	for _, invoice := range open {
+		if left <= 0 {
+			break
+		}
+		applied := invoice.Attributes.Remaining
+		if applied > left {
+			applied = left
+		}
+		left = RoundAmount(left - applied)
+		result.Allocated = RoundAmount(result.Allocated + applied)
+		result.Allocations = append(result.Allocations, PaymentAllocation{
+			InvoiceID: invoice.ID,
+			IssueDate: invoice.Attributes.IssueDate,
+			Amount:    applied,
+			Remaining: RoundAmount(invoice.Attributes.Remaining - applied),
+		})
+	}
+	result.Credit = left
+	return result
+}
@@ -0,0 +1,170 @@
+package parasut
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPaymentInput_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   PaymentInput
+		wantErr string
+	}{
+		{"Geçerli", PaymentInput{AccountID: "1", Amount: 10, ExchangeRate: 1}, ""},
+		{"Hesap yok", PaymentInput{Amount: 10, ExchangeRate: 1}, "AccountID"},
+		{"Kur yok", PaymentInput{AccountID: "1", Amount: 10}, "ExchangeRate"},
+		{"Tutar sıfır", PaymentInput{AccountID: "1", ExchangeRate: 1}, "tutar"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.validate()
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("validate() = %v, beklenen %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreatePayment_RequiresAccount(t *testing.T) {
+	requests := 0
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+	if _, err := client.PurchaseBills.CreatePayment(context.Background(), "1", PaymentInput{Date: "2024-05-01", Amount: 10}); err == nil {
+		t.Error("Hesapsız ödeme için hata bekleniyordu")
+	}
+	if requests != 0 {
+		t.Errorf("Geçersiz ödeme için %d istek gönderildi", requests)
+	}
+}
+
+func TestAllocatePayment(t *testing.T) {
+	invoices := []SalesInvoice{
+		{ID: "3", Attributes: SalesInvoiceAttributes{ItemType: "invoice", IssueDate: "2024-03-01", Remaining: 100}},
+		{ID: "1", Attributes: SalesInvoiceAttributes{ItemType: "invoice", IssueDate: "2024-01-01", Remaining: 100, Currency: "TRL"}},
+		{ID: "2", Attributes: SalesInvoiceAttributes{ItemType: "invoice", IssueDate: "2024-02-01", Remaining: 100, PaymentStatus: "paid"}},
+		{ID: "4", Attributes: SalesInvoiceAttributes{IssueDate: "2023-12-01", Remaining: 50, ItemType: "cancelled"}},
+		{ID: "5", Attributes: SalesInvoiceAttributes{ItemType: "invoice", IssueDate: "2024-01-01", DueDate: "2024-01-15", Remaining: 40.5}},
+		{ID: "6", Attributes: SalesInvoiceAttributes{ItemType: "estimate", IssueDate: "2023-11-01", Remaining: 70}},
+		{ID: "8", Attributes: SalesInvoiceAttributes{ItemType: "recurring_invoice", IssueDate: "2023-11-01", Remaining: 70}},
+		{ID: "9", Attributes: SalesInvoiceAttributes{ItemType: "invoice", IssueDate: "2023-10-01", Remaining: 30, Currency: "USD"}},
+	}
+
+	result := allocatePayment("7", 180, "TRL", invoices)
+	if result.Allocated != 180 || result.Credit != 0 || len(result.Allocations) != 3 {
+		t.Fatalf("Sonuç = %+v", result)
+	}
+	want := []PaymentAllocation{
+		{InvoiceID: "1", IssueDate: "2024-01-01", Amount: 100, Remaining: 0},
+		{InvoiceID: "5", IssueDate: "2024-01-01", Amount: 40.5, Remaining: 0},
+		{InvoiceID: "3", IssueDate: "2024-03-01", Amount: 39.5, Remaining: 60.5},
+	}
+	for i, w := range want {
+		if result.Allocations[i] != w {
+			t.Errorf("%d. dağıtım = %+v, beklenen %+v", i, result.Allocations[i], w)
+		}
+	}
+
+	// Açık faturaları aşan tutar müşteri lehine kalır
+	result = allocatePayment("7", 300, "TRL", invoices)
+	if result.Allocated != 240.5 || result.Credit != 59.5 {
+		t.Errorf("Fazla ödeme sonucu = %+v", result)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0] != "9" {
+		t.Errorf("Atlanan faturalar = %v", result.Skipped)
+	}
+
+	// Dövizli tahsilat yalnızca aynı dövizdeki faturaya düşer
+	result = allocatePayment("7", 50, "USD", invoices)
+	if len(result.Allocations) != 1 || result.Allocations[0].InvoiceID != "9" || result.Credit != 20 || len(result.Skipped) != 3 {
+		t.Errorf("USD sonucu = %+v", result)
+	}
+}
+
+func TestSalesInvoicesService_AllocatePayment(t *testing.T) {
+	var payments []map[string]interface{}
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			if r.URL.Query().Get("filter[contact_id]") != "7" || r.URL.Query().Get("filter[item_type]") != "invoice" {
+				t.Errorf("Filtre = %v", r.URL.Query())
+			}
+			w.Write([]byte(`{"data": [
+				{"id": "1", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-01-01", "remaining": 100}},
+				{"id": "2", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-02-01", "remaining": 100}}
+			], "meta": {"current_page": 1, "total_pages": 1, "total_count": 2}}`))
+			return
+		}
+
+		if r.URL.Path == "/v4/123/sales_invoices/2/payments" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"errors": [{"title": "Geçersiz tutar"}]}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var payload struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		json.Unmarshal(body, &payload)
+		payments = append(payments, payload.Data.Attributes)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"id": "50", "type": "payments", "attributes": {"amount": 100}}}`))
+	})
+
+	result, err := client.SalesInvoices.AllocatePayment(context.Background(), "7", PaymentInput{
+		AccountID: "9", Date: "2024-05-01", Amount: 150, ExchangeRate: 1,
+	})
+	if err == nil || !strings.Contains(err.Error(), "2 numaralı fatura") {
+		t.Fatalf("Hata = %v", err)
+	}
+	if len(result.Allocations) != 1 || result.Allocations[0].Payment == nil || result.Allocated != 100 || result.Credit != 50 {
+		t.Errorf("Sonuç = %+v", result)
+	}
+	if len(payments) != 1 || payments[0]["account_id"] != "9" || payments[0]["amount"] != 100.0 || payments[0]["exchange_rate"] != 1.0 {
+		t.Errorf("Gönderilen ödemeler = %v", payments)
+	}
+}
+
+func TestSalesInvoicesService_AllocatePaymentFillsRate(t *testing.T) {
+	var rates []interface{}
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/accounts/9":
+			w.Write([]byte(`{"data": {"id": "9", "type": "accounts", "attributes": {"currency": "TRL"}}}`))
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"data": [
+				{"id": "1", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-01-01", "remaining": 100, "currency": "USD"}}
+			], "meta": {"current_page": 1, "total_pages": 1, "total_count": 1}}`))
+		default:
+			body, _ := io.ReadAll(r.Body)
+			var payload struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			json.Unmarshal(body, &payload)
+			rates = append(rates, payload.Data.Attributes["exchange_rate"])
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "50", "type": "payments", "attributes": {"amount": 100}}}`))
+		}
+	})
+	client.exchangeRates = StaticRates{"USD": {"2024-05-01": 32.1}}
+
+	result, err := client.SalesInvoices.AllocatePayment(context.Background(), "7", PaymentInput{
+		AccountID: "9", Date: "2024-05-01", Amount: 100, Currency: "USD",
+	})
+	if err != nil {
+		t.Fatalf("AllocatePayment hata döndü: %v", err)
+	}
+	if len(result.Allocations) != 1 || len(rates) != 1 || rates[0] != 32.1 {
+		t.Errorf("Sonuç = %+v, kurlar = %v", result, rates)
+	}
+}
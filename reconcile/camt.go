@@ -0,0 +1,170 @@
+package reconcile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/importer"
+)
+
+// camtDocument ISO 20022 camt.053 belgesinin kullanılan kısmı. Ad alanları
+// (camt.053.001.02 … .08) yok sayılır.
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	IBAN     string      `xml:"Acct>Id>IBAN"`
+	Currency string      `xml:"Acct>Ccy"`
+	Entries  []camtEntry `xml:"Ntry"`
+}
+
+type camtEntry struct {
+	Reference        string            `xml:"NtryRef"`
+	ServicerRef      string            `xml:"AcctSvcrRef"`
+	Amount           camtAmount        `xml:"Amt"`
+	Indicator        string            `xml:"CdtDbtInd"`
+	BookingDate      camtDate          `xml:"BookgDt"`
+	ValueDate        camtDate          `xml:"ValDt"`
+	AdditionalInfo   string            `xml:"AddtlNtryInf"`
+	TransactionInfos []camtTransaction `xml:"NtryDtls>TxDtls"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtTransaction struct {
+	EndToEndID   string   `xml:"Refs>EndToEndId"`
+	DebtorName   []string `xml:"RltdPties>Dbtr>Nm"`
+	DebtorPty    []string `xml:"RltdPties>Dbtr>Pty>Nm"` // camt.053.001.08
+	DebtorIBAN   string   `xml:"RltdPties>DbtrAcct>Id>IBAN"`
+	CreditorName []string `xml:"RltdPties>Cdtr>Nm"`
+	CreditorPty  []string `xml:"RltdPties>Cdtr>Pty>Nm"`
+	CreditorIBAN string   `xml:"RltdPties>CdtrAcct>Id>IBAN"`
+	Unstructured []string `xml:"RmtInf>Ustrd"`
+	Structured   []string `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	Additional   string   `xml:"AddtlTxInf"`
+}
+
+// ParseCAMT053 ISO 20022 camt.053 (BkToCstmrStmt) ekstresini okur. Birden
+// fazla Stmt varsa hareketleri tek ekstrede birleştirilir. Birden fazla
+// TxDtls içeren toplu kayıtlarda karşı taraf ilk işlemden alınır.
+func ParseCAMT053(r io.Reader) (*Statement, error) {
+	var document camtDocument
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("camt.053 okunamadı: %w", err)
+	}
+	if len(document.Statements) == 0 {
+		return nil, fmt.Errorf("camt.053 belgesinde ekstre (Stmt) bulunamadı")
+	}
+
+	statement := &Statement{}
+	for _, stmt := range document.Statements {
+		if statement.IBAN == "" {
+			statement.IBAN = normalizeIBAN(stmt.IBAN)
+			statement.Currency = importer.NormalizeCurrency(stmt.Currency)
+		}
+		for _, entry := range stmt.Entries {
+			line, err := entry.line()
+			if err != nil {
+				return nil, err
+			}
+			if line.Currency == "" {
+				line.Currency = importer.NormalizeCurrency(stmt.Currency)
+			}
+			statement.Lines = append(statement.Lines, line)
+		}
+	}
+	if statement.Currency == "" && len(statement.Lines) > 0 {
+		statement.Currency = statement.Lines[0].Currency
+	}
+	return statement, nil
+}
+
+// line kaydı hareket satırına çevirir
+func (e camtEntry) line() (Line, error) {
+	amount, err := parseAmount(e.Amount.Value)
+	if err != nil {
+		return Line{}, fmt.Errorf("camt.053 kaydı %s: %w", e.Reference, err)
+	}
+	incoming := strings.EqualFold(e.Indicator, "CRDT")
+	if !incoming {
+		amount = -amount
+	}
+
+	date, err := e.BookingDate.time()
+	if err != nil {
+		if date, err = e.ValueDate.time(); err != nil {
+			return Line{}, fmt.Errorf("camt.053 kaydı %s: tarih bulunamadı", e.Reference)
+		}
+	}
+
+	line := Line{
+		ID:          first(e.ServicerRef, e.Reference),
+		Date:        date,
+		Amount:      parasut.RoundAmount(amount),
+		Currency:    importer.NormalizeCurrency(e.Amount.Currency),
+		Description: strings.TrimSpace(e.AdditionalInfo),
+		Reference:   e.Reference,
+	}
+
+	if len(e.TransactionInfos) > 0 {
+		tx := e.TransactionInfos[0]
+		if incoming {
+			line.CounterpartyName = first(append(tx.DebtorName, tx.DebtorPty...)...)
+			line.CounterpartyIBAN = normalizeIBAN(tx.DebtorIBAN)
+		} else {
+			line.CounterpartyName = first(append(tx.CreditorName, tx.CreditorPty...)...)
+			line.CounterpartyIBAN = normalizeIBAN(tx.CreditorIBAN)
+		}
+		if reference := first(append(tx.Structured, tx.EndToEndID)...); reference != "" && reference != "NOTPROVIDED" {
+			line.Reference = reference
+		}
+		var remittance []string
+		for _, text := range append(tx.Unstructured, tx.Additional) {
+			if text = strings.TrimSpace(text); text != "" {
+				remittance = append(remittance, text)
+			}
+		}
+		if len(remittance) > 0 {
+			line.Description = strings.Join(remittance, " ")
+		}
+	}
+	return line, nil
+}
+
+// time tarihi Dt veya DtTm alanından okur
+func (d camtDate) time() (time.Time, error) {
+	if d.Date != "" {
+		return time.Parse("2006-01-02", strings.TrimSpace(d.Date))
+	}
+	if d.DateTime != "" {
+		value := strings.TrimSpace(d.DateTime)
+		if date, err := time.Parse(time.RFC3339, value); err == nil {
+			return date, nil
+		}
+		return time.Parse("2006-01-02T15:04:05", value)
+	}
+	return time.Time{}, fmt.Errorf("tarih yok")
+}
+
+// first boş olmayan ilk değeri döndürür
+func first(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package reconcile
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleCAMT053 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Id>STMT1</Id>
+      <Acct><Id><IBAN>TR33 0006 1005 1978 6457 8413 26</IBAN></Id><Ccy>TRY</Ccy></Acct>
+      <Ntry>
+        <NtryRef>N1</NtryRef>
+        <Amt Ccy="TRY">1500.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <BookgDt><Dt>2024-05-02</Dt></BookgDt>
+        <AcctSvcrRef>BNK001</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>E2E-1</EndToEndId></Refs>
+          <RltdPties>
+            <Dbtr><Nm>Acme Ltd. Şti.</Nm></Dbtr>
+            <DbtrAcct><Id><IBAN>TR120001000000000000000001</IBAN></Id></DbtrAcct>
+            <Cdtr><Nm>Biz</Nm></Cdtr>
+          </RltdPties>
+          <RmtInf><Ustrd>Fatura A123</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <NtryRef>N2</NtryRef>
+        <Amt Ccy="TRY">2000.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <BookgDt><DtTm>2024-05-03T10:00:00+03:00</DtTm></BookgDt>
+        <AddtlNtryInf>Kira</AddtlNtryInf>
+        <NtryDtls><TxDtls>
+          <RltdPties>
+            <Cdtr><Pty><Nm>Ev Sahibi</Nm></Pty></Cdtr>
+            <CdtrAcct><Id><IBAN>TR990001000000000000000002</IBAN></Id></CdtrAcct>
+          </RltdPties>
+        </TxDtls></NtryDtls>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+func TestParseCAMT053(t *testing.T) {
+	statement, err := ParseCAMT053(strings.NewReader(sampleCAMT053))
+	if err != nil {
+		t.Fatalf("ParseCAMT053 hata döndü: %v", err)
+	}
+	if statement.IBAN != "TR330006100519786457841326" || statement.Currency != "TRL" || len(statement.Lines) != 2 {
+		t.Fatalf("Ekstre = %+v", statement)
+	}
+
+	first := statement.Lines[0]
+	want := Line{
+		ID:               "BNK001",
+		Date:             time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+		Amount:           1500,
+		Currency:         "TRL",
+		Description:      "Fatura A123",
+		Reference:        "E2E-1",
+		CounterpartyName: "Acme Ltd. Şti.",
+		CounterpartyIBAN: "TR120001000000000000000001",
+	}
+	if first != want {
+		t.Errorf("İlk satır = %+v\nbeklenen %+v", first, want)
+	}
+
+	second := statement.Lines[1]
+	if second.Amount != -2000 || second.CounterpartyName != "Ev Sahibi" || second.CounterpartyIBAN != "TR990001000000000000000002" || second.Description != "Kira" || second.ID != "N2" {
+		t.Errorf("İkinci satır = %+v", second)
+	}
+	if second.Date.Format("2006-01-02") != "2024-05-03" {
+		t.Errorf("İkinci satır tarihi = %v", second.Date)
+	}
+}
+
+func TestParseCAMT053_Errors(t *testing.T) {
+	if _, err := ParseCAMT053(strings.NewReader("<Document></Document>")); err == nil {
+		t.Error("Ekstresiz belge için hata bekleniyordu")
+	}
+	if _, err := ParseCAMT053(strings.NewReader("<Document>")); err == nil {
+		t.Error("Bozuk XML için hata bekleniyordu")
+	}
+}
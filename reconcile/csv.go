@@ -0,0 +1,160 @@
+package reconcile
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/importer"
+)
+
+// Columns CSV/XLSX ekstresindeki sütun adları. Adlar büyük/küçük harf
+// duyarsız eşleştirilir; boş bırakılan sütunlar okunmaz. Amount sütunu
+// yoksa tutar Credit - Debit olarak hesaplanır.
+type Columns struct {
+	Date             string
+	Amount           string
+	Credit           string // hesaba giren tutar (alacak)
+	Debit            string // hesaptan çıkan tutar (borç)
+	Currency         string
+	Description      string
+	Reference        string
+	CounterpartyName string
+	CounterpartyIBAN string
+
+	// DateLayouts tarih biçimleri; boşsa DefaultDateLayouts kullanılır
+	DateLayouts []string
+}
+
+// DefaultColumns Türk bankalarının ekstrelerinde yaygın sütun adları
+var DefaultColumns = Columns{
+	Date:             "Tarih",
+	Amount:           "Tutar",
+	Credit:           "Alacak",
+	Debit:            "Borç",
+	Currency:         "Döviz",
+	Description:      "Açıklama",
+	Reference:        "Referans",
+	CounterpartyName: "Karşı Taraf",
+	CounterpartyIBAN: "Karşı IBAN",
+}
+
+// DefaultDateLayouts ekstre tarihleri için denenen biçimler
+var DefaultDateLayouts = []string{
+	"02.01.2006",
+	"02.01.2006 15:04",
+	"02.01.2006 15:04:05",
+	"2006-01-02",
+	"2006-01-02T15:04:05",
+	"02/01/2006",
+	"02-01-2006",
+}
+
+// ParseCSV virgül veya noktalı virgülle ayrılmış ekstreyi okur
+func ParseCSV(r io.Reader, columns Columns) (*Statement, error) {
+	sheet, err := importer.ReadCSV(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSheet(sheet, columns)
+}
+
+// ParseSheet CSV veya XLSX'ten okunmuş tabloyu ekstreye çevirir
+func ParseSheet(sheet *importer.Sheet, columns Columns) (*Statement, error) {
+	get := func(row importer.Row, column string) string {
+		if column == "" {
+			return ""
+		}
+		for _, name := range sheet.Header {
+			if strings.EqualFold(name, column) {
+				return sheet.Get(row, name)
+			}
+		}
+		return ""
+	}
+	has := func(column string) bool {
+		for _, name := range sheet.Header {
+			if column != "" && strings.EqualFold(name, column) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !has(columns.Date) {
+		return nil, fmt.Errorf("tarih sütunu bulunamadı: %q", columns.Date)
+	}
+	if !has(columns.Amount) && !has(columns.Credit) && !has(columns.Debit) {
+		return nil, fmt.Errorf("tutar sütunu bulunamadı: %q veya %q/%q", columns.Amount, columns.Credit, columns.Debit)
+	}
+
+	layouts := columns.DateLayouts
+	if len(layouts) == 0 {
+		layouts = DefaultDateLayouts
+	}
+
+	statement := &Statement{}
+	for _, row := range sheet.Rows {
+		date, err := parseTime(get(row, columns.Date), layouts)
+		if err != nil {
+			return nil, fmt.Errorf("satır %d: %w", row.Line, err)
+		}
+
+		var amount float64
+		if raw := get(row, columns.Amount); raw != "" {
+			if amount, err = parseAmount(raw); err != nil {
+				return nil, fmt.Errorf("satır %d: %w", row.Line, err)
+			}
+		} else {
+			for _, column := range []string{columns.Credit, columns.Debit} {
+				raw := get(row, column)
+				if raw == "" {
+					continue
+				}
+				value, err := parseAmount(raw)
+				if err != nil {
+					return nil, fmt.Errorf("satır %d: %w", row.Line, err)
+				}
+				if column == columns.Debit {
+					value = -abs(value)
+				}
+				amount += value
+			}
+		}
+
+		line := Line{
+			ID:               get(row, columns.Reference),
+			Date:             date,
+			Amount:           parasut.RoundAmount(amount),
+			Currency:         importer.NormalizeCurrency(get(row, columns.Currency)),
+			Description:      get(row, columns.Description),
+			Reference:        get(row, columns.Reference),
+			CounterpartyName: get(row, columns.CounterpartyName),
+			CounterpartyIBAN: normalizeIBAN(get(row, columns.CounterpartyIBAN)),
+		}
+		if line.ID == "" {
+			line.ID = fmt.Sprintf("satır-%d", row.Line)
+		}
+		statement.Lines = append(statement.Lines, line)
+	}
+	return statement, nil
+}
+
+// parseTime değeri verilen biçimlerden ilk uyanla okur
+func parseTime(raw string, layouts []string) (time.Time, error) {
+	for _, layout := range layouts {
+		if date, err := time.Parse(layout, raw); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("geçersiz tarih: %q", raw)
+}
+
+func abs(amount float64) float64 {
+	if amount < 0 {
+		return -amount
+	}
+	return amount
+}
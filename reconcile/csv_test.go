@@ -0,0 +1,52 @@
+package reconcile
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCSV(t *testing.T) {
+	data := "\ufeffTarih;Açıklama;Alacak;Borç;Karşı Taraf;Karşı IBAN;Referans\n" +
+		"02.05.2024;FATURA A123 ODEMESI;1.500,00;;ACME LTD ŞTİ;TR33 0006 1005 1978 6457 8413 26;REF1\n" +
+		"03.05.2024;Kira;;2.000,00;Ev Sahibi;;\n"
+
+	statement, err := ParseCSV(strings.NewReader(data), DefaultColumns)
+	if err != nil {
+		t.Fatalf("ParseCSV hata döndü: %v", err)
+	}
+	if len(statement.Lines) != 2 {
+		t.Fatalf("Satır sayısı = %d", len(statement.Lines))
+	}
+
+	first := statement.Lines[0]
+	if first.ID != "REF1" || first.Amount != 1500 || !first.Date.Equal(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("İlk satır = %+v", first)
+	}
+	if first.CounterpartyIBAN != "TR330006100519786457841326" || first.CounterpartyName != "ACME LTD ŞTİ" {
+		t.Errorf("Karşı taraf = %q %q", first.CounterpartyName, first.CounterpartyIBAN)
+	}
+
+	second := statement.Lines[1]
+	if second.Amount != -2000 || second.ID != "satır-3" {
+		t.Errorf("İkinci satır = %+v", second)
+	}
+}
+
+func TestParseCSV_CustomColumns(t *testing.T) {
+	data := "date,amount,memo\n2024-05-01,-49.90,Kart\n"
+	statement, err := ParseCSV(strings.NewReader(data), Columns{Date: "Date", Amount: "Amount", Description: "Memo"})
+	if err != nil {
+		t.Fatalf("ParseCSV hata döndü: %v", err)
+	}
+	if line := statement.Lines[0]; line.Amount != -49.9 || line.Description != "Kart" {
+		t.Errorf("Satır = %+v", line)
+	}
+
+	if _, err := ParseCSV(strings.NewReader(data), DefaultColumns); err == nil {
+		t.Error("Eksik sütun için hata bekleniyordu")
+	}
+	if _, err := ParseCSV(strings.NewReader("Tarih;Tutar\n2024/13/45;1\n"), DefaultColumns); err == nil || !strings.Contains(err.Error(), "satır 2") {
+		t.Errorf("Geçersiz tarih hatası = %v", err)
+	}
+}
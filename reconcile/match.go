@@ -0,0 +1,225 @@
+package reconcile
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// Kind eşleşme adayının türü
+type Kind string
+
+const (
+	KindSalesInvoice Kind = "sales_invoice"
+	KindPurchaseBill Kind = "purchase_bill"
+	KindSalary       Kind = "salary"
+	KindTax          Kind = "tax"
+)
+
+// Candidate ödemesi beklenen açık kayıt. Satış faturaları hesaba giren,
+// diğerleri hesaptan çıkan hareketlerle eşleşir.
+type Candidate struct {
+	Kind        Kind      `json:"kind"`
+	ID          string    `json:"id"`
+	Date        time.Time `json:"date"`
+	DueDate     time.Time `json:"due_date,omitempty"`
+	Remaining   float64   `json:"remaining"`
+	Currency    string    `json:"currency"`
+	ContactName string    `json:"contact_name,omitempty"`
+	IBANs       []string  `json:"ibans,omitempty"`
+	// DocumentNo fatura seri ve numarası (ör. "A123")
+	DocumentNo  string `json:"document_no,omitempty"`
+	Description string `json:"description,omitempty"`
+	// ExchangeRate kaydın TRL kuru; dövizli kayıt TRL hesaptan ödenirken kullanılır
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+}
+
+// Weights eşleşme puanını oluşturan ölçütlerin ağırlıkları. Toplam puan
+// 1 ile sınırlandığından ağırlıkların toplamı 1'i aşabilir.
+type Weights struct {
+	Amount     float64
+	Date       float64
+	IBAN       float64
+	Name       float64
+	DocumentNo float64
+}
+
+// DefaultWeights varsayılan ağırlıklar: tutar ve fatura numarası tek başına
+// güçlü, IBAN ve isim destekleyici kanıttır.
+var DefaultWeights = Weights{Amount: 0.4, Date: 0.15, IBAN: 0.25, Name: 0.15, DocumentNo: 0.3}
+
+// Match bir ekstre satırı ile aday kayıt arasındaki eşleşme
+type Match struct {
+	Candidate Candidate `json:"candidate"`
+	Score     float64   `json:"score"`
+	// Amount kayda girilecek, kaydın dövizindeki ödeme tutarı: satır tutarının
+	// karşılığı ile kalan tutarın küçüğü
+	Amount float64 `json:"amount"`
+	// ExchangeRate ödemede kullanılacak kayıt-hesap kuru
+	ExchangeRate float64  `json:"exchange_rate,omitempty"`
+	Reasons      []string `json:"reasons,omitempty"`
+}
+
+// Score satırın adayla eşleşme puanını 0-1 arasında hesaplar. Satır TRL ve
+// aday dövizliyse tutarlar adayın kuruyla karşılaştırılır. Yön uyuşmuyorsa,
+// kur bilinmiyorsa ya da aday kapanmışsa false döner. window tarih
+// yakınlığının sıfırlandığı gün sayısıdır.
+func Score(line Line, candidate Candidate, weights Weights, window int) (Match, bool) {
+	incoming := candidate.Kind == KindSalesInvoice
+	if line.Amount == 0 || (line.Amount > 0) != incoming || candidate.Remaining <= 0 {
+		return Match{}, false
+	}
+	rate := 1.0
+	if line.Currency != "" {
+		rate = paymentRate(candidate, line.Currency)
+	}
+	if rate <= 0 {
+		return Match{}, false
+	}
+	if window <= 0 {
+		window = 30
+	}
+
+	// amount satır tutarının kaydın dövizindeki karşılığı
+	amount := abs(line.Amount) / rate
+	match := Match{Candidate: candidate, Amount: parasut.RoundAmount(math.Min(amount, candidate.Remaining)), ExchangeRate: rate}
+	add := func(weight, ratio float64, reason string) {
+		if weight <= 0 || ratio <= 0 {
+			return
+		}
+		match.Score += weight * ratio
+		match.Reasons = append(match.Reasons, reason)
+	}
+
+	switch {
+	case math.Abs(amount-candidate.Remaining) < 0.005:
+		add(weights.Amount, 1, "tutar")
+	case amount < candidate.Remaining:
+		add(weights.Amount, 0.5, "kısmi tutar")
+	default:
+		add(weights.Amount, 0.25, "fazla tutar")
+	}
+
+	days := math.Inf(1)
+	for _, date := range []time.Time{candidate.Date, candidate.DueDate} {
+		if !date.IsZero() {
+			days = math.Min(days, math.Abs(line.Date.Sub(date).Hours()/24))
+		}
+	}
+	add(weights.Date, 1-days/float64(window), "tarih")
+
+	if line.CounterpartyIBAN != "" {
+		for _, iban := range candidate.IBANs {
+			if normalizeIBAN(iban) == line.CounterpartyIBAN {
+				add(weights.IBAN, 1, "IBAN")
+				break
+			}
+		}
+	}
+
+	add(weights.Name, nameSimilarity(candidate.ContactName, line.CounterpartyName+" "+line.Description), "isim")
+
+	if containsDocumentNo(line.Reference+" "+line.Description, candidate.DocumentNo) {
+		add(weights.DocumentNo, 1, "fatura numarası")
+	}
+
+	match.Score = parasut.RoundAmount(math.Min(match.Score, 1))
+	return match, match.Score > 0
+}
+
+// paymentRate kaydın accountCurrency dövizindeki hesaptan ödenmesinde
+// kullanılacak kuru döndürür: aynı dövizde 1, TRL hesapta kaydın kuru.
+// Kur bilinmiyorsa 0 döner.
+func paymentRate(candidate Candidate, accountCurrency string) float64 {
+	if candidate.Currency == "" || candidate.Currency == accountCurrency {
+		return 1
+	}
+	if accountCurrency == "TRL" {
+		return candidate.ExchangeRate
+	}
+	return 0
+}
+
+// Rank satırın adaylarla eşleşmelerini puana göre azalan sırada döndürür
+func Rank(line Line, candidates []Candidate, weights Weights, window int) []Match {
+	var matches []Match
+	for _, candidate := range candidates {
+		if match, ok := Score(line, candidate, weights, window); ok {
+			matches = append(matches, match)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
+
+// stopWords isim karşılaştırmasında yok sayılan şirket unvanı ekleri
+var stopWords = map[string]bool{
+	"ltd": true, "sti": true, "as": true, "a": true, "s": true, "tic": true, "san": true,
+	"ve": true, "limited": true, "sirketi": true, "anonim": true, "ticaret": true, "sanayi": true,
+}
+
+// nameTokens ismi Türkçe karakterlerden arındırılmış küçük harf kelimelere böler
+func nameTokens(name string) []string {
+	replacer := strings.NewReplacer("ı", "i", "ş", "s", "ğ", "g", "ü", "u", "ö", "o", "ç", "c")
+	name = replacer.Replace(strings.ToLowerSpecial(unicode.TurkishCase, name))
+	var tokens []string
+	for _, token := range strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		if !stopWords[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// nameSimilarity aday isminin kelimelerinden metinde geçenlerin oranı
+func nameSimilarity(name, text string) float64 {
+	tokens := nameTokens(name)
+	if len(tokens) == 0 {
+		return 0
+	}
+	present := map[string]bool{}
+	for _, token := range nameTokens(text) {
+		present[token] = true
+	}
+	found := 0
+	for _, token := range tokens {
+		if present[token] {
+			found++
+		}
+	}
+	return float64(found) / float64(len(tokens))
+}
+
+// containsDocumentNo fatura numarasının metinde boşluk ve noktalama
+// işaretlerinden bağımsız olarak geçip geçmediğini kontrol eder
+func containsDocumentNo(text, documentNo string) bool {
+	compact := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToUpper(r)
+			}
+			return -1
+		}, s)
+	}
+	documentNo, text = compact(documentNo), compact(text)
+	if len(documentNo) < 3 {
+		return false
+	}
+	// "A12" numarası "A123" içinde bulunmuş sayılmaz
+	isDigit := func(s string, i int) bool { return i >= 0 && i < len(s) && s[i] >= '0' && s[i] <= '9' }
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], documentNo)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(documentNo)
+		if !(isDigit(text, end) && isDigit(documentNo, len(documentNo)-1)) && !(isDigit(text, start-1) && isDigit(documentNo, 0)) {
+			return true
+		}
+		offset = start + 1
+	}
+}
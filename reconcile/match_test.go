@@ -0,0 +1,83 @@
+package reconcile
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScore(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC) }
+	invoice := Candidate{
+		Kind:        KindSalesInvoice,
+		ID:          "1",
+		Date:        day(1),
+		DueDate:     day(15),
+		Remaining:   1500,
+		Currency:    "TRL",
+		ContactName: "Acme Yazılım Ltd. Şti.",
+		IBANs:       []string{"TR12 0001 0000 0000 0000 0000 01"},
+		DocumentNo:  "A123",
+	}
+
+	full := Line{Date: day(2), Amount: 1500, Currency: "TRL", Description: "ACME YAZILIM FATURA A-123", CounterpartyIBAN: "TR120001000000000000000001"}
+	match, ok := Score(full, invoice, DefaultWeights, 30)
+	if !ok || match.Score != 1 || match.Amount != 1500 {
+		t.Errorf("Tam eşleşme = %+v", match)
+	}
+	if len(match.Reasons) != 5 {
+		t.Errorf("Gerekçeler = %v", match.Reasons)
+	}
+
+	partial := Line{Date: day(20), Amount: 500, Currency: "TRL", CounterpartyName: "ACME"}
+	match, ok = Score(partial, invoice, DefaultWeights, 30)
+	if !ok || match.Amount != 500 {
+		t.Fatalf("Kısmi eşleşme = %+v", match)
+	}
+	// 0.4*0.5 tutar + 0.15*(1-5/30) tarih + 0.15*0.5 isim
+	if match.Score != 0.4 {
+		t.Errorf("Kısmi puan = %v", match.Score)
+	}
+
+	if _, ok := Score(Line{Date: day(2), Amount: -1500, Currency: "TRL"}, invoice, DefaultWeights, 30); ok {
+		t.Error("Giden hareket satış faturasıyla eşleşmemeli")
+	}
+	if _, ok := Score(Line{Date: day(2), Amount: 1500, Currency: "USD"}, invoice, DefaultWeights, 30); ok {
+		t.Error("Farklı döviz eşleşmemeli")
+	}
+
+	bill := Candidate{Kind: KindPurchaseBill, ID: "2", Date: day(1), Remaining: 200, Currency: "TRL"}
+	if match, ok := Score(Line{Date: day(1), Amount: -300, Currency: "TRL"}, bill, DefaultWeights, 30); !ok || match.Amount != 200 {
+		t.Errorf("Fazla ödeme = %+v", match)
+	}
+}
+
+func TestRank(t *testing.T) {
+	line := Line{Date: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), Amount: 100, Description: "B-77"}
+	candidates := []Candidate{
+		{Kind: KindSalesInvoice, ID: "1", Remaining: 100},
+		{Kind: KindSalesInvoice, ID: "2", Remaining: 100, DocumentNo: "B77"},
+		{Kind: KindTax, ID: "3", Remaining: 100},
+	}
+	matches := Rank(line, candidates, DefaultWeights, 30)
+	if len(matches) != 2 || matches[0].Candidate.ID != "2" {
+		t.Errorf("Sıralama = %+v", matches)
+	}
+}
+
+func TestContainsDocumentNo(t *testing.T) {
+	tests := []struct {
+		text, documentNo string
+		want             bool
+	}{
+		{"Fatura no: a-123 ödemesi", "A123", true},
+		{"A1234 nolu fatura", "A123", false},
+		{"A1234 ve A123", "A123", true},
+		{"ref 5123", "123", false},
+		{"12", "12", false},
+	}
+	for _, tt := range tests {
+		if got := containsDocumentNo(tt.text, tt.documentNo); got != tt.want {
+			t.Errorf("containsDocumentNo(%q, %q) = %v", tt.text, tt.documentNo, got)
+		}
+	}
+}
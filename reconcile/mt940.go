@@ -0,0 +1,152 @@
+package reconcile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/importer"
+)
+
+// ibanPattern serbest metin içindeki IBAN'ları bulur
+var ibanPattern = regexp.MustCompile(`\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]){11,30}\b`)
+
+// mt940Line :61: alanı: değer tarihi (YYMMDD), isteğe bağlı kayıt tarihi
+// (MMDD), C/D/RC/RD işareti, isteğe bağlı fon kodu, tutar, işlem türü ve
+// müşteri//banka referansları
+var mt940Line = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+(?:,\d*)?)([A-Z][A-Z0-9]{3})?([^/]*)(?://(.*))?`)
+
+// ParseMT940 SWIFT MT940 ekstresini okur. Dosyada birden fazla ekstre varsa
+// hareketleri tek ekstrede birleştirilir. :86: alanı hareketin açıklaması
+// olarak alınır; içindeki ilk yabancı IBAN karşı taraf IBAN'ı sayılır.
+func ParseMT940(r io.Reader) (*Statement, error) {
+	var (
+		statement = &Statement{}
+		tag       string
+		value     strings.Builder
+		tagLine   int
+		lineNo    int
+	)
+
+	flush := func() error {
+		defer value.Reset()
+		text := strings.TrimSpace(value.String())
+		switch tag {
+		case "25":
+			account := text
+			if i := strings.LastIndex(account, "/"); i >= 0 {
+				account = account[i+1:]
+			}
+			if statement.IBAN == "" {
+				statement.IBAN = normalizeIBAN(account)
+			}
+		case "60F", "60M":
+			if len(text) >= 10 && statement.Currency == "" {
+				statement.Currency = importer.NormalizeCurrency(text[7:10])
+			}
+		case "61":
+			line, err := parseMT940Line(text)
+			if err != nil {
+				return fmt.Errorf("satır %d: %w", tagLine, err)
+			}
+			line.Currency = statement.Currency
+			statement.Lines = append(statement.Lines, line)
+		case "86":
+			if len(statement.Lines) == 0 {
+				return nil
+			}
+			line := &statement.Lines[len(statement.Lines)-1]
+			line.Description = strings.Join(strings.Fields(text), " ")
+			for _, iban := range ibanPattern.FindAllString(strings.ToUpper(text), -1) {
+				if iban = normalizeIBAN(iban); iban != statement.IBAN {
+					line.CounterpartyIBAN = iban
+					break
+				}
+			}
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		end := -1
+		if strings.HasPrefix(text, ":") {
+			end = strings.Index(text[1:], ":")
+		}
+		switch {
+		case end > 0:
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			tag, tagLine = text[1:end+1], lineNo
+			text = text[end+2:]
+		case text == "-" || text == "-}" || strings.HasPrefix(text, "{"):
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			tag = ""
+			continue
+		default:
+			value.WriteString("\n")
+		}
+		value.WriteString(text)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if len(statement.Lines) == 0 {
+		return nil, fmt.Errorf("MT940 ekstresinde hareket (:61:) bulunamadı")
+	}
+	for i := range statement.Lines {
+		if statement.Lines[i].Currency == "" {
+			statement.Lines[i].Currency = statement.Currency
+		}
+	}
+	return statement, nil
+}
+
+// parseMT940Line :61: alanını hareket satırına çevirir
+func parseMT940Line(text string) (Line, error) {
+	first, rest, _ := strings.Cut(text, "\n")
+	match := mt940Line.FindStringSubmatch(first)
+	if match == nil {
+		return Line{}, fmt.Errorf("geçersiz :61: alanı: %q", first)
+	}
+
+	date, err := time.Parse("060102", match[1])
+	if err != nil {
+		return Line{}, fmt.Errorf("geçersiz :61: tarihi: %q", match[1])
+	}
+	amount, err := parseAmount(match[5])
+	if err != nil {
+		return Line{}, err
+	}
+	// RC (alacak iptali) hesaptan çıkış, RD (borç iptali) hesaba giriştir
+	if match[3] == "D" || match[3] == "RC" {
+		amount = -amount
+	}
+
+	line := Line{
+		Date:        date,
+		Amount:      parasut.RoundAmount(amount),
+		Reference:   strings.TrimSpace(match[7]),
+		ID:          strings.TrimSpace(match[8]),
+		Description: strings.TrimSpace(rest),
+	}
+	if line.Reference == "NONREF" {
+		line.Reference = ""
+	}
+	if line.ID == "" {
+		line.ID = line.Reference
+	}
+	return line, nil
+}
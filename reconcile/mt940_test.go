@@ -0,0 +1,63 @@
+package reconcile
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleMT940 = `{1:F01TGBATRISAXXX0000000000}{2:I940TGBATRISXXXXN}{4:
+:20:STMT240502
+:25:TGBATRIS/TR330006100519786457841326
+:28C:00042/001
+:60F:C240501TRY10000,00
+:61:2405020502C1500,00NTRFA123ODEME//BNK001
+:86:ACME LTD STI FATURA A123 ODEMESI
+TR120001000000000000000001
+:61:240503D2000,00NMSCNONREF//BNK002
+:86:KIRA MAYIS
+:61:240504RD50,00NCHGNONREF
+:62F:C240504TRY9550,00
+-}
+`
+
+func TestParseMT940(t *testing.T) {
+	statement, err := ParseMT940(strings.NewReader(sampleMT940))
+	if err != nil {
+		t.Fatalf("ParseMT940 hata döndü: %v", err)
+	}
+	if statement.IBAN != "TR330006100519786457841326" || statement.Currency != "TRL" {
+		t.Errorf("Ekstre = %q %q", statement.IBAN, statement.Currency)
+	}
+	if len(statement.Lines) != 3 {
+		t.Fatalf("Satır sayısı = %d", len(statement.Lines))
+	}
+
+	first := statement.Lines[0]
+	if first.ID != "BNK001" || first.Reference != "A123ODEME" || first.Amount != 1500 || first.Currency != "TRL" {
+		t.Errorf("İlk satır = %+v", first)
+	}
+	if !first.Date.Equal(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Tarih = %v", first.Date)
+	}
+	if first.Description != "ACME LTD STI FATURA A123 ODEMESI TR120001000000000000000001" || first.CounterpartyIBAN != "TR120001000000000000000001" {
+		t.Errorf("Açıklama = %q, IBAN = %q", first.Description, first.CounterpartyIBAN)
+	}
+
+	if second := statement.Lines[1]; second.Amount != -2000 || second.Reference != "" || second.Description != "KIRA MAYIS" {
+		t.Errorf("İkinci satır = %+v", second)
+	}
+	// RD borç iptalidir, hesaba giriş sayılır
+	if third := statement.Lines[2]; third.Amount != 50 {
+		t.Errorf("Üçüncü satır = %+v", third)
+	}
+}
+
+func TestParseMT940_Errors(t *testing.T) {
+	if _, err := ParseMT940(strings.NewReader(":20:X\n:25:TR1\n")); err == nil {
+		t.Error("Hareketsiz ekstre için hata bekleniyordu")
+	}
+	if _, err := ParseMT940(strings.NewReader(":20:X\n:61:GECERSIZ\n")); err == nil || !strings.Contains(err.Error(), "satır 2") {
+		t.Errorf("Geçersiz :61: hatası = %v", err)
+	}
+}
@@ -0,0 +1,387 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/importer"
+)
+
+// Options mutabakat ayarları
+type Options struct {
+	// MinScore önerilecek eşleşmenin en düşük puanı; 0 ise 0.6
+	MinScore float64
+	// DateWindow tarih yakınlığı puanının sıfırlandığı gün sayısı; 0 ise 30
+	DateWindow int
+	// Weights puan ağırlıkları; boşsa DefaultWeights
+	Weights *Weights
+	// Alternatives satır başına raporlanacak diğer aday sayısı; 0 ise 3
+	Alternatives int
+}
+
+// Status ekstre satırının mutabakat durumu
+type Status string
+
+const (
+	// StatusMatched satır MinScore üstünde bir kayıtla eşleşti
+	StatusMatched Status = "matched"
+	// StatusRecorded satır hesapta zaten kayıtlı bir hareketle örtüşüyor
+	StatusRecorded Status = "recorded"
+	// StatusUnmatched satır için yeterli puanda aday bulunamadı
+	StatusUnmatched Status = "unmatched"
+)
+
+// Proposal ekstre satırı için mutabakat önerisi
+type Proposal struct {
+	Line   Line   `json:"line"`
+	Status Status `json:"status"`
+	// Match önerilen eşleşme; yalnızca StatusMatched için dolu
+	Match *Match `json:"match,omitempty"`
+	// Alternatives elle kontrol için diğer olası eşleşmeler
+	Alternatives []Match `json:"alternatives,omitempty"`
+	// TransactionID StatusRecorded satırların örtüştüğü hesap hareketi
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Payment Apply ile girilen ödeme
+	Payment *parasut.Payment `json:"payment,omitempty"`
+}
+
+// Reconciler bir kasa/banka hesabının ekstresini Paraşüt kayıtlarıyla eşleştirir
+type Reconciler struct {
+	client    *parasut.Client
+	accountID string
+	options   Options
+}
+
+// New accountID numaralı hesap için Reconciler oluşturur
+func New(client *parasut.Client, accountID string, options Options) *Reconciler {
+	if options.MinScore <= 0 {
+		options.MinScore = 0.6
+	}
+	if options.DateWindow <= 0 {
+		options.DateWindow = 30
+	}
+	if options.Weights == nil {
+		options.Weights = &DefaultWeights
+	}
+	if options.Alternatives <= 0 {
+		options.Alternatives = 3
+	}
+	return &Reconciler{client: client, accountID: accountID, options: options}
+}
+
+// Propose ekstre satırlarını hesabın mevcut hareketleri ve açık kayıtlarla
+// karşılaştırıp öneriler üretir; Paraşüt'te değişiklik yapmaz. Hesapta aynı
+// tutar ve ±1 gün tarihle kayıtlı hareketi olan satırlar StatusRecorded
+// olur. Bir kaydın kalan tutarı birden fazla satıra dağıtılabilir; puanı
+// yüksek eşleşmeler önce atanır.
+func (r *Reconciler) Propose(ctx context.Context, statement *Statement) ([]Proposal, error) {
+	account, err := r.client.Accounts.Get(ctx, r.accountID)
+	if err != nil {
+		return nil, err
+	}
+	if iban := normalizeIBAN(account.Attributes.IBAN); iban != "" && statement.IBAN != "" && iban != statement.IBAN {
+		return nil, fmt.Errorf("ekstre IBAN'ı (%s) %s hesabının IBAN'ı (%s) ile uyuşmuyor", statement.IBAN, account.Attributes.Name, iban)
+	}
+	currency := importer.NormalizeCurrency(account.Attributes.Currency)
+	if currency == "" {
+		currency = "TRL"
+	}
+
+	filter := parasut.AccountTransactionFilter{}
+	for _, line := range statement.Lines {
+		if filter.From.IsZero() || line.Date.Before(filter.From) {
+			filter.From = line.Date
+		}
+		if line.Date.After(filter.To) {
+			filter.To = line.Date
+		}
+	}
+	if !filter.From.IsZero() {
+		filter.From, filter.To = filter.From.AddDate(0, 0, -1), filter.To.AddDate(0, 0, 1)
+	}
+	transactions, err := r.client.Accounts.ListTransactions(ctx, r.accountID, filter)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := r.Candidates(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+	return propose(statement, currency, transactions, candidates, r.options), nil
+}
+
+// Candidates hesaptan ödenebilecek açık satış faturası, gider faturası, maaş
+// ve vergi kayıtlarını müşteri/tedarikçi ve çalışan isim ve IBAN'larıyla
+// birlikte döndürür: hesabın dövizindeki kayıtlar ve TRL hesapta kuru
+// bilinen dövizli kayıtlar
+func (r *Reconciler) Candidates(ctx context.Context, currency string) ([]Candidate, error) {
+	contacts, err := parasut.ListAll(ctx, r.client.Contacts.List, nil)
+	if err != nil {
+		return nil, err
+	}
+	contactsByID := map[string]parasut.ContactAttributes{}
+	for _, contact := range contacts {
+		contactsByID[contact.ID] = contact.Attributes
+	}
+	employees, err := parasut.ListAll(ctx, r.client.Employees.List, nil)
+	if err != nil {
+		return nil, err
+	}
+	employeesByID := map[string]parasut.EmployeeAttributes{}
+	for _, employee := range employees {
+		employeesByID[employee.ID] = employee.Attributes
+	}
+
+	var candidates []Candidate
+	withContact := func(candidate Candidate, ref *parasut.RelationshipData) Candidate {
+		if ref != nil {
+			contact := contactsByID[ref.ID]
+			candidate.ContactName, candidate.IBANs = contact.Name, contact.IBANs
+		}
+		return candidate
+	}
+
+	invoices, err := parasut.ListAll(ctx, r.client.SalesInvoices.List, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, invoice := range invoices {
+		a := invoice.Attributes
+		if a.Archived || (a.ItemType != "invoice" && a.ItemType != "") {
+			continue
+		}
+		candidate := newCandidate(KindSalesInvoice, invoice.ID, a.IssueDate, a.DueDate, a.Remaining, a.Currency, a.Description)
+		candidate.ExchangeRate = a.ExchangeRate
+		if a.InvoiceID > 0 {
+			candidate.DocumentNo = a.InvoiceSeries + strconv.Itoa(a.InvoiceID)
+		}
+		candidates = append(candidates, withContact(candidate, invoice.Relationships.Contact))
+	}
+
+	bills, err := parasut.ListAll(ctx, r.client.PurchaseBills.List, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, bill := range bills {
+		a := bill.Attributes
+		if a.Archived || a.ItemType == "cancelled" {
+			continue
+		}
+		candidate := newCandidate(KindPurchaseBill, bill.ID, a.IssueDate, a.DueDate, a.Remaining, a.Currency, a.Description)
+		candidate.ExchangeRate = a.ExchangeRate
+		if a.InvoiceID != "" {
+			candidate.DocumentNo = a.InvoiceSeries + a.InvoiceID
+		}
+		candidates = append(candidates, withContact(candidate, bill.Relationships.Supplier))
+	}
+
+	salaries, err := parasut.ListAll(ctx, r.client.Salaries.List, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, salary := range salaries {
+		a := salary.Attributes
+		if a.Archived {
+			continue
+		}
+		candidate := newCandidate(KindSalary, salary.ID, a.Date, a.DueDate, a.Remaining, a.Currency, a.Description)
+		candidate.ExchangeRate = a.ExchangeRate
+		if ref := salary.Relationships.Employee; ref != nil {
+			employee := employeesByID[ref.ID]
+			candidate.ContactName = employee.Name
+			if employee.IBAN != "" {
+				candidate.IBANs = []string{employee.IBAN}
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	taxes, err := parasut.ListAll(ctx, r.client.Taxes.List, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, tax := range taxes {
+		a := tax.Attributes
+		if a.Archived {
+			continue
+		}
+		candidate := newCandidate(KindTax, tax.ID, a.Date, a.DueDate, a.Remaining, a.Currency, a.Description)
+		candidate.ExchangeRate = a.ExchangeRate
+		candidates = append(candidates, candidate)
+	}
+
+	var open []Candidate
+	for _, candidate := range candidates {
+		if candidate.Remaining > 0 && paymentRate(candidate, currency) > 0 {
+			open = append(open, candidate)
+		}
+	}
+	return open, nil
+}
+
+// Apply StatusMatched önerileri için eşleşen kayda, hesaba ve satır
+// tarihine eşleşmenin kuruyla ödeme girer ve Payment alanını doldurur.
+// Kuru bilinmeyen eşleşmede işlem durur. Ödemesi girilmiş
+// öneriler atlanır; böylece hata sonrası Apply aynı önerilerle yeniden
+// çağrılabilir. Bir ödeme başarısız olursa işlem durur ve hata döner.
+func (r *Reconciler) Apply(ctx context.Context, proposals []Proposal) error {
+	for i := range proposals {
+		proposal := &proposals[i]
+		if proposal.Status != StatusMatched || proposal.Match == nil || proposal.Payment != nil {
+			continue
+		}
+
+		candidate := proposal.Match.Candidate
+		if proposal.Match.ExchangeRate <= 0 {
+			return fmt.Errorf("%s satırı için %s %s kaydının ödeme kuru bilinmiyor", proposal.Line.ID, candidate.Kind, candidate.ID)
+		}
+		input := parasut.PaymentInput{
+			AccountID:    r.accountID,
+			Date:         proposal.Line.Date.Format("2006-01-02"),
+			Amount:       proposal.Match.Amount,
+			ExchangeRate: proposal.Match.ExchangeRate,
+			Description:  proposal.Line.Description,
+		}
+
+		var payment *parasut.Payment
+		var err error
+		switch candidate.Kind {
+		case KindSalesInvoice:
+			payment, err = r.client.SalesInvoices.CreatePayment(ctx, candidate.ID, input)
+		case KindPurchaseBill:
+			payment, err = r.client.PurchaseBills.CreatePayment(ctx, candidate.ID, input)
+		case KindSalary:
+			payment, err = r.client.Salaries.CreatePayment(ctx, candidate.ID, input)
+		case KindTax:
+			payment, err = r.client.Taxes.CreatePayment(ctx, candidate.ID, input)
+		default:
+			err = fmt.Errorf("bilinmeyen kayıt türü: %s", candidate.Kind)
+		}
+		if err != nil {
+			return fmt.Errorf("%s satırı için %s %s kaydına ödeme girilemedi: %w", proposal.Line.ID, candidate.Kind, candidate.ID, err)
+		}
+		proposal.Payment = payment
+	}
+	return nil
+}
+
+// propose satırları kayıtlı hareketlerle ve adaylarla eşleştirir
+func propose(statement *Statement, currency string, transactions []parasut.AccountTransaction, candidates []Candidate, options Options) []Proposal {
+	proposals := make([]Proposal, len(statement.Lines))
+	used := map[string]bool{}
+	type scored struct {
+		line  int
+		match Match
+	}
+	var all []scored
+
+	for i, line := range statement.Lines {
+		if line.Currency == "" {
+			line.Currency = statement.Currency
+		}
+		if line.Currency == "" {
+			line.Currency = currency
+		}
+		proposals[i] = Proposal{Line: line, Status: StatusUnmatched}
+
+		if id := recordedTransaction(line, transactions, used); id != "" {
+			used[id] = true
+			proposals[i].Status = StatusRecorded
+			proposals[i].TransactionID = id
+			continue
+		}
+		for _, match := range Rank(line, candidates, *options.Weights, options.DateWindow) {
+			all = append(all, scored{line: i, match: match})
+		}
+	}
+
+	// Yüksek puanlı eşleşmeler önce atanır; kalan tutarı tükenen kayıt
+	// sonraki satırlara önerilmez
+	sort.SliceStable(all, func(i, j int) bool { return all[i].match.Score > all[j].match.Score })
+	remaining := map[string]float64{}
+	for _, candidate := range candidates {
+		remaining[string(candidate.Kind)+"/"+candidate.ID] = candidate.Remaining
+	}
+	for _, s := range all {
+		proposal := &proposals[s.line]
+		if proposal.Match != nil || s.match.Score < options.MinScore {
+			continue
+		}
+		key := string(s.match.Candidate.Kind) + "/" + s.match.Candidate.ID
+		if remaining[key] <= 0 {
+			continue
+		}
+		match := s.match
+		match.Amount = parasut.RoundAmount(math.Min(abs(proposal.Line.Amount)/match.ExchangeRate, remaining[key]))
+		remaining[key] = parasut.RoundAmount(remaining[key] - match.Amount)
+		proposal.Match = &match
+		proposal.Status = StatusMatched
+	}
+
+	for _, s := range all {
+		proposal := &proposals[s.line]
+		if proposal.Match != nil && proposal.Match.Candidate.ID == s.match.Candidate.ID && proposal.Match.Candidate.Kind == s.match.Candidate.Kind {
+			continue
+		}
+		if len(proposal.Alternatives) < options.Alternatives {
+			proposal.Alternatives = append(proposal.Alternatives, s.match)
+		}
+	}
+	return proposals
+}
+
+// recordedTransaction satırla aynı yönde, aynı tutarda ve ±1 gün içinde,
+// daha önce kullanılmamış hesap hareketini döndürür
+func recordedTransaction(line Line, transactions []parasut.AccountTransaction, used map[string]bool) string {
+	for _, transaction := range transactions {
+		if used[transaction.ID] || math.Abs(abs(transaction.Attributes.Amount)-abs(line.Amount)) >= 0.005 {
+			continue
+		}
+		if incoming(transaction) != (line.Amount > 0) {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", transaction.Attributes.Date)
+		if err != nil {
+			continue
+		}
+		if math.Abs(line.Date.Sub(date).Hours()) <= 24 {
+			return transaction.ID
+		}
+	}
+	return ""
+}
+
+// incoming hesap hareketinin hesaba giriş olup olmadığını döndürür. Tahsilat
+// ve elle girilen giriş (account_credit) girişi, ödeme ve elle girilen çıkış
+// (account_debit) çıkışı gösterir; diğer türlerde tutarın işaretine bakılır.
+func incoming(transaction parasut.AccountTransaction) bool {
+	switch transaction.Attributes.TransactionType {
+	case "collection", "account_credit":
+		return true
+	case "payment", "account_debit":
+		return false
+	}
+	return transaction.Attributes.Amount > 0
+}
+
+// newCandidate Paraşüt kaydının alanlarından aday oluşturur
+func newCandidate(kind Kind, id, date, dueDate string, remaining float64, currency, description string) Candidate {
+	candidate := Candidate{
+		Kind:        kind,
+		ID:          id,
+		Remaining:   remaining,
+		Currency:    importer.NormalizeCurrency(currency),
+		Description: description,
+	}
+	if candidate.Currency == "" {
+		candidate.Currency = "TRL"
+	}
+	candidate.Date, _ = time.Parse("2006-01-02", date)
+	candidate.DueDate, _ = time.Parse("2006-01-02", dueDate)
+	return candidate
+}
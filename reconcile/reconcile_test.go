@@ -0,0 +1,206 @@
+package reconcile
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/parasuttest"
+)
+
+func TestReconciler(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	account := server.Seed("accounts", map[string]interface{}{"name": "Banka", "currency": "TRL", "iban": "TR33 0006 1005 1978 6457 8413 26"}, nil)
+	accountRef := parasut.RelationshipData{ID: account, Type: "accounts"}
+	server.Seed("transactions", map[string]interface{}{"date": "2024-05-04", "amount": 75}, map[string]interface{}{"account": accountRef})
+
+	acme := server.Seed("contacts", map[string]interface{}{"name": "Acme Ltd. Şti.", "ibans": []string{"TR120001000000000000000001"}}, nil)
+	supplier := server.Seed("contacts", map[string]interface{}{"name": "Kırtasiye Dünyası"}, nil)
+	employee := server.Seed("employees", map[string]interface{}{"name": "Ayşe Yılmaz", "iban": "TR560001000000000000000003"}, nil)
+
+	invoice := server.Seed("sales_invoices", map[string]interface{}{
+		"item_type": "invoice", "issue_date": "2024-05-01", "invoice_series": "A", "invoice_id": 123,
+		"net_total": 1500, "remaining": 1500, "currency": "TRL",
+	}, map[string]interface{}{"contact": parasut.RelationshipData{ID: acme, Type: "contacts"}})
+	usdInvoice := server.Seed("sales_invoices", map[string]interface{}{
+		"item_type": "invoice", "issue_date": "2024-05-01", "net_total": 100, "remaining": 100, "currency": "USD", "exchange_rate": 32.5,
+	}, map[string]interface{}{"contact": parasut.RelationshipData{ID: acme, Type: "contacts"}})
+	server.Seed("sales_invoices", map[string]interface{}{
+		"item_type": "invoice", "issue_date": "2024-05-01", "net_total": 1500, "remaining": 1500, "currency": "EUR",
+	}, map[string]interface{}{"contact": parasut.RelationshipData{ID: acme, Type: "contacts"}})
+	bill := server.Seed("purchase_bills", map[string]interface{}{
+		"item_type": "purchase_bill", "issue_date": "2024-04-28", "net_total": 320, "remaining": 320,
+	}, map[string]interface{}{"supplier": parasut.RelationshipData{ID: supplier, Type: "contacts"}})
+	salary := server.Seed("salaries", map[string]interface{}{"date": "2024-04-30", "net_total": 25000, "remaining": 25000}, map[string]interface{}{
+		"employee": parasut.RelationshipData{ID: employee, Type: "employees"},
+	})
+
+	day := func(d int) time.Time { return time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC) }
+	statement := &Statement{IBAN: "TR330006100519786457841326", Currency: "TRL", Lines: []Line{
+		{ID: "1", Date: day(2), Amount: 1500, Description: "FATURA A123", CounterpartyName: "ACME LTD", CounterpartyIBAN: "TR120001000000000000000001"},
+		{ID: "2", Date: day(3), Amount: -320, CounterpartyName: "KIRTASIYE DUNYASI"},
+		{ID: "3", Date: day(3), Amount: -25000, Description: "Maaş", CounterpartyIBAN: "TR560001000000000000000003"},
+		{ID: "4", Date: day(5), Amount: 75, Description: "Nakit yatırma"},
+		{ID: "5", Date: day(5), Amount: 999, Description: "Bilinmeyen"},
+		{ID: "6", Date: day(6), Amount: 3250, CounterpartyName: "ACME LTD", CounterpartyIBAN: "TR120001000000000000000001"},
+	}}
+
+	reconciler := New(client, account, Options{})
+	proposals, err := reconciler.Propose(context.Background(), statement)
+	if err != nil {
+		t.Fatalf("Propose hata döndü: %v", err)
+	}
+
+	want := []struct {
+		status Status
+		kind   Kind
+		id     string
+	}{
+		{StatusMatched, KindSalesInvoice, invoice},
+		{StatusMatched, KindPurchaseBill, bill},
+		{StatusMatched, KindSalary, salary},
+		{StatusRecorded, "", ""},
+		{StatusUnmatched, "", ""},
+		{StatusMatched, KindSalesInvoice, usdInvoice},
+	}
+	for i, w := range want {
+		proposal := proposals[i]
+		if proposal.Status != w.status {
+			t.Errorf("Satır %d durumu = %s, beklenen %s (%+v)", i+1, proposal.Status, w.status, proposal.Alternatives)
+			continue
+		}
+		if w.id != "" && (proposal.Match.Candidate.Kind != w.kind || proposal.Match.Candidate.ID != w.id) {
+			t.Errorf("Satır %d eşleşmesi = %+v", i+1, proposal.Match.Candidate)
+		}
+	}
+	if proposals[3].TransactionID == "" {
+		t.Error("Kayıtlı hareket numarası boş")
+	}
+	if len(server.Requests()) == 0 || countMethod(server.Requests(), http.MethodPost) != 0 {
+		t.Error("Propose yazma isteği yapmamalı")
+	}
+
+	if err := reconciler.Apply(context.Background(), proposals); err != nil {
+		t.Fatalf("Apply hata döndü: %v", err)
+	}
+	for _, i := range []int{0, 1, 2, 5} {
+		if proposals[i].Payment == nil {
+			t.Errorf("Satır %d için ödeme girilmedi", i+1)
+		}
+	}
+	paid, _ := server.Resource("sales_invoices", invoice)
+	if paid.Attributes["payment_status"] != "paid" {
+		t.Errorf("Fatura durumu = %v", paid.Attributes["payment_status"])
+	}
+	payments := server.Resources("payments")
+	if len(payments) != 4 || payments[0].Attributes["account_id"] != account || payments[0].Attributes["date"] != "2024-05-02" || payments[0].Attributes["exchange_rate"] != 1.0 {
+		t.Errorf("Ödemeler = %+v", payments)
+	}
+	// Dövizli fatura TRL hesaptan faturanın dövizinde ve kuruyla ödenir
+	if usd := payments[3].Attributes; usd["amount"] != 100.0 || usd["exchange_rate"] != 32.5 {
+		t.Errorf("Dövizli ödeme = %+v", usd)
+	}
+
+	// İkinci Apply ödemesi girilmiş önerileri atlar
+	if err := reconciler.Apply(context.Background(), proposals); err != nil || len(server.Resources("payments")) != 4 {
+		t.Errorf("Tekrar Apply = %v, ödeme sayısı %d", err, len(server.Resources("payments")))
+	}
+}
+
+func TestReconciler_Errors(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	account := server.Seed("accounts", map[string]interface{}{"name": "Banka", "iban": "TR330006100519786457841326"}, nil)
+	reconciler := New(client, account, Options{})
+
+	_, err := reconciler.Propose(context.Background(), &Statement{IBAN: "TR000000000000000000000000"})
+	if err == nil || !strings.Contains(err.Error(), "uyuşmuyor") {
+		t.Errorf("IBAN uyuşmazlığı hatası = %v", err)
+	}
+
+	bill := server.Seed("purchase_bills", map[string]interface{}{"item_type": "purchase_bill", "issue_date": "2024-05-01", "remaining": 10}, nil)
+	proposals := []Proposal{{
+		Line:   Line{ID: "1", Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Amount: -10},
+		Status: StatusMatched,
+		Match:  &Match{Candidate: Candidate{Kind: KindPurchaseBill, ID: bill}, Amount: 10},
+	}}
+	if err := reconciler.Apply(context.Background(), proposals); err == nil || !strings.Contains(err.Error(), "kuru bilinmiyor") {
+		t.Errorf("Kursuz eşleşme hatası = %v", err)
+	}
+
+	proposals[0].Match.ExchangeRate = 1
+	server.FailNext(http.MethodPost, "/purchase_bills/"+bill+"/payments", http.StatusUnprocessableEntity)
+	if err := reconciler.Apply(context.Background(), proposals); err == nil || proposals[0].Payment != nil {
+		t.Errorf("Apply hatası = %v", err)
+	}
+}
+
+func TestPropose_SplitsRemaining(t *testing.T) {
+	candidates := []Candidate{{Kind: KindSalesInvoice, ID: "1", Remaining: 100, Currency: "TRL", DocumentNo: "A100"}}
+	statement := &Statement{Lines: []Line{
+		{Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Amount: 60, Description: "A100"},
+		{Date: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), Amount: 60, Description: "A100"},
+		{Date: time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC), Amount: 60, Description: "A100"},
+	}}
+	options := New(nil, "", Options{MinScore: 0.3}).options
+	proposals := propose(statement, "TRL", nil, candidates, options)
+
+	if proposals[0].Match == nil || proposals[0].Match.Amount != 60 {
+		t.Errorf("İlk satır = %+v", proposals[0].Match)
+	}
+	if proposals[1].Match == nil || proposals[1].Match.Amount != 40 {
+		t.Errorf("İkinci satır = %+v", proposals[1].Match)
+	}
+	if proposals[2].Status != StatusUnmatched || len(proposals[2].Alternatives) != 1 {
+		t.Errorf("Üçüncü satır = %+v", proposals[2])
+	}
+}
+
+func TestPropose_Direction(t *testing.T) {
+	day := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+	transactions := []parasut.AccountTransaction{
+		{ID: "10", Attributes: parasut.AccountTransactionAttributes{Date: "2024-05-03", Amount: 250, TransactionType: "collection"}},
+		{ID: "11", Attributes: parasut.AccountTransactionAttributes{Date: "2024-05-03", Amount: 80, TransactionType: "account_debit"}},
+	}
+	candidates := []Candidate{
+		{Kind: KindSalesInvoice, ID: "1", Remaining: 400, Currency: "TRL", DocumentNo: "A400"},
+		{Kind: KindPurchaseBill, ID: "2", Remaining: 400, Currency: "USD", ExchangeRate: 32.5, DocumentNo: "B400"},
+	}
+	statement := &Statement{Lines: []Line{
+		// Giden 250 tahsilat hareketiyle örtüşmez
+		{ID: "1", Date: day, Amount: -250},
+		{ID: "2", Date: day, Amount: -80},
+		// Gelen tutar gider faturasıyla, giden tutar satış faturasıyla eşleşmez
+		{ID: "3", Date: day, Amount: 400, Description: "B400", Currency: "USD"},
+		{ID: "4", Date: day, Amount: -13000, Description: "A400"},
+	}}
+	options := New(nil, "", Options{MinScore: 0.3}).options
+	proposals := propose(statement, "TRL", transactions, candidates, options)
+
+	if proposals[0].Status != StatusUnmatched || proposals[1].Status != StatusRecorded || proposals[1].TransactionID != "11" {
+		t.Errorf("Kayıtlı hareketler = %+v, %+v", proposals[0], proposals[1])
+	}
+	if proposals[2].Match != nil || len(proposals[2].Alternatives) != 0 {
+		t.Errorf("Gelen satır = %+v", proposals[2])
+	}
+	// Dövizli gider faturası TRL hesaptan faturanın kuruyla ödenir; 13000 TRL 400 USD'dir
+	if m := proposals[3].Match; m == nil || m.Candidate.ID != "2" || m.ExchangeRate != 32.5 || m.Amount != 400 || m.Reasons[0] != "tutar" {
+		t.Errorf("Giden satır = %+v", proposals[3].Match)
+	}
+
+	if rate := paymentRate(Candidate{Currency: "EUR"}, "USD"); rate != 0 {
+		t.Errorf("Farklı dövizli hesap kuru = %v", rate)
+	}
+}
+
+func countMethod(requests []parasuttest.Request, method string) int {
+	count := 0
+	for _, request := range requests {
+		if request.Method == method {
+			count++
+		}
+	}
+	return count
+}
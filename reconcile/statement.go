@@ -0,0 +1,91 @@
+// Package reconcile banka ekstrelerini (CSV/XLSX, MT940, CAMT.053) okur ve
+// hareketleri Paraşüt'teki açık satış faturaları, gider faturaları, maaşlar
+// ve vergilerle eşleştirerek ödeme önerir veya ödemeleri girer.
+package reconcile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parevo-lab/parasut/importer"
+)
+
+// Line ekstredeki tek bir hesap hareketi. Amount hesaba giren tutarlar için
+// pozitif, hesaptan çıkanlar için negatiftir.
+type Line struct {
+	ID               string    `json:"id,omitempty"`
+	Date             time.Time `json:"date"`
+	Amount           float64   `json:"amount"`
+	Currency         string    `json:"currency,omitempty"`
+	Description      string    `json:"description,omitempty"`
+	Reference        string    `json:"reference,omitempty"`
+	CounterpartyName string    `json:"counterparty_name,omitempty"`
+	CounterpartyIBAN string    `json:"counterparty_iban,omitempty"`
+}
+
+// Statement bir banka hesabının ekstresi
+type Statement struct {
+	IBAN     string `json:"iban,omitempty"`
+	Currency string `json:"currency,omitempty"`
+	Lines    []Line `json:"lines"`
+}
+
+// ParseFile ekstre dosyasını uzantısına göre okur: .csv/.txt ve .xlsx için
+// DefaultColumns, .sta/.mt940/.940 için MT940, .xml için CAMT.053 kullanılır.
+func ParseFile(name string) (*Statement, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv", ".txt", ".xlsx":
+		sheet, err := importer.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		return ParseSheet(sheet, DefaultColumns)
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".sta", ".mt940", ".940":
+		return ParseMT940(file)
+	case ".xml":
+		return ParseCAMT053(file)
+	}
+	return nil, fmt.Errorf("desteklenmeyen ekstre türü: %s (csv, xlsx, sta, mt940, xml)", filepath.Ext(name))
+}
+
+// parseAmount "1.234,56", "1,234.56", "1234,56" ve "-12.5" biçimlerini kabul
+// eder. Hem nokta hem virgül varsa sonda olan ondalık ayracıdır.
+func parseAmount(raw string) (float64, error) {
+	raw = strings.ReplaceAll(strings.TrimSpace(raw), " ", "")
+	raw = strings.TrimRightFunc(raw, func(r rune) bool { return r > '9' || r < '0' && r != '.' && r != ',' })
+	dot, comma := strings.LastIndex(raw, "."), strings.LastIndex(raw, ",")
+	switch {
+	case dot >= 0 && comma >= 0 && comma > dot:
+		raw = strings.ReplaceAll(raw, ".", "")
+		raw = strings.Replace(raw, ",", ".", 1)
+	case dot >= 0 && comma >= 0:
+		raw = strings.ReplaceAll(raw, ",", "")
+	case comma >= 0:
+		raw = strings.Replace(raw, ",", ".", 1)
+	case strings.Count(raw, ".") > 1:
+		raw = strings.ReplaceAll(raw, ".", "")
+	}
+	amount, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("geçersiz tutar: %q", raw)
+	}
+	return amount, nil
+}
+
+// normalizeIBAN boşlukları kaldırıp büyük harfe çevirir
+func normalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
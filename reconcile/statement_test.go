@@ -0,0 +1,53 @@
+package reconcile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := map[string]float64{
+		"1.234,56":     1234.56,
+		"1,234.56":     1234.56,
+		"1234,5":       1234.5,
+		"-12.5":        -12.5,
+		"1.234.567":    1234567,
+		"+250,00 TL":   250,
+		" 3 500,00 ":   3500,
+		"-1.000,00TRY": -1000,
+	}
+	for raw, want := range tests {
+		got, err := parseAmount(raw)
+		if err != nil {
+			t.Errorf("parseAmount(%q) hata döndü: %v", raw, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("parseAmount(%q) = %v, beklenen %v", raw, got, want)
+		}
+	}
+
+	if _, err := parseAmount("abc"); err == nil {
+		t.Error("Geçersiz tutar için hata bekleniyordu")
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "ekstre.csv")
+	if err := os.WriteFile(csvPath, []byte("Tarih;Tutar;Açıklama\n01.05.2024;100,00;Test\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	statement, err := ParseFile(csvPath)
+	if err != nil {
+		t.Fatalf("ParseFile hata döndü: %v", err)
+	}
+	if len(statement.Lines) != 1 || statement.Lines[0].Amount != 100 {
+		t.Errorf("Satırlar = %+v", statement.Lines)
+	}
+
+	if _, err := ParseFile(filepath.Join(dir, "ekstre.pdf")); err == nil {
+		t.Error("Desteklenmeyen tür için hata bekleniyordu")
+	}
+}
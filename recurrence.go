@@ -0,0 +1,116 @@
+package parasut
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Tekrarlama dönemleri
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+	RecurrenceYearly  = "yearly"
+)
+
+// validate planın API'ye gönderilmeden önce alanlarını denetler
+func (p RecurrencePlanInput) validate() error {
+	switch p.Period {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceYearly:
+	default:
+		return fmt.Errorf("geçersiz tekrarlama dönemi %q (daily, weekly, monthly, yearly)", p.Period)
+	}
+	if p.Interval < 0 {
+		return fmt.Errorf("tekrarlama aralığı negatif olamaz")
+	}
+
+	start, err := ParseDate(p.StartDate)
+	if err != nil {
+		return fmt.Errorf("geçersiz başlangıç tarihi: %q", p.StartDate)
+	}
+	if p.EndDate != "" {
+		end, err := ParseDate(p.EndDate)
+		if err != nil {
+			return fmt.Errorf("geçersiz bitiş tarihi: %q", p.EndDate)
+		}
+		if end.Before(start) {
+			return fmt.Errorf("bitiş tarihi başlangıç tarihinden önce olamaz")
+		}
+	}
+
+	if p.DayOfMonth != 0 {
+		if p.Period != RecurrenceMonthly && p.Period != RecurrenceYearly {
+			return fmt.Errorf("ayın günü yalnızca monthly ve yearly planlarda verilebilir")
+		}
+		if p.DayOfMonth < 1 || p.DayOfMonth > 31 {
+			return fmt.Errorf("ayın günü 1-31 arasında olmalıdır")
+		}
+	}
+	return nil
+}
+
+// CreateRecurring tekrarlama planını ve plana bağlı tekrarlayan fatura
+// şablonunu oluşturur. attributes.ItemType "estimate" veya
+// "recurring_estimate" ise şablon tekrarlayan proforma, aksi halde
+// tekrarlayan fatura olur. Şablon oluşturulamazsa plan silinir.
+func (s *SalesInvoicesService) CreateRecurring(ctx context.Context, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships, plan RecurrencePlanInput) (*SalesInvoice, *RecurrencePlan, error) {
+	if err := plan.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	switch attributes.ItemType {
+	case "estimate", "recurring_estimate":
+		attributes.ItemType = "recurring_estimate"
+	default:
+		attributes.ItemType = "recurring_invoice"
+	}
+	if attributes.IssueDate == "" {
+		attributes.IssueDate = plan.StartDate
+	}
+
+	created, err := s.client.RecurrencePlans.Create(ctx, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	withPlan := SalesInvoiceRelationships{}
+	if relationships != nil {
+		withPlan = *relationships
+	}
+	withPlan.RecurrencePlan = &RelationshipData{ID: created.ID, Type: "recurrence_plans"}
+
+	invoice, err := s.Create(ctx, attributes, &withPlan)
+	if err != nil {
+		if deleteErr := s.client.RecurrencePlans.Delete(ctx, created.ID); deleteErr != nil {
+			return nil, created, fmt.Errorf("tekrarlayan fatura oluşturulamadı: %w; %s numaralı plan silinemedi: %v", err, created.ID, deleteErr)
+		}
+		return nil, nil, fmt.Errorf("tekrarlayan fatura oluşturulamadı, plan silindi: %w", err)
+	}
+	return invoice, created, nil
+}
+
+// ListGenerated tekrarlama planının oluşturduğu faturaları (şablon hariç)
+// düzenlenme tarihi sırasıyla döndürür. Tüm sayfalar dolaşılır.
+func (s *SalesInvoicesService) ListGenerated(ctx context.Context, planID string) ([]SalesInvoice, error) {
+	invoices, err := ListAll(ctx, s.List, &ListParams{
+		Sort:   "issue_date",
+		Filter: map[string]string{"recurrence_plan_id": planID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var generated []SalesInvoice
+	for _, invoice := range invoices {
+		switch invoice.Attributes.ItemType {
+		case "recurring_invoice", "recurring_estimate":
+			continue
+		}
+		generated = append(generated, invoice)
+	}
+	sort.SliceStable(generated, func(i, j int) bool {
+		return generated[i].Attributes.IssueDate < generated[j].Attributes.IssueDate
+	})
+	return generated, nil
+}
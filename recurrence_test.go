@@ -0,0 +1,122 @@
+package parasut
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRecurrencePlanInput_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   RecurrencePlanInput
+		wantErr string
+	}{
+		{"Geçerli", RecurrencePlanInput{Period: "monthly", Interval: 1, StartDate: "2024-05-01", EndDate: "2024-12-31", DayOfMonth: 15}, ""},
+		{"Süresiz", RecurrencePlanInput{Period: "weekly", StartDate: "2024-05-01"}, ""},
+		{"Dönem", RecurrencePlanInput{Period: "hourly", StartDate: "2024-05-01"}, "dönemi"},
+		{"Aralık", RecurrencePlanInput{Period: "daily", Interval: -1, StartDate: "2024-05-01"}, "aralığı"},
+		{"Başlangıç", RecurrencePlanInput{Period: "daily"}, "başlangıç"},
+		{"Bitiş önce", RecurrencePlanInput{Period: "daily", StartDate: "2024-05-01", EndDate: "2024-04-01"}, "önce"},
+		{"Haftalıkta gün", RecurrencePlanInput{Period: "weekly", StartDate: "2024-05-01", DayOfMonth: 3}, "monthly"},
+		{"Gün aralığı", RecurrencePlanInput{Period: "monthly", StartDate: "2024-05-01", DayOfMonth: 32}, "1-31"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.validate()
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("validate() = %v, beklenen %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSalesInvoicesService_CreateRecurring(t *testing.T) {
+	var invoiceBody map[string]interface{}
+	deleted := ""
+	failInvoice := false
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/123/recurrence_plans":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "7", "type": "recurrence_plans", "attributes": {"period": "monthly", "start_date": "2024-05-01"}}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/123/sales_invoices":
+			if failInvoice {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"errors": [{"title": "Müşteri zorunlu"}]}`))
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &invoiceBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "20", "type": "sales_invoices", "attributes": {"item_type": "recurring_invoice"}, "relationships": {"recurrence_plan": {"data": {"id": "7", "type": "recurrence_plans"}}}}}`))
+		case r.Method == http.MethodDelete:
+			deleted = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Beklenmeyen istek: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	plan := RecurrencePlanInput{Period: RecurrenceMonthly, StartDate: "2024-05-01", DayOfMonth: 1, AutoSend: true}
+	contact := &SalesInvoiceRelationships{Contact: &RelationshipData{ID: "3", Type: "contacts"}}
+
+	invoice, created, err := client.SalesInvoices.CreateRecurring(ctx, SalesInvoiceInput{Description: "Aylık bakım"}, contact, plan)
+	if err != nil {
+		t.Fatalf("CreateRecurring hata döndü: %v", err)
+	}
+	if created.ID != "7" || invoice.Relationships.RecurrencePlan == nil || invoice.Relationships.RecurrencePlan.ID != "7" {
+		t.Errorf("Fatura = %+v, plan = %+v", invoice, created)
+	}
+
+	data := invoiceBody["data"].(map[string]interface{})
+	attributes := data["attributes"].(map[string]interface{})
+	if attributes["item_type"] != "recurring_invoice" || attributes["issue_date"] != "2024-05-01" {
+		t.Errorf("Fatura nitelikleri = %v", attributes)
+	}
+	relationships := data["relationships"].(map[string]interface{})
+	if relationships["recurrence_plan"] == nil || relationships["contact"] == nil {
+		t.Errorf("Fatura ilişkileri = %v", relationships)
+	}
+	if contact.RecurrencePlan != nil {
+		t.Error("Verilen ilişkiler değiştirilmemeli")
+	}
+
+	failInvoice = true
+	if _, _, err := client.SalesInvoices.CreateRecurring(ctx, SalesInvoiceInput{}, nil, plan); err == nil || !strings.Contains(err.Error(), "plan silindi") {
+		t.Errorf("Başarısız şablon hatası = %v", err)
+	}
+	if deleted != "/v4/123/recurrence_plans/7" {
+		t.Errorf("Silinen plan = %q", deleted)
+	}
+
+	if _, _, err := client.SalesInvoices.CreateRecurring(ctx, SalesInvoiceInput{}, nil, RecurrencePlanInput{Period: "monthly"}); err == nil {
+		t.Error("Geçersiz plan için hata bekleniyordu")
+	}
+}
+
+func TestSalesInvoicesService_ListGenerated(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("filter[recurrence_plan_id]") != "7" {
+			t.Errorf("filter[recurrence_plan_id] = %s", r.URL.Query().Get("filter[recurrence_plan_id]"))
+		}
+		w.Write([]byte(`{"data": [
+			{"id": "20", "type": "sales_invoices", "attributes": {"item_type": "recurring_invoice", "issue_date": "2024-05-01"}},
+			{"id": "22", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-06-01"}},
+			{"id": "21", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-05-01"}}
+		], "meta": {"current_page": 1, "total_pages": 1, "total_count": 3}}`))
+	})
+
+	invoices, err := client.SalesInvoices.ListGenerated(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("ListGenerated hata döndü: %v", err)
+	}
+	if len(invoices) != 2 || invoices[0].ID != "21" || invoices[1].ID != "22" {
+		t.Errorf("Faturalar = %+v", invoices)
+	}
+}
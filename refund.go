@@ -0,0 +1,283 @@
+package parasut
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// RefundLine iade edilecek fatura kalemi
+type RefundLine struct {
+	// DetailID orijinal faturadaki kalemin id'si
+	DetailID string
+	// Quantity iade edilen miktar; 0 ise kalemin henüz iade edilmemiş miktarının tamamı
+	Quantity float64
+}
+
+// RefundOptions iade faturası ayarları
+type RefundOptions struct {
+	// IssueDate iade faturasının tarihi; boşsa bugün
+	IssueDate string
+	// Description iade açıklaması; boşsa orijinal faturanın numarası ve tarihi yazılır
+	Description string
+	// EDocument iade faturasını resmileştirir: alıcı e-fatura kullanıcısıysa
+	// e-fatura, değilse e-arşiv faturası oluşturulur
+	EDocument bool
+	// Note e-belgeye yazılan not
+	Note string
+}
+
+// Refund iade faturası ve resmileştirildiyse e-belgesi
+type Refund struct {
+	Invoice  *SalesInvoice `json:"invoice"`
+	EInvoice *EInvoice     `json:"e_invoice,omitempty"`
+	EArchive *EArchive     `json:"e_archive,omitempty"`
+}
+
+// CreateRefund originalID faturası için iade faturası (item_type refund)
+// oluşturur. lines boşsa faturanın iade edilmemiş tüm kalemleri iade edilir.
+// Kalemler ürün ilişkileri ve iskontolarıyla kopyalanır; tutar tipi iskontolar
+// iade edilen miktara oranlanır. Daha önce kesilmiş iadeler hesaba katılır ve
+// bir kalem aldığından fazla iade edilemez. Orijinal fatura irsaliyeli ise ya
+// da iade edilen ürünlerden biri stok takibi yapıyorsa (ör. ayrı irsaliyeyle
+// sevk edilmiş fatura) iade irsaliyeli kesilir; böylece ürünler depoya geri
+// girer.
+// İade faturası oluşturulup e-belge gönderilemezse fatura hatayla birlikte döner.
+func (s *SalesInvoicesService) CreateRefund(ctx context.Context, originalID string, lines []RefundLine, options RefundOptions) (*Refund, error) {
+	original, err := s.Get(ctx, originalID, "details")
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case original.Attributes.ItemType != "invoice":
+		return nil, fmt.Errorf("yalnızca faturalar iade edilebilir; %s numaralı belgenin türü %s", originalID, original.Attributes.ItemType)
+	case len(original.Details) == 0 || len(original.Details) != len(original.Relationships.Details):
+		return nil, fmt.Errorf("fatura kalemleri alınamadı")
+	}
+
+	refunded, err := s.refundedQuantities(ctx, originalID)
+	if err != nil {
+		return nil, err
+	}
+	details, ratio, err := refundDetails(original.Details, lines, refunded)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := original.Attributes.ToInput()
+	attributes.ItemType = "refund"
+	attributes.IssueDate = options.IssueDate
+	if attributes.IssueDate == "" {
+		attributes.IssueDate = time.Now().Format("2006-01-02")
+	}
+	attributes.DueDate = ""
+	attributes.InvoiceSeries, attributes.InvoiceID = "", 0
+	attributes.OrderNo, attributes.OrderDate = "", ""
+	attributes.Description = options.Description
+	if attributes.Description == "" {
+		number := documentNo(original.Attributes.InvoiceSeries, invoiceNumber(original.Attributes.InvoiceID))
+		if number == "" {
+			number = original.ID
+		}
+		attributes.Description = fmt.Sprintf("%s numaralı %s tarihli faturanın iadesi", number, original.Attributes.IssueDate)
+	}
+	if attributes.InvoiceDiscountType == "amount" {
+		attributes.InvoiceDiscount = RoundAmount(attributes.InvoiceDiscount * ratio)
+	}
+
+	attributes.ShipmentIncluded, err = s.refundRestocks(ctx, original, details)
+	if err != nil {
+		return nil, err
+	}
+
+	relationships := &SalesInvoiceRelationships{
+		Contact:         original.Relationships.Contact,
+		OriginalInvoice: &RelationshipData{ID: original.ID, Type: "sales_invoices"},
+	}
+	invoice, err := s.CreateWithDetails(ctx, attributes, relationships, details)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := &Refund{Invoice: invoice}
+	if !options.EDocument {
+		return refund, nil
+	}
+	if err := s.issueEDocument(ctx, refund, original, options.Note); err != nil {
+		return refund, fmt.Errorf("%s numaralı iade faturası oluşturuldu ancak e-belge olarak gönderilemedi: %w", invoice.ID, err)
+	}
+	return refund, nil
+}
+
+// refundRestocks iadenin irsaliyeli kesilip kesilmeyeceğini belirler: orijinal
+// fatura irsaliyeliyse veya iade edilen kalemlerden birinin ürünü stok takibi
+// yapıyorsa true döner
+func (s *SalesInvoicesService) refundRestocks(ctx context.Context, original *SalesInvoice, details []SalesInvoiceDetailInput) (bool, error) {
+	if original.Attributes.ShipmentIncluded {
+		return true, nil
+	}
+	seen := map[string]bool{}
+	for _, detail := range details {
+		if detail.Product == nil || seen[detail.Product.ID] {
+			continue
+		}
+		seen[detail.Product.ID] = true
+		product, err := s.client.Products.Get(ctx, detail.Product.ID)
+		if err != nil {
+			return false, err
+		}
+		if product.Attributes.InventoryTracking {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// refundedQuantities faturanın iptal edilmemiş iadelerinde kalem anahtarı
+// başına iade edilmiş miktarları döndürür
+func (s *SalesInvoicesService) refundedQuantities(ctx context.Context, originalID string) (map[string]float64, error) {
+	refunds, err := ListAll(ctx, s.List, &ListParams{
+		Filter:  map[string]string{"original_invoice_id": originalID, "item_type": "refund"},
+		Include: "details",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quantities := map[string]float64{}
+	for _, refund := range refunds {
+		for _, detail := range refund.Details {
+			quantities[refundKey(detail.Attributes, detail.Relationships)] += detail.Attributes.Quantity
+		}
+	}
+	return quantities, nil
+}
+
+// refundKey iade kalemini orijinal kalemle eşleştiren anahtar: ürün, birim
+// fiyat ve açıklama
+func refundKey(attributes SalesInvoiceDetailAttributes, relationships SalesInvoiceDetailRelationships) string {
+	product := ""
+	if relationships.Product != nil {
+		product = relationships.Product.ID
+	}
+	return product + "|" + strconv.FormatFloat(attributes.UnitPrice, 'f', -1, 64) + "|" + attributes.Description
+}
+
+// refundDetails iade kalemlerini oluşturur ve iade edilen iskontolu tutarın
+// orijinal kalemlerin iskontolu tutarına oranını döndürür
+func refundDetails(originals []SalesInvoiceDetail, lines []RefundLine, refunded map[string]float64) ([]SalesInvoiceDetailInput, float64, error) {
+	// Önceki iadeler aynı anahtarlı kalemlere sırayla dağıtılır
+	unassigned := map[string]float64{}
+	for key, quantity := range refunded {
+		unassigned[key] = quantity
+	}
+	available := map[string]float64{}
+	byID := map[string]SalesInvoiceDetail{}
+	for _, detail := range originals {
+		key := refundKey(detail.Attributes, detail.Relationships)
+		used := unassigned[key]
+		if used > detail.Attributes.Quantity {
+			used = detail.Attributes.Quantity
+		}
+		unassigned[key] -= used
+		available[detail.ID] = detail.Attributes.Quantity - used
+		byID[detail.ID] = detail
+	}
+
+	if len(lines) == 0 {
+		for _, detail := range originals {
+			if available[detail.ID] > 0 {
+				lines = append(lines, RefundLine{DetailID: detail.ID})
+			}
+		}
+		if len(lines) == 0 {
+			return nil, 0, fmt.Errorf("faturanın tüm kalemleri zaten iade edilmiş")
+		}
+	}
+
+	var details []SalesInvoiceDetailInput
+	var refundTotal, originalTotal float64
+	seen := map[string]bool{}
+	for _, line := range lines {
+		detail, ok := byID[line.DetailID]
+		switch {
+		case !ok:
+			return nil, 0, fmt.Errorf("%s kalemi faturada yok", line.DetailID)
+		case seen[line.DetailID]:
+			return nil, 0, fmt.Errorf("%s kalemi birden fazla kez verilmiş", line.DetailID)
+		case line.Quantity < 0:
+			return nil, 0, fmt.Errorf("%s kaleminin iade miktarı negatif olamaz", line.DetailID)
+		}
+		seen[line.DetailID] = true
+
+		quantity := line.Quantity
+		if quantity == 0 {
+			quantity = available[detail.ID]
+		}
+		if quantity <= 0 {
+			return nil, 0, fmt.Errorf("%s kalemi zaten tamamen iade edilmiş", line.DetailID)
+		}
+		if quantity > available[detail.ID]+1e-9 {
+			return nil, 0, fmt.Errorf("%s kaleminden en fazla %v iade edilebilir", line.DetailID, available[detail.ID])
+		}
+
+		input := detail.ToInput()
+		input.Quantity = quantity
+		if input.DiscountType == "amount" && detail.Attributes.Quantity != 0 {
+			input.DiscountValue = RoundAmount(input.DiscountValue * quantity / detail.Attributes.Quantity)
+		}
+		details = append(details, input)
+		refundTotal += lineSubtotal(input.Quantity, input.UnitPrice, input.DiscountType, input.DiscountValue)
+	}
+
+	for _, detail := range originals {
+		a := detail.Attributes
+		originalTotal += lineSubtotal(a.Quantity, a.UnitPrice, a.DiscountType, a.DiscountValue)
+	}
+	ratio := 1.0
+	if originalTotal != 0 {
+		ratio = refundTotal / originalTotal
+	}
+	return details, ratio, nil
+}
+
+// lineSubtotal kalemin satır iskontosu düşülmüş vergisiz tutarı
+func lineSubtotal(quantity, unitPrice float64, discountType string, discountValue float64) float64 {
+	subtotal := quantity * unitPrice
+	switch discountType {
+	case "percentage":
+		return subtotal * (1 - discountValue/100)
+	case "amount":
+		return subtotal - discountValue
+	}
+	return subtotal
+}
+
+// issueEDocument iade faturasını alıcı e-fatura kullanıcısıysa e-fatura,
+// değilse e-arşiv faturası olarak resmileştirir
+func (s *SalesInvoicesService) issueEDocument(ctx context.Context, refund *Refund, original *SalesInvoice, note string) error {
+	taxNumber := original.Attributes.TaxNumber
+	if taxNumber == "" && original.Relationships.Contact != nil {
+		contact, err := s.client.Contacts.Get(ctx, original.Relationships.Contact.ID)
+		if err != nil {
+			return err
+		}
+		taxNumber = contact.Attributes.TaxNumber
+	}
+
+	if taxNumber != "" {
+		inboxes, _, err := s.client.EInvoiceInboxes.List(ctx, &ListParams{Filter: map[string]string{"vkn": taxNumber}})
+		if err != nil {
+			return err
+		}
+		if len(inboxes) > 0 {
+			refund.EInvoice, err = s.client.EInvoices.CreateForInvoice(ctx, refund.Invoice.ID, EInvoiceInput{Note: note})
+			return err
+		}
+	}
+
+	var err error
+	refund.EArchive, err = s.client.EArchives.Create(ctx, refund.Invoice.ID, EArchiveInput{Note: note})
+	return err
+}
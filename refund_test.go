@@ -0,0 +1,197 @@
+package parasut
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRefundDetails(t *testing.T) {
+	product := &RelationshipData{ID: "11", Type: "products"}
+	originals := []SalesInvoiceDetail{
+		{ID: "1", Attributes: SalesInvoiceDetailAttributes{Quantity: 4, UnitPrice: 50, VatRate: 20, DiscountType: "amount", DiscountValue: 20}, Relationships: SalesInvoiceDetailRelationships{Product: product}},
+		{ID: "2", Attributes: SalesInvoiceDetailAttributes{Quantity: 1, UnitPrice: 320, VatRate: 20, Description: "Kurulum"}},
+	}
+	refundedFirst := map[string]float64{refundKey(originals[0].Attributes, originals[0].Relationships): 1}
+
+	tests := []struct {
+		name     string
+		lines    []RefundLine
+		refunded map[string]float64
+		want     []float64
+		wantErr  string
+	}{
+		{"Tamamı", nil, map[string]float64{}, []float64{4, 1}, ""},
+		{"Kısmi", []RefundLine{{DetailID: "1", Quantity: 2}}, map[string]float64{}, []float64{2}, ""},
+		{"Önceki iade", []RefundLine{{DetailID: "1"}}, refundedFirst, []float64{3}, ""},
+		{"Fazla", []RefundLine{{DetailID: "1", Quantity: 4}}, refundedFirst, nil, "en fazla 3"},
+		{"Bilinmeyen", []RefundLine{{DetailID: "9"}}, map[string]float64{}, nil, "faturada yok"},
+		{"Tekrar", []RefundLine{{DetailID: "2"}, {DetailID: "2"}}, map[string]float64{}, nil, "birden fazla"},
+		{"Negatif", []RefundLine{{DetailID: "2", Quantity: -1}}, map[string]float64{}, nil, "negatif"},
+		{"Tamamı iade edilmiş", nil, map[string]float64{"|320|Kurulum": 1, "11|50|": 4}, nil, "zaten iade"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details, _, err := refundDetails(originals, tt.lines, tt.refunded)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("refundDetails() hata = %v, beklenen %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("refundDetails() hata döndü: %v", err)
+			}
+			if len(details) != len(tt.want) {
+				t.Fatalf("Kalem sayısı = %d, beklenen %d", len(details), len(tt.want))
+			}
+			for i, quantity := range tt.want {
+				if details[i].Quantity != quantity {
+					t.Errorf("Kalem %d miktarı = %v, beklenen %v", i, details[i].Quantity, quantity)
+				}
+			}
+		})
+	}
+
+	details, ratio, err := refundDetails(originals, []RefundLine{{DetailID: "1", Quantity: 2}}, map[string]float64{})
+	if err != nil {
+		t.Fatalf("refundDetails() hata döndü: %v", err)
+	}
+	// 2 * 50 - 10 iskonto = 90; orijinal kalemler 180 + 320 = 500
+	if details[0].DiscountValue != 10 || details[0].Product == nil || ratio != 0.18 {
+		t.Errorf("Kalem = %+v, oran = %v", details[0], ratio)
+	}
+}
+
+func TestSalesInvoicesService_CreateRefundRestock(t *testing.T) {
+	var refundBody map[string]interface{}
+	tracked := true
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/sales_invoices/5":
+			w.Write([]byte(`{
+				"data": {"id": "5", "type": "sales_invoices",
+					"attributes": {"item_type": "invoice", "issue_date": "2024-05-01"},
+					"relationships": {"details": {"data": [{"id": "8", "type": "sales_invoice_details"}, {"id": "9", "type": "sales_invoice_details"}]}}},
+				"included": [
+					{"id": "8", "type": "sales_invoice_details", "attributes": {"quantity": 2, "unit_price": 100}, "relationships": {"product": {"data": {"id": "11", "type": "products"}}}},
+					{"id": "9", "type": "sales_invoice_details", "attributes": {"quantity": 1, "unit_price": 50, "description": "Kurulum"}}
+				]
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/sales_invoices":
+			w.Write([]byte(`{"data": [], "meta": {"current_page": 1, "total_pages": 1}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/products/11":
+			fmt.Fprintf(w, `{"data": {"id": "11", "type": "products", "attributes": {"name": "Sunucu", "inventory_tracking": %t}}}`, tracked)
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/123/sales_invoices":
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &refundBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "30", "type": "sales_invoices", "attributes": {"item_type": "refund"}}}`))
+		default:
+			t.Errorf("Beklenmeyen istek: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	shipmentIncluded := func() interface{} {
+		return refundBody["data"].(map[string]interface{})["attributes"].(map[string]interface{})["shipment_included"]
+	}
+
+	// İrsaliyesiz faturadaki stok takipli ürün iadesi depoya geri girer
+	if _, err := client.SalesInvoices.CreateRefund(ctx, "5", nil, RefundOptions{IssueDate: "2024-05-20"}); err != nil {
+		t.Fatalf("CreateRefund hata döndü: %v", err)
+	}
+	if shipmentIncluded() != true {
+		t.Errorf("Stok takipli iade shipment_included = %v", shipmentIncluded())
+	}
+
+	// Yalnızca hizmet kalemi iade edilirse ürün sorgulanmaz
+	if _, err := client.SalesInvoices.CreateRefund(ctx, "5", []RefundLine{{DetailID: "9"}}, RefundOptions{IssueDate: "2024-05-20"}); err != nil {
+		t.Fatalf("CreateRefund hata döndü: %v", err)
+	}
+	if shipmentIncluded() == true {
+		t.Error("Hizmet iadesi irsaliyeli kesilmemeli")
+	}
+
+	tracked = false
+	if _, err := client.SalesInvoices.CreateRefund(ctx, "5", []RefundLine{{DetailID: "8"}}, RefundOptions{IssueDate: "2024-05-20"}); err != nil {
+		t.Fatalf("CreateRefund hata döndü: %v", err)
+	}
+	if shipmentIncluded() == true {
+		t.Error("Stok takipsiz ürün iadesi irsaliyeli kesilmemeli")
+	}
+}
+
+func TestSalesInvoicesService_CreateRefund(t *testing.T) {
+	var refundBody map[string]interface{}
+	var eInvoiceBody map[string]interface{}
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/sales_invoices/5":
+			w.Write([]byte(`{
+				"data": {"id": "5", "type": "sales_invoices",
+					"attributes": {"item_type": "invoice", "issue_date": "2024-05-01", "invoice_series": "A", "invoice_id": 12, "tax_number": "1234567890", "invoice_discount_type": "amount", "invoice_discount": 50, "shipment_included": true},
+					"relationships": {"contact": {"data": {"id": "3", "type": "contacts"}}, "details": {"data": [{"id": "8", "type": "sales_invoice_details"}]}}},
+				"included": [{"id": "8", "type": "sales_invoice_details", "attributes": {"quantity": 4, "unit_price": 25, "vat_rate": 20}}]
+			}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/sales_invoices":
+			if r.URL.Query().Get("filter[original_invoice_id]") != "5" || r.URL.Query().Get("filter[item_type]") != "refund" {
+				t.Errorf("İade filtresi = %v", r.URL.Query())
+			}
+			w.Write([]byte(`{"data": [], "meta": {"current_page": 1, "total_pages": 1}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/123/sales_invoices":
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &refundBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "30", "type": "sales_invoices", "attributes": {"item_type": "refund"}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/123/e_invoice_inboxes":
+			if r.URL.Query().Get("filter[vkn]") != "1234567890" {
+				t.Errorf("vkn = %q", r.URL.Query().Get("filter[vkn]"))
+			}
+			w.Write([]byte(`{"data": [{"id": "1", "type": "e_invoice_inboxes", "attributes": {"vkn": "1234567890"}}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/123/e_invoices":
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &eInvoiceBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": "70", "type": "e_invoices", "attributes": {"note": "İade"}}}`))
+		default:
+			t.Errorf("Beklenmeyen istek: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	refund, err := client.SalesInvoices.CreateRefund(context.Background(), "5", []RefundLine{{DetailID: "8", Quantity: 1}}, RefundOptions{
+		IssueDate: "2024-05-20", EDocument: true, Note: "İade",
+	})
+	if err != nil {
+		t.Fatalf("CreateRefund hata döndü: %v", err)
+	}
+	if refund.Invoice.ID != "30" || refund.EInvoice == nil || refund.EInvoice.ID != "70" || refund.EArchive != nil {
+		t.Errorf("İade = %+v", refund)
+	}
+
+	data := refundBody["data"].(map[string]interface{})
+	attributes := data["attributes"].(map[string]interface{})
+	if attributes["item_type"] != "refund" || attributes["issue_date"] != "2024-05-20" || attributes["invoice_series"] != nil ||
+		attributes["invoice_discount"] != 12.5 || attributes["shipment_included"] != true ||
+		!strings.Contains(attributes["description"].(string), "A12 numaralı 2024-05-01") {
+		t.Errorf("İade nitelikleri = %v", attributes)
+	}
+	relationships := data["relationships"].(map[string]interface{})
+	if original := relationships["original_invoice"].(map[string]interface{}); original["id"] != "5" {
+		t.Errorf("original_invoice = %v", original)
+	}
+	details := relationships["details"].(map[string]interface{})["data"].([]interface{})
+	if quantity := details[0].(map[string]interface{})["attributes"].(map[string]interface{})["quantity"]; len(details) != 1 || quantity != 1.0 {
+		t.Errorf("İade kalemleri = %v", details)
+	}
+
+	invoice := eInvoiceBody["data"].(map[string]interface{})["relationships"].(map[string]interface{})["invoice"].(map[string]interface{})["data"].(map[string]interface{})
+	if invoice["id"] != "30" || invoice["type"] != "sales_invoices" {
+		t.Errorf("E-fatura ilişkisi = %v", invoice)
+	}
+}
@@ -0,0 +1,273 @@
+// Package reporting Paraşüt servisleri üzerine kurulu finansal raporlar sunar.
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// CashFlowSource nakit akışı kaleminin kaynağı
+type CashFlowSource string
+
+const (
+	SourceSalesInvoice CashFlowSource = "sales_invoice"
+	SourcePurchaseBill CashFlowSource = "purchase_bill"
+	SourceSalary       CashFlowSource = "salary"
+	SourceTax          CashFlowSource = "tax"
+	SourceBankFee      CashFlowSource = "bank_fee"
+)
+
+// CashFlowOptions nakit akışı projeksiyonu ayarları
+type CashFlowOptions struct {
+	// From projeksiyonun ilk günü; boşsa bugün
+	From time.Time
+	// Days projeksiyonun gün sayısı; 0 ise 30
+	Days int
+	// SkipOverdue vadesi geçmiş kalemleri ilk güne eklemek yerine atlar
+	SkipOverdue bool
+}
+
+// CashFlowItem beklenen tahsilat veya ödeme. Amount tahsilatlar için pozitif,
+// ödemeler için negatiftir.
+type CashFlowItem struct {
+	Date        time.Time      `json:"date"`
+	Source      CashFlowSource `json:"source"`
+	ID          string         `json:"id"`
+	Description string         `json:"description,omitempty"`
+	Currency    string         `json:"currency"`
+	Amount      float64        `json:"amount"`
+	Overdue     bool           `json:"overdue,omitempty"`
+}
+
+// CashFlowDay bir döviz cinsinde tek günün nakit hareketi
+type CashFlowDay struct {
+	Date     time.Time      `json:"date"`
+	Opening  float64        `json:"opening"`
+	Inflows  float64        `json:"inflows"`
+	Outflows float64        `json:"outflows"`
+	Closing  float64        `json:"closing"`
+	Items    []CashFlowItem `json:"items,omitempty"`
+}
+
+// CashFlowProjection döviz bazında günlük nakit akışı projeksiyonu
+type CashFlowProjection struct {
+	From       time.Time                `json:"from"`
+	To         time.Time                `json:"to"`
+	Currencies []string                 `json:"currencies"`
+	Opening    map[string]float64       `json:"opening"`
+	Days       map[string][]CashFlowDay `json:"days"`
+}
+
+// Closing verilen dövizin projeksiyon sonundaki bakiyesini döndürür
+func (p *CashFlowProjection) Closing(currency string) float64 {
+	days := p.Days[currency]
+	if len(days) == 0 {
+		return p.Opening[currency]
+	}
+	return days[len(days)-1].Closing
+}
+
+// LowestBalance verilen dövizde bakiyenin en düşük olduğu günü döndürür
+func (p *CashFlowProjection) LowestBalance(currency string) *CashFlowDay {
+	var lowest *CashFlowDay
+	days := p.Days[currency]
+	for i := range days {
+		if lowest == nil || days[i].Closing < lowest.Closing {
+			lowest = &days[i]
+		}
+	}
+	return lowest
+}
+
+// ProjectCashFlow kasa/banka hesaplarının güncel bakiyeleriyle açık satış
+// faturaları, alış faturaları, maaşlar, vergiler ve banka masraflarının kalan
+// tutarlarını vade tarihlerine göre birleştirerek günlük projeksiyon oluşturur.
+// Tüm kaynaklar sayfa sayfa dolaşılır.
+func ProjectCashFlow(ctx context.Context, client *parasut.Client, opts CashFlowOptions) (*CashFlowProjection, error) {
+	accounts, err := parasut.ListAll(ctx, client.Accounts.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("hesaplar alınamadı: %w", err)
+	}
+
+	var items []CashFlowItem
+
+	invoices, err := parasut.ListAll(ctx, client.SalesInvoices.List, &parasut.ListParams{
+		Filter: map[string]string{"item_type": "invoice"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("satış faturaları alınamadı: %w", err)
+	}
+	for _, invoice := range invoices {
+		a := invoice.Attributes
+		if a.ItemType != "invoice" || a.Remaining <= 0 {
+			continue
+		}
+		items = appendItem(items, SourceSalesInvoice, invoice.ID, a.Description, a.Currency, dueOrIssue(a.DueDate, a.IssueDate), a.Remaining)
+	}
+
+	bills, err := parasut.ListAll(ctx, client.PurchaseBills.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("alış faturaları alınamadı: %w", err)
+	}
+	for _, bill := range bills {
+		a := bill.Attributes
+		if a.ItemType == "cancelled" || a.ItemType == "refund" || a.Remaining <= 0 {
+			continue
+		}
+		items = appendItem(items, SourcePurchaseBill, bill.ID, a.Description, a.Currency, dueOrIssue(a.DueDate, a.IssueDate), -a.Remaining)
+	}
+
+	salaries, err := parasut.ListAll(ctx, client.Salaries.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("maaşlar alınamadı: %w", err)
+	}
+	for _, salary := range salaries {
+		a := salary.Attributes
+		if a.Archived || a.Remaining <= 0 {
+			continue
+		}
+		items = appendItem(items, SourceSalary, salary.ID, a.Description, a.Currency, dueOrIssue(a.DueDate, a.Date), -a.Remaining)
+	}
+
+	taxes, err := parasut.ListAll(ctx, client.Taxes.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("vergiler alınamadı: %w", err)
+	}
+	for _, tax := range taxes {
+		a := tax.Attributes
+		if a.Archived || a.Remaining <= 0 {
+			continue
+		}
+		items = appendItem(items, SourceTax, tax.ID, a.Description, a.Currency, dueOrIssue(a.DueDate, a.Date), -a.Remaining)
+	}
+
+	fees, err := parasut.ListAll(ctx, client.BankFees.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("banka masrafları alınamadı: %w", err)
+	}
+	for _, fee := range fees {
+		a := fee.Attributes
+		if a.Archived || a.Remaining <= 0 {
+			continue
+		}
+		items = appendItem(items, SourceBankFee, fee.ID, a.Description, a.Currency, dueOrIssue(a.DueDate, a.IssueDate), -a.Remaining)
+	}
+
+	return BuildCashFlow(accounts, items, opts)
+}
+
+// BuildCashFlow hesap bakiyeleri ve beklenen kalemlerden projeksiyon oluşturur.
+// Yalnızca arşivlenmemiş kasa ve banka hesaplarının bakiyeleri açılışa eklenir.
+func BuildCashFlow(accounts []parasut.Account, items []CashFlowItem, opts CashFlowOptions) (*CashFlowProjection, error) {
+	from := opts.From
+	if from.IsZero() {
+		from = time.Now()
+	}
+	from = parasut.TruncateDate(from)
+
+	days := opts.Days
+	if days <= 0 {
+		days = 30
+	}
+	to := from.AddDate(0, 0, days-1)
+
+	projection := &CashFlowProjection{
+		From:    from,
+		To:      to,
+		Opening: map[string]float64{},
+		Days:    map[string][]CashFlowDay{},
+	}
+
+	currencies := map[string]bool{}
+	for _, account := range accounts {
+		a := account.Attributes
+		if a.Archived || (a.AccountType != "cash" && a.AccountType != "bank") {
+			continue
+		}
+		currency := parasut.CurrencyOrDefault(a.Currency)
+		projection.Opening[currency] = parasut.RoundAmount(projection.Opening[currency] + a.Balance)
+		currencies[currency] = true
+	}
+
+	byDay := map[string]map[time.Time][]CashFlowItem{}
+	for _, item := range items {
+		if item.Date.IsZero() {
+			return nil, fmt.Errorf("%s %s için vade tarihi yok", item.Source, item.ID)
+		}
+		item.Date = parasut.TruncateDate(item.Date)
+		item.Currency = parasut.CurrencyOrDefault(item.Currency)
+
+		if item.Date.After(to) {
+			continue
+		}
+		if item.Date.Before(from) {
+			if opts.SkipOverdue {
+				continue
+			}
+			item.Overdue = true
+			item.Date = from
+		}
+
+		if byDay[item.Currency] == nil {
+			byDay[item.Currency] = map[time.Time][]CashFlowItem{}
+		}
+		byDay[item.Currency][item.Date] = append(byDay[item.Currency][item.Date], item)
+		currencies[item.Currency] = true
+	}
+
+	for currency := range currencies {
+		projection.Currencies = append(projection.Currencies, currency)
+	}
+	sort.Strings(projection.Currencies)
+
+	for _, currency := range projection.Currencies {
+		balance := projection.Opening[currency]
+		for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+			day := CashFlowDay{
+				Date:    date,
+				Opening: balance,
+				Items:   byDay[currency][date],
+			}
+			for _, item := range day.Items {
+				if item.Amount >= 0 {
+					day.Inflows = parasut.RoundAmount(day.Inflows + item.Amount)
+				} else {
+					day.Outflows = parasut.RoundAmount(day.Outflows - item.Amount)
+				}
+			}
+			balance = parasut.RoundAmount(balance + day.Inflows - day.Outflows)
+			day.Closing = balance
+			projection.Days[currency] = append(projection.Days[currency], day)
+		}
+	}
+
+	return projection, nil
+}
+
+// appendItem tarih alanı çözülebilen kalemi listeye ekler
+func appendItem(items []CashFlowItem, source CashFlowSource, id, description, currency, date string, amount float64) []CashFlowItem {
+	parsed, err := parasut.ParseDate(date)
+	if err != nil {
+		parsed = time.Time{}
+	}
+	return append(items, CashFlowItem{
+		Date:        parsed,
+		Source:      source,
+		ID:          id,
+		Description: description,
+		Currency:    currency,
+		Amount:      amount,
+	})
+}
+
+// dueOrIssue vade tarihi yoksa düzenleme tarihini döndürür
+func dueOrIssue(dueDate, issueDate string) string {
+	if dueDate != "" {
+		return dueDate
+	}
+	return issueDate
+}
@@ -0,0 +1,124 @@
+package reporting
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+func TestBuildCashFlow(t *testing.T) {
+	accounts := []parasut.Account{
+		{ID: "1", Attributes: parasut.AccountAttributes{AccountType: "cash", Currency: "TRL", Balance: 1000}},
+		{ID: "2", Attributes: parasut.AccountAttributes{AccountType: "bank", Currency: "TRL", Balance: 4000}},
+		{ID: "3", Attributes: parasut.AccountAttributes{AccountType: "bank", Currency: "USD", Balance: 100}},
+		{ID: "4", Attributes: parasut.AccountAttributes{AccountType: "sys", Currency: "TRL", Balance: 999}},
+		{ID: "5", Attributes: parasut.AccountAttributes{AccountType: "cash", Currency: "TRL", Balance: 999, Archived: true}},
+	}
+
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	items := []CashFlowItem{
+		{Date: time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC), Source: SourceSalesInvoice, ID: "10", Currency: "TRL", Amount: 500},
+		{Date: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), Source: SourcePurchaseBill, ID: "11", Currency: "TRL", Amount: -2000},
+		{Date: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), Source: SourceSalary, ID: "12", Amount: -4000},
+		{Date: time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC), Source: SourceSalesInvoice, ID: "13", Currency: "USD", Amount: 50},
+		{Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Source: SourceTax, ID: "14", Currency: "TRL", Amount: -100},
+	}
+
+	projection, err := BuildCashFlow(accounts, items, CashFlowOptions{From: from, Days: 3})
+	if err != nil {
+		t.Fatalf("BuildCashFlow hata döndü: %v", err)
+	}
+
+	if projection.Opening["TRL"] != 5000 {
+		t.Errorf("TRL açılış = %f, beklenen 5000", projection.Opening["TRL"])
+	}
+
+	trl := projection.Days["TRL"]
+	if len(trl) != 3 {
+		t.Fatalf("TRL gün sayısı = %d, beklenen 3", len(trl))
+	}
+
+	if trl[0].Inflows != 500 || !trl[0].Items[0].Overdue {
+		t.Errorf("İlk gün = %+v, vadesi geçmiş tahsilat bekleniyordu", trl[0])
+	}
+
+	if trl[1].Outflows != 6000 || trl[1].Closing != -500 {
+		t.Errorf("İkinci gün = %+v, beklenen 6000 çıkış ve -500 kapanış", trl[1])
+	}
+
+	if projection.Closing("TRL") != -500 {
+		t.Errorf("TRL kapanış = %f, beklenen -500", projection.Closing("TRL"))
+	}
+
+	if lowest := projection.LowestBalance("TRL"); lowest == nil || !lowest.Date.Equal(trl[1].Date) {
+		t.Errorf("En düşük bakiye günü = %+v", lowest)
+	}
+
+	if projection.Closing("USD") != 150 {
+		t.Errorf("USD kapanış = %f, beklenen 150", projection.Closing("USD"))
+	}
+
+	skipped, err := BuildCashFlow(accounts, items, CashFlowOptions{From: from, Days: 3, SkipOverdue: true})
+	if err != nil {
+		t.Fatalf("BuildCashFlow hata döndü: %v", err)
+	}
+
+	if skipped.Closing("TRL") != -1000 {
+		t.Errorf("Vadesi geçmişler atlandığında TRL kapanış = %f, beklenen -1000", skipped.Closing("TRL"))
+	}
+}
+
+func TestProjectCashFlow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		meta := `"meta": {"current_page": 1, "total_pages": 1, "total_count": 1}`
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/accounts"):
+			w.Write([]byte(`{"data": [{"id": "1", "type": "accounts", "attributes": {"name": "Banka", "account_type": "bank", "currency": "TRL", "balance": 10000}}], ` + meta + `}`))
+		case strings.HasSuffix(r.URL.Path, "/sales_invoices"):
+			w.Write([]byte(`{"data": [{"id": "2", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-05-01", "due_date": "2024-05-05", "remaining": 3000}}], ` + meta + `}`))
+		case strings.HasSuffix(r.URL.Path, "/purchase_bills"):
+			w.Write([]byte(`{"data": [{"id": "3", "type": "purchase_bills", "attributes": {"item_type": "purchase_bill", "issue_date": "2024-05-01", "due_date": "2024-05-03", "remaining": 1500}}], ` + meta + `}`))
+		case strings.HasSuffix(r.URL.Path, "/salaries"):
+			w.Write([]byte(`{"data": [{"id": "4", "type": "salaries", "attributes": {"date": "2024-05-01", "due_date": "2024-05-04", "net_total": 8000, "remaining": 8000}}], ` + meta + `}`))
+		case strings.HasSuffix(r.URL.Path, "/taxes"):
+			w.Write([]byte(`{"data": [{"id": "5", "type": "taxes", "attributes": {"date": "2024-05-01", "due_date": "2024-05-02", "net_total": 700, "remaining": 0}}], ` + meta + `}`))
+		case strings.HasSuffix(r.URL.Path, "/bank_fees"):
+			w.Write([]byte(`{"data": [{"id": "6", "type": "bank_fees", "attributes": {"description": "EFT", "currency": "TRL", "issue_date": "2024-05-01", "due_date": "2024-05-01", "net_total": 25, "remaining": 25}}], ` + meta + `}`))
+		default:
+			t.Errorf("Beklenmeyen path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := parasut.NewClient(&parasut.Config{
+		CompanyID: 123,
+		BaseURL:   server.URL + "/v4",
+	})
+
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	projection, err := ProjectCashFlow(context.Background(), client, CashFlowOptions{From: from, Days: 7})
+	if err != nil {
+		t.Fatalf("ProjectCashFlow hata döndü: %v", err)
+	}
+
+	days := projection.Days["TRL"]
+	if len(days) != 7 {
+		t.Fatalf("Gün sayısı = %d, beklenen 7", len(days))
+	}
+
+	// 10000 - 25 - 1500 - 8000 + 3000
+	if projection.Closing("TRL") != 3475 {
+		t.Errorf("TRL kapanış = %f, beklenen 3475", projection.Closing("TRL"))
+	}
+
+	if lowest := projection.LowestBalance("TRL"); lowest.Closing != 475 {
+		t.Errorf("En düşük bakiye = %f, beklenen 475", lowest.Closing)
+	}
+}
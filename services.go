@@ -4,13 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"reflect"
+	"strings"
 )
 
 // Generic helper metodları - Kod tekrarını önlemek için
 
 // list generic list metodu
 func list[T any](c *Client, ctx context.Context, endpoint string, params *ListParams) ([]T, *Meta, error) {
+	items, _, meta, err := listIncluded[T](c, ctx, endpoint, params)
+	return items, meta, err
+}
+
+// listIncluded included kaynaklarını da döndüren generic list metodu
+func listIncluded[T any](c *Client, ctx context.Context, endpoint string, params *ListParams) ([]T, []IncludedResource, *Meta, error) {
 	var queryParams map[string]string
 	if params != nil {
 		queryParams = params.ToMap()
@@ -18,39 +25,60 @@ func list[T any](c *Client, ctx context.Context, endpoint string, params *ListPa
 
 	resp, err := c.get(ctx, endpoint, queryParams)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	var response struct {
-		Data []T   `json:"data"`
-		Meta *Meta `json:"meta"`
+		Data     []T                `json:"data"`
+		Included []IncludedResource `json:"included"`
+		Meta     *Meta              `json:"meta"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return response.Data, response.Meta, nil
+	return response.Data, response.Included, response.Meta, nil
 }
 
 // get generic get metodu
 func get[T any](c *Client, ctx context.Context, endpoint string) (*T, error) {
-	resp, err := c.get(ctx, endpoint, nil)
+	item, _, err := getIncluded[T](c, ctx, endpoint, "")
+	return item, err
+}
+
+// getIncluded included kaynaklarını da döndüren generic get metodu
+func getIncluded[T any](c *Client, ctx context.Context, endpoint string, include string) (*T, []IncludedResource, error) {
+	var queryParams map[string]string
+	if include != "" {
+		queryParams = map[string]string{"include": include}
+	}
+
+	resp, err := c.get(ctx, endpoint, queryParams)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	var response struct {
-		Data T `json:"data"`
+		Data     T                  `json:"data"`
+		Included []IncludedResource `json:"included"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &response.Data, nil
+	return &response.Data, response.Included, nil
+}
+
+// ListResources endpoint'teki kaynakları modellere çözmeden, included
+// kaynaklarıyla birlikte döndürür. Modellerde karşılığı olmayan nitelikler de
+// korunduğundan yedekleme gibi kayıpsız okuma gereken işler içindir.
+// endpoint şirket yolundan sonraki kısımdır (ör. "/sales_invoices").
+func (c *Client) ListResources(ctx context.Context, endpoint string, params *ListParams) ([]IncludedResource, []IncludedResource, *Meta, error) {
+	return listIncluded[IncludedResource](c, ctx, endpoint, params)
 }
 
 // create generic create metodu
@@ -62,7 +90,7 @@ func create[T any](c *Client, ctx context.Context, endpoint, resourceType string
 		},
 	}
 
-	if relationships != nil {
+	if hasRelationships(relationships) {
 		body["data"].(map[string]interface{})["relationships"] = relationships
 	}
 
@@ -83,6 +111,21 @@ func create[T any](c *Client, ctx context.Context, endpoint, resourceType string
 	return &response.Data, nil
 }
 
+// hasRelationships ilişkilerin gönderilip gönderilmeyeceğini döndürür.
+// interface{} içindeki nil işaretçi (ör. (*ContactRelationshipsInput)(nil))
+// de boş sayılır; aksi halde gövdeye "relationships": null yazılırdı.
+func hasRelationships(relationships interface{}) bool {
+	if relationships == nil {
+		return false
+	}
+	value := reflect.ValueOf(relationships)
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return !value.IsNil()
+	}
+	return true
+}
+
 // update generic update metodu
 func update[T any](c *Client, ctx context.Context, endpoint, id, resourceType string, attributes interface{}, relationships interface{}) (*T, error) {
 	body := map[string]interface{}{
@@ -93,7 +136,7 @@ func update[T any](c *Client, ctx context.Context, endpoint, id, resourceType st
 		},
 	}
 
-	if relationships != nil {
+	if hasRelationships(relationships) {
 		body["data"].(map[string]interface{})["relationships"] = relationships
 	}
 
@@ -188,8 +231,14 @@ func recover(c *Client, ctx context.Context, endpoint string) error {
 	return err
 }
 
-// CreatePayment generic payment metodu
-func createPayment(c *Client, ctx context.Context, endpoint string, attributes PaymentAttributes) (*Payment, error) {
+// CreatePayment generic payment metodu. Hesap ve kur zorunludur.
+func createPayment(c *Client, ctx context.Context, endpoint string, attributes PaymentInput) (*Payment, error) {
+	if err := c.fillPaymentRate(ctx, &attributes); err != nil {
+		return nil, err
+	}
+	if err := attributes.validate(); err != nil {
+		return nil, err
+	}
 	return create[Payment](c, ctx, endpoint+"/payments", "payments", attributes, nil)
 }
 
@@ -201,24 +250,13 @@ type MeService struct {
 }
 
 func (s *MeService) Get(ctx context.Context, includeParams ...string) (*Me, error) {
-	// /me endpoint doesn't use company_id, so we need to make request differently
+	// /me endpoint'i firma kapsamında değildir, bu yüzden company_id eklenmez
 	endpoint := "/me"
 	if len(includeParams) > 0 {
 		endpoint += "?include=" + includeParams[0]
 	}
 
-	// Direct request to base URL without company_id
-	url := fmt.Sprintf("https://api.parasut.com/v4%s", endpoint)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := s.client.httpClient.Do(req)
+	resp, err := s.client.do(ctx, "GET", s.client.baseURL+endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -248,11 +286,11 @@ func (s *AccountsService) Get(ctx context.Context, id string) (*Account, error)
 	return get[Account](s.client, ctx, fmt.Sprintf("/accounts/%s", id))
 }
 
-func (s *AccountsService) Create(ctx context.Context, attributes AccountAttributes) (*Account, error) {
+func (s *AccountsService) Create(ctx context.Context, attributes AccountInput) (*Account, error) {
 	return create[Account](s.client, ctx, "/accounts", "accounts", attributes, nil)
 }
 
-func (s *AccountsService) Update(ctx context.Context, id string, attributes AccountAttributes) (*Account, error) {
+func (s *AccountsService) Update(ctx context.Context, id string, attributes AccountInput) (*Account, error) {
 	return update[Account](s.client, ctx, fmt.Sprintf("/accounts/%s", id), id, "accounts", attributes, nil)
 }
 
@@ -260,15 +298,15 @@ func (s *AccountsService) Delete(ctx context.Context, id string) error {
 	return deleteResource(s.client, ctx, fmt.Sprintf("/accounts/%s", id))
 }
 
-func (s *AccountsService) GetTransactions(ctx context.Context, accountID string) ([]AccountTransaction, *Meta, error) {
-	return list[AccountTransaction](s.client, ctx, fmt.Sprintf("/accounts/%s/transactions", accountID), nil)
+func (s *AccountsService) GetTransactions(ctx context.Context, accountID string, params *ListParams) ([]AccountTransaction, *Meta, error) {
+	return list[AccountTransaction](s.client, ctx, fmt.Sprintf("/accounts/%s/transactions", accountID), params)
 }
 
-func (s *AccountsService) CreateDebitTransaction(ctx context.Context, accountID string, attributes AccountTransactionAttributes) (*AccountTransaction, error) {
+func (s *AccountsService) CreateDebitTransaction(ctx context.Context, accountID string, attributes AccountTransactionInput) (*AccountTransaction, error) {
 	return create[AccountTransaction](s.client, ctx, fmt.Sprintf("/accounts/%s/debit_transactions", accountID), "account_transactions", attributes, nil)
 }
 
-func (s *AccountsService) CreateCreditTransaction(ctx context.Context, accountID string, attributes AccountTransactionAttributes) (*AccountTransaction, error) {
+func (s *AccountsService) CreateCreditTransaction(ctx context.Context, accountID string, attributes AccountTransactionInput) (*AccountTransaction, error) {
 	return create[AccountTransaction](s.client, ctx, fmt.Sprintf("/accounts/%s/credit_transactions", accountID), "account_transactions", attributes, nil)
 }
 
@@ -285,11 +323,14 @@ func (s *BankFeesService) Get(ctx context.Context, id string) (*BankFee, error)
 	return get[BankFee](s.client, ctx, fmt.Sprintf("/bank_fees/%s", id))
 }
 
-func (s *BankFeesService) Create(ctx context.Context, attributes BankFeeAttributes) (*BankFee, error) {
+func (s *BankFeesService) Create(ctx context.Context, attributes BankFeeInput) (*BankFee, error) {
+	if err := s.client.fillExchangeRate(ctx, attributes.Currency, attributes.IssueDate, &attributes.ExchangeRate); err != nil {
+		return nil, err
+	}
 	return create[BankFee](s.client, ctx, "/bank_fees", "bank_fees", attributes, nil)
 }
 
-func (s *BankFeesService) Update(ctx context.Context, id string, attributes BankFeeAttributes) (*BankFee, error) {
+func (s *BankFeesService) Update(ctx context.Context, id string, attributes BankFeeInput) (*BankFee, error) {
 	return update[BankFee](s.client, ctx, fmt.Sprintf("/bank_fees/%s", id), id, "bank_fees", attributes, nil)
 }
 
@@ -301,7 +342,7 @@ func (s *BankFeesService) Unarchive(ctx context.Context, id string) error {
 	return unarchive(s.client, ctx, fmt.Sprintf("/bank_fees/%s", id))
 }
 
-func (s *BankFeesService) CreatePayment(ctx context.Context, bankFeeID string, attributes PaymentAttributes) (*Payment, error) {
+func (s *BankFeesService) CreatePayment(ctx context.Context, bankFeeID string, attributes PaymentInput) (*Payment, error) {
 	return createPayment(s.client, ctx, fmt.Sprintf("/bank_fees/%s", bankFeeID), attributes)
 }
 
@@ -311,31 +352,172 @@ type ContactsService struct {
 }
 
 func (s *ContactsService) List(ctx context.Context, params *ListParams) ([]Contact, *Meta, error) {
-	return list[Contact](s.client, ctx, "/contacts", params)
+	contacts, included, meta, err := listIncluded[Contact](s.client, ctx, "/contacts", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range contacts {
+		if err := resolveContactIncluded(&contacts[i], included); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return contacts, meta, nil
 }
 
-func (s *ContactsService) Get(ctx context.Context, id string) (*Contact, error) {
-	return get[Contact](s.client, ctx, fmt.Sprintf("/contacts/%s", id))
+// Get müşteri/tedarikçiyi getirir. include ile contact_people, category,
+// contact_portal ilişkileri istenirse Contact üzerindeki ilgili alanlar doldurulur.
+func (s *ContactsService) Get(ctx context.Context, id string, include ...string) (*Contact, error) {
+	contact, included, err := getIncluded[Contact](s.client, ctx, fmt.Sprintf("/contacts/%s", id), strings.Join(include, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resolveContactIncluded(contact, included); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
 }
 
-func (s *ContactsService) Create(ctx context.Context, attributes ContactAttributes) (*Contact, error) {
-	return create[Contact](s.client, ctx, "/contacts", "contacts", attributes, nil)
+func (s *ContactsService) Create(ctx context.Context, attributes ContactInput, relationships *ContactRelationshipsInput) (*Contact, error) {
+	return create[Contact](s.client, ctx, "/contacts", "contacts", attributes, relationships)
 }
 
-func (s *ContactsService) Update(ctx context.Context, id string, attributes ContactAttributes) (*Contact, error) {
-	return update[Contact](s.client, ctx, fmt.Sprintf("/contacts/%s", id), id, "contacts", attributes, nil)
+func (s *ContactsService) Update(ctx context.Context, id string, attributes ContactInput, relationships *ContactRelationshipsInput) (*Contact, error) {
+	return update[Contact](s.client, ctx, fmt.Sprintf("/contacts/%s", id), id, "contacts", attributes, relationships)
 }
 
 func (s *ContactsService) Delete(ctx context.Context, id string) error {
 	return deleteResource(s.client, ctx, fmt.Sprintf("/contacts/%s", id))
 }
 
-func (s *ContactsService) GetDebitTransactions(ctx context.Context, contactID string) ([]ContactTransaction, *Meta, error) {
-	return list[ContactTransaction](s.client, ctx, fmt.Sprintf("/contacts/%s/contact_debit_transactions", contactID), nil)
+func (s *ContactsService) GetDebitTransactions(ctx context.Context, contactID string, params *ListParams) ([]ContactTransaction, *Meta, error) {
+	return list[ContactTransaction](s.client, ctx, fmt.Sprintf("/contacts/%s/contact_debit_transactions", contactID), params)
 }
 
-func (s *ContactsService) GetCreditTransactions(ctx context.Context, contactID string) ([]ContactTransaction, *Meta, error) {
-	return list[ContactTransaction](s.client, ctx, fmt.Sprintf("/contacts/%s/contact_credit_transactions", contactID), nil)
+func (s *ContactsService) GetCreditTransactions(ctx context.Context, contactID string, params *ListParams) ([]ContactTransaction, *Meta, error) {
+	return list[ContactTransaction](s.client, ctx, fmt.Sprintf("/contacts/%s/contact_credit_transactions", contactID), params)
+}
+
+// resolveContactIncluded included kaynaklarından yetkili kişi, kategori ve portal alanlarını doldurur
+func resolveContactIncluded(contact *Contact, included []IncludedResource) error {
+	if len(included) == 0 {
+		return nil
+	}
+
+	if rel := contact.Relationships.ContactPeople; rel != nil {
+		contact.ContactPeople = nil
+		for _, ref := range rel.Data {
+			resource := findIncluded(included, ref)
+			if resource == nil {
+				continue
+			}
+			var person ContactPerson
+			if err := decodeIncluded(resource, &person); err != nil {
+				return err
+			}
+			contact.ContactPeople = append(contact.ContactPeople, person)
+		}
+	}
+
+	if rel := contact.Relationships.Category; rel != nil && rel.Data != nil {
+		if resource := findIncluded(included, *rel.Data); resource != nil {
+			var category ItemCategory
+			if err := decodeIncluded(resource, &category); err != nil {
+				return err
+			}
+			contact.Category = &category
+		}
+	}
+
+	if rel := contact.Relationships.ContactPortal; rel != nil && rel.Data != nil {
+		if resource := findIncluded(included, *rel.Data); resource != nil {
+			var portal ContactPortal
+			if err := decodeIncluded(resource, &portal); err != nil {
+				return err
+			}
+			contact.ContactPortal = &portal
+		}
+	}
+
+	return nil
+}
+
+// PaymentsService Ödemeler servisi. Ödemeler belgeler üzerinden (ör.
+// SalesInvoicesService.CreatePayment) oluşturulur.
+type PaymentsService struct {
+	client *Client
+}
+
+// List belgenin ödemelerini hesap hareketleriyle birlikte döndürür.
+// payableType sales_invoices, purchase_bills, salaries, taxes veya bank_fees olabilir.
+func (s *PaymentsService) List(ctx context.Context, payableType, payableID string) ([]Payment, error) {
+	payable, included, err := getIncluded[IncludedResource](s.client, ctx, fmt.Sprintf("/%s/%s", payableType, payableID), "payments,payments.transaction")
+	if err != nil {
+		return nil, err
+	}
+
+	var relationships struct {
+		Payments []RelationshipData `json:"payments"`
+	}
+	if len(payable.Relationships) > 0 {
+		if err := unmarshalRelationships(payable.Relationships, &relationships); err != nil {
+			return nil, err
+		}
+	}
+
+	payments := []Payment{}
+	for _, ref := range relationships.Payments {
+		resource := findIncluded(included, ref)
+		if resource == nil {
+			continue
+		}
+		var payment Payment
+		if err := decodeIncluded(resource, &payment); err != nil {
+			return nil, err
+		}
+		if err := resolvePaymentIncluded(&payment, included); err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	return payments, nil
+}
+
+// Get ödemeyi hesap hareketiyle birlikte döndürür
+func (s *PaymentsService) Get(ctx context.Context, id string) (*Payment, error) {
+	payment, included, err := getIncluded[Payment](s.client, ctx, fmt.Sprintf("/payments/%s", id), "transaction")
+	if err != nil {
+		return nil, err
+	}
+	if err := resolvePaymentIncluded(payment, included); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// Delete ödemeyi siler; belgenin kalan tutarı ve hesap bakiyesi geri alınır
+func (s *PaymentsService) Delete(ctx context.Context, id string) error {
+	return deleteResource(s.client, ctx, fmt.Sprintf("/payments/%s", id))
+}
+
+// resolvePaymentIncluded included kaynaklarından ödemenin hesap hareketini doldurur
+func resolvePaymentIncluded(payment *Payment, included []IncludedResource) error {
+	if payment.Relationships.Transaction == nil {
+		return nil
+	}
+	resource := findIncluded(included, *payment.Relationships.Transaction)
+	if resource == nil {
+		return nil
+	}
+	var transaction Transaction
+	if err := decodeIncluded(resource, &transaction); err != nil {
+		return err
+	}
+	payment.Transaction = &transaction
+	return nil
 }
 
 // ProductsService Ürünler servisi
@@ -351,11 +533,11 @@ func (s *ProductsService) Get(ctx context.Context, id string) (*Product, error)
 	return get[Product](s.client, ctx, fmt.Sprintf("/products/%s", id))
 }
 
-func (s *ProductsService) Create(ctx context.Context, attributes ProductAttributes) (*Product, error) {
+func (s *ProductsService) Create(ctx context.Context, attributes ProductInput) (*Product, error) {
 	return create[Product](s.client, ctx, "/products", "products", attributes, nil)
 }
 
-func (s *ProductsService) Update(ctx context.Context, id string, attributes ProductAttributes) (*Product, error) {
+func (s *ProductsService) Update(ctx context.Context, id string, attributes ProductInput) (*Product, error) {
 	return update[Product](s.client, ctx, fmt.Sprintf("/products/%s", id), id, "products", attributes, nil)
 }
 
@@ -367,24 +549,92 @@ func (s *ProductsService) GetInventoryLevels(ctx context.Context, productID stri
 	return list[InventoryLevel](s.client, ctx, fmt.Sprintf("/products/%s/inventory_levels", productID), nil)
 }
 
+// RecurrencePlansService Tekrarlama planları servisi
+type RecurrencePlansService struct {
+	client *Client
+}
+
+func (s *RecurrencePlansService) List(ctx context.Context, params *ListParams) ([]RecurrencePlan, *Meta, error) {
+	return list[RecurrencePlan](s.client, ctx, "/recurrence_plans", params)
+}
+
+func (s *RecurrencePlansService) Get(ctx context.Context, id string) (*RecurrencePlan, error) {
+	return get[RecurrencePlan](s.client, ctx, fmt.Sprintf("/recurrence_plans/%s", id))
+}
+
+func (s *RecurrencePlansService) Create(ctx context.Context, attributes RecurrencePlanInput) (*RecurrencePlan, error) {
+	if err := attributes.validate(); err != nil {
+		return nil, err
+	}
+	return create[RecurrencePlan](s.client, ctx, "/recurrence_plans", "recurrence_plans", attributes, nil)
+}
+
+func (s *RecurrencePlansService) Update(ctx context.Context, id string, attributes RecurrencePlanInput) (*RecurrencePlan, error) {
+	if err := attributes.validate(); err != nil {
+		return nil, err
+	}
+	return update[RecurrencePlan](s.client, ctx, fmt.Sprintf("/recurrence_plans/%s", id), id, "recurrence_plans", attributes, nil)
+}
+
+func (s *RecurrencePlansService) Delete(ctx context.Context, id string) error {
+	return deleteResource(s.client, ctx, fmt.Sprintf("/recurrence_plans/%s", id))
+}
+
 // SalesInvoicesService Satış faturaları servisi
 type SalesInvoicesService struct {
 	client *Client
 }
 
 func (s *SalesInvoicesService) List(ctx context.Context, params *ListParams) ([]SalesInvoice, *Meta, error) {
-	return list[SalesInvoice](s.client, ctx, "/sales_invoices", params)
+	invoices, included, meta, err := listIncluded[SalesInvoice](s.client, ctx, "/sales_invoices", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range invoices {
+		if err := resolveSalesInvoiceIncluded(&invoices[i], included); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return invoices, meta, nil
 }
 
-func (s *SalesInvoicesService) Get(ctx context.Context, id string) (*SalesInvoice, error) {
-	return get[SalesInvoice](s.client, ctx, fmt.Sprintf("/sales_invoices/%s", id))
+// Get satış faturasını getirir. include ile details, payments ilişkileri
+// istenirse SalesInvoice üzerindeki Details ve Payments alanları doldurulur.
+func (s *SalesInvoicesService) Get(ctx context.Context, id string, include ...string) (*SalesInvoice, error) {
+	invoice, included, err := getIncluded[SalesInvoice](s.client, ctx, fmt.Sprintf("/sales_invoices/%s", id), strings.Join(include, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resolveSalesInvoiceIncluded(invoice, included); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
 }
 
-func (s *SalesInvoicesService) Create(ctx context.Context, attributes SalesInvoiceAttributes, relationships *SalesInvoiceRelationships) (*SalesInvoice, error) {
+func (s *SalesInvoicesService) Create(ctx context.Context, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships) (*SalesInvoice, error) {
+	if err := s.client.fillExchangeRate(ctx, attributes.Currency, attributes.IssueDate, &attributes.ExchangeRate); err != nil {
+		return nil, err
+	}
 	return create[SalesInvoice](s.client, ctx, "/sales_invoices", "sales_invoices", attributes, relationships)
 }
 
-func (s *SalesInvoicesService) Update(ctx context.Context, id string, attributes SalesInvoiceAttributes, relationships *SalesInvoiceRelationships) (*SalesInvoice, error) {
+// CreateWithDetails satış faturasını kalemleriyle birlikte tek istekte oluşturur.
+// relationships.Details yok sayılır; kalemler details listesinden oluşturulur.
+func (s *SalesInvoicesService) CreateWithDetails(ctx context.Context, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships, details []SalesInvoiceDetailInput) (*SalesInvoice, error) {
+	if len(details) == 0 {
+		return nil, fmt.Errorf("fatura en az bir kalem içermelidir")
+	}
+	if err := s.client.fillExchangeRate(ctx, attributes.Currency, attributes.IssueDate, &attributes.ExchangeRate); err != nil {
+		return nil, err
+	}
+	return create[SalesInvoice](s.client, ctx, "/sales_invoices", "sales_invoices", attributes, salesInvoiceRelationshipsInput{relationships: relationships, details: details})
+}
+
+func (s *SalesInvoicesService) Update(ctx context.Context, id string, attributes SalesInvoiceInput, relationships *SalesInvoiceRelationships) (*SalesInvoice, error) {
 	return update[SalesInvoice](s.client, ctx, fmt.Sprintf("/sales_invoices/%s", id), id, "sales_invoices", attributes, relationships)
 }
 
@@ -404,7 +654,7 @@ func (s *SalesInvoicesService) Unarchive(ctx context.Context, id string) error {
 	return unarchive(s.client, ctx, fmt.Sprintf("/sales_invoices/%s", id))
 }
 
-func (s *SalesInvoicesService) CreatePayment(ctx context.Context, invoiceID string, attributes PaymentAttributes) (*Payment, error) {
+func (s *SalesInvoicesService) CreatePayment(ctx context.Context, invoiceID string, attributes PaymentInput) (*Payment, error) {
 	return createPayment(s.client, ctx, fmt.Sprintf("/sales_invoices/%s", invoiceID), attributes)
 }
 
@@ -416,6 +666,41 @@ func (s *SalesInvoicesService) GetPDF(ctx context.Context, id string) ([]byte, e
 	return getPDF(s.client, ctx, fmt.Sprintf("/sales_invoices/%s/pdf", id))
 }
 
+// resolveSalesInvoiceIncluded included kaynaklarından fatura kalemlerini ve ödemeleri doldurur
+func resolveSalesInvoiceIncluded(invoice *SalesInvoice, included []IncludedResource) error {
+	if len(included) == 0 {
+		return nil
+	}
+
+	invoice.Details = nil
+	for _, ref := range invoice.Relationships.Details {
+		resource := findIncluded(included, ref)
+		if resource == nil {
+			continue
+		}
+		var detail SalesInvoiceDetail
+		if err := decodeIncluded(resource, &detail); err != nil {
+			return err
+		}
+		invoice.Details = append(invoice.Details, detail)
+	}
+
+	invoice.Payments = nil
+	for _, ref := range invoice.Relationships.Payments {
+		resource := findIncluded(included, ref)
+		if resource == nil {
+			continue
+		}
+		var payment Payment
+		if err := decodeIncluded(resource, &payment); err != nil {
+			return err
+		}
+		invoice.Payments = append(invoice.Payments, payment)
+	}
+
+	return nil
+}
+
 // PurchaseBillsService Alış faturaları servisi
 type PurchaseBillsService struct {
 	client *Client
@@ -429,15 +714,18 @@ func (s *PurchaseBillsService) Get(ctx context.Context, id string) (*PurchaseBil
 	return get[PurchaseBill](s.client, ctx, fmt.Sprintf("/purchase_bills/%s", id))
 }
 
-func (s *PurchaseBillsService) Create(ctx context.Context, attributes PurchaseBillAttributes, relationships *PurchaseBillRelationships) (*PurchaseBill, error) {
+func (s *PurchaseBillsService) Create(ctx context.Context, attributes PurchaseBillInput, relationships *PurchaseBillRelationships) (*PurchaseBill, error) {
+	if err := s.client.fillExchangeRate(ctx, attributes.Currency, attributes.IssueDate, &attributes.ExchangeRate); err != nil {
+		return nil, err
+	}
 	return create[PurchaseBill](s.client, ctx, "/purchase_bills", "purchase_bills", attributes, relationships)
 }
 
-func (s *PurchaseBillsService) Update(ctx context.Context, id string, attributes PurchaseBillAttributes, relationships *PurchaseBillRelationships) (*PurchaseBill, error) {
+func (s *PurchaseBillsService) Update(ctx context.Context, id string, attributes PurchaseBillInput, relationships *PurchaseBillRelationships) (*PurchaseBill, error) {
 	return update[PurchaseBill](s.client, ctx, fmt.Sprintf("/purchase_bills/%s", id), id, "purchase_bills", attributes, relationships)
 }
 
-func (s *PurchaseBillsService) CreatePayment(ctx context.Context, billID string, attributes PaymentAttributes) (*Payment, error) {
+func (s *PurchaseBillsService) CreatePayment(ctx context.Context, billID string, attributes PaymentInput) (*Payment, error) {
 	return createPayment(s.client, ctx, fmt.Sprintf("/purchase_bills/%s", billID), attributes)
 }
 
@@ -474,11 +762,11 @@ func (s *EmployeesService) Get(ctx context.Context, id string) (*Employee, error
 	return get[Employee](s.client, ctx, fmt.Sprintf("/employees/%s", id))
 }
 
-func (s *EmployeesService) Create(ctx context.Context, attributes EmployeeAttributes) (*Employee, error) {
+func (s *EmployeesService) Create(ctx context.Context, attributes EmployeeInput) (*Employee, error) {
 	return create[Employee](s.client, ctx, "/employees", "employees", attributes, nil)
 }
 
-func (s *EmployeesService) Update(ctx context.Context, id string, attributes EmployeeAttributes) (*Employee, error) {
+func (s *EmployeesService) Update(ctx context.Context, id string, attributes EmployeeInput) (*Employee, error) {
 	return update[Employee](s.client, ctx, fmt.Sprintf("/employees/%s", id), id, "employees", attributes, nil)
 }
 
@@ -503,11 +791,11 @@ func (s *SalariesService) Get(ctx context.Context, id string) (*Salary, error) {
 	return get[Salary](s.client, ctx, fmt.Sprintf("/salaries/%s", id))
 }
 
-func (s *SalariesService) Create(ctx context.Context, attributes SalaryAttributes, relationships *SalaryRelationships) (*Salary, error) {
+func (s *SalariesService) Create(ctx context.Context, attributes SalaryInput, relationships *SalaryRelationships) (*Salary, error) {
 	return create[Salary](s.client, ctx, "/salaries", "salaries", attributes, relationships)
 }
 
-func (s *SalariesService) Update(ctx context.Context, id string, attributes SalaryAttributes, relationships *SalaryRelationships) (*Salary, error) {
+func (s *SalariesService) Update(ctx context.Context, id string, attributes SalaryInput, relationships *SalaryRelationships) (*Salary, error) {
 	return update[Salary](s.client, ctx, fmt.Sprintf("/salaries/%s", id), id, "salaries", attributes, relationships)
 }
 
@@ -519,7 +807,7 @@ func (s *SalariesService) Unarchive(ctx context.Context, id string) error {
 	return unarchive(s.client, ctx, fmt.Sprintf("/salaries/%s", id))
 }
 
-func (s *SalariesService) CreatePayment(ctx context.Context, salaryID string, attributes PaymentAttributes) (*Payment, error) {
+func (s *SalariesService) CreatePayment(ctx context.Context, salaryID string, attributes PaymentInput) (*Payment, error) {
 	return createPayment(s.client, ctx, fmt.Sprintf("/salaries/%s", salaryID), attributes)
 }
 
@@ -536,11 +824,11 @@ func (s *TaxesService) Get(ctx context.Context, id string) (*Tax, error) {
 	return get[Tax](s.client, ctx, fmt.Sprintf("/taxes/%s", id))
 }
 
-func (s *TaxesService) Create(ctx context.Context, attributes TaxAttributes, relationships *TaxRelationships) (*Tax, error) {
+func (s *TaxesService) Create(ctx context.Context, attributes TaxInput, relationships *TaxRelationships) (*Tax, error) {
 	return create[Tax](s.client, ctx, "/taxes", "taxes", attributes, relationships)
 }
 
-func (s *TaxesService) Update(ctx context.Context, id string, attributes TaxAttributes, relationships *TaxRelationships) (*Tax, error) {
+func (s *TaxesService) Update(ctx context.Context, id string, attributes TaxInput, relationships *TaxRelationships) (*Tax, error) {
 	return update[Tax](s.client, ctx, fmt.Sprintf("/taxes/%s", id), id, "taxes", attributes, relationships)
 }
 
@@ -552,7 +840,7 @@ func (s *TaxesService) Unarchive(ctx context.Context, id string) error {
 	return unarchive(s.client, ctx, fmt.Sprintf("/taxes/%s", id))
 }
 
-func (s *TaxesService) CreatePayment(ctx context.Context, taxID string, attributes PaymentAttributes) (*Payment, error) {
+func (s *TaxesService) CreatePayment(ctx context.Context, taxID string, attributes PaymentInput) (*Payment, error) {
 	return createPayment(s.client, ctx, fmt.Sprintf("/taxes/%s", taxID), attributes)
 }
 
@@ -569,11 +857,11 @@ func (s *TagsService) Get(ctx context.Context, id string) (*Tag, error) {
 	return get[Tag](s.client, ctx, fmt.Sprintf("/tags/%s", id))
 }
 
-func (s *TagsService) Create(ctx context.Context, attributes TagAttributes) (*Tag, error) {
+func (s *TagsService) Create(ctx context.Context, attributes TagInput) (*Tag, error) {
 	return create[Tag](s.client, ctx, "/tags", "tags", attributes, nil)
 }
 
-func (s *TagsService) Update(ctx context.Context, id string, attributes TagAttributes) (*Tag, error) {
+func (s *TagsService) Update(ctx context.Context, id string, attributes TagInput) (*Tag, error) {
 	return update[Tag](s.client, ctx, fmt.Sprintf("/tags/%s", id), id, "tags", attributes, nil)
 }
 
@@ -594,11 +882,11 @@ func (s *WarehousesService) Get(ctx context.Context, id string) (*Warehouse, err
 	return get[Warehouse](s.client, ctx, fmt.Sprintf("/warehouses/%s", id))
 }
 
-func (s *WarehousesService) Create(ctx context.Context, attributes WarehouseAttributes) (*Warehouse, error) {
+func (s *WarehousesService) Create(ctx context.Context, attributes WarehouseInput) (*Warehouse, error) {
 	return create[Warehouse](s.client, ctx, "/warehouses", "warehouses", attributes, nil)
 }
 
-func (s *WarehousesService) Update(ctx context.Context, id string, attributes WarehouseAttributes) (*Warehouse, error) {
+func (s *WarehousesService) Update(ctx context.Context, id string, attributes WarehouseInput) (*Warehouse, error) {
 	return update[Warehouse](s.client, ctx, fmt.Sprintf("/warehouses/%s", id), id, "warehouses", attributes, nil)
 }
 
@@ -628,11 +916,11 @@ func (s *WebhooksService) Get(ctx context.Context, id string) (*Webhook, error)
 	return get[Webhook](s.client, ctx, fmt.Sprintf("/webhooks/%s", id))
 }
 
-func (s *WebhooksService) Create(ctx context.Context, attributes WebhookAttributes) (*Webhook, error) {
+func (s *WebhooksService) Create(ctx context.Context, attributes WebhookInput) (*Webhook, error) {
 	return create[Webhook](s.client, ctx, "/webhooks", "webhooks", attributes, nil)
 }
 
-func (s *WebhooksService) Update(ctx context.Context, id string, attributes WebhookAttributes) (*Webhook, error) {
+func (s *WebhooksService) Update(ctx context.Context, id string, attributes WebhookInput) (*Webhook, error) {
 	return update[Webhook](s.client, ctx, fmt.Sprintf("/webhooks/%s", id), id, "webhooks", attributes, nil)
 }
 
@@ -655,6 +943,11 @@ func (s *EArchivesService) Get(ctx context.Context, id string) (*EArchive, error
 	return get[EArchive](s.client, ctx, fmt.Sprintf("/e_archives/%s", id))
 }
 
+// Create satış faturasını e-arşiv faturası olarak resmileştirir
+func (s *EArchivesService) Create(ctx context.Context, invoiceID string, attributes EArchiveInput) (*EArchive, error) {
+	return create[EArchive](s.client, ctx, "/e_archives", "e_archives", attributes, invoiceRelationship(invoiceID))
+}
+
 func (s *EArchivesService) GetPDF(ctx context.Context, id string) ([]byte, error) {
 	return getPDF(s.client, ctx, fmt.Sprintf("/e_archives/%s/pdf", id))
 }
@@ -681,14 +974,28 @@ func (s *EInvoicesService) Get(ctx context.Context, id string) (*EInvoice, error
 	return get[EInvoice](s.client, ctx, fmt.Sprintf("/e_invoices/%s", id))
 }
 
-func (s *EInvoicesService) Create(ctx context.Context, attributes EInvoiceAttributes) (*EInvoice, error) {
+func (s *EInvoicesService) Create(ctx context.Context, attributes EInvoiceInput) (*EInvoice, error) {
 	return create[EInvoice](s.client, ctx, "/e_invoices", "e_invoices", attributes, nil)
 }
 
+// CreateForInvoice satış faturasını alıcının e-fatura kutusuna gönderir
+func (s *EInvoicesService) CreateForInvoice(ctx context.Context, invoiceID string, attributes EInvoiceInput) (*EInvoice, error) {
+	return create[EInvoice](s.client, ctx, "/e_invoices", "e_invoices", attributes, invoiceRelationship(invoiceID))
+}
+
 func (s *EInvoicesService) GetPDF(ctx context.Context, id string) ([]byte, error) {
 	return getPDF(s.client, ctx, fmt.Sprintf("/e_invoices/%s/pdf", id))
 }
 
+// invoiceRelationship e-belgenin bağlı olduğu satış faturası ilişkisini oluşturur
+func invoiceRelationship(invoiceID string) map[string]interface{} {
+	return map[string]interface{}{
+		"invoice": map[string]interface{}{
+			"data": RelationshipData{ID: invoiceID, Type: "sales_invoices"},
+		},
+	}
+}
+
 // ESMMsService E-SMM servisi
 type ESMMsService struct {
 	client *Client
@@ -702,7 +1009,7 @@ func (s *ESMMsService) Get(ctx context.Context, id string) (*ESMM, error) {
 	return get[ESMM](s.client, ctx, fmt.Sprintf("/e_smms/%s", id))
 }
 
-func (s *ESMMsService) Create(ctx context.Context, attributes ESMMAttributes) (*ESMM, error) {
+func (s *ESMMsService) Create(ctx context.Context, attributes ESMMInput) (*ESMM, error) {
 	return create[ESMM](s.client, ctx, "/e_smms", "e_smms", attributes, nil)
 }
 
@@ -723,11 +1030,11 @@ func (s *ItemCategoriesService) Get(ctx context.Context, id string) (*ItemCatego
 	return get[ItemCategory](s.client, ctx, fmt.Sprintf("/item_categories/%s", id))
 }
 
-func (s *ItemCategoriesService) Create(ctx context.Context, attributes ItemCategoryAttributes) (*ItemCategory, error) {
+func (s *ItemCategoriesService) Create(ctx context.Context, attributes ItemCategoryInput) (*ItemCategory, error) {
 	return create[ItemCategory](s.client, ctx, "/item_categories", "item_categories", attributes, nil)
 }
 
-func (s *ItemCategoriesService) Update(ctx context.Context, id string, attributes ItemCategoryAttributes) (*ItemCategory, error) {
+func (s *ItemCategoriesService) Update(ctx context.Context, id string, attributes ItemCategoryInput) (*ItemCategory, error) {
 	return update[ItemCategory](s.client, ctx, fmt.Sprintf("/item_categories/%s", id), id, "item_categories", attributes, nil)
 }
 
@@ -741,18 +1048,40 @@ type SalesOffersService struct {
 }
 
 func (s *SalesOffersService) List(ctx context.Context, params *ListParams) ([]SalesOffer, *Meta, error) {
-	return list[SalesOffer](s.client, ctx, "/sales_offers", params)
+	offers, included, meta, err := listIncluded[SalesOffer](s.client, ctx, "/sales_offers", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range offers {
+		if err := resolveSalesOfferIncluded(&offers[i], included); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return offers, meta, nil
 }
 
-func (s *SalesOffersService) Get(ctx context.Context, id string) (*SalesOffer, error) {
-	return get[SalesOffer](s.client, ctx, fmt.Sprintf("/sales_offers/%s", id))
+// Get satış teklifini getirir. include ile details ilişkisi istenirse
+// SalesOffer üzerindeki Details alanı doldurulur.
+func (s *SalesOffersService) Get(ctx context.Context, id string, include ...string) (*SalesOffer, error) {
+	offer, included, err := getIncluded[SalesOffer](s.client, ctx, fmt.Sprintf("/sales_offers/%s", id), strings.Join(include, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resolveSalesOfferIncluded(offer, included); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
 }
 
-func (s *SalesOffersService) Create(ctx context.Context, attributes SalesOfferAttributes, relationships *SalesOfferRelationships) (*SalesOffer, error) {
+func (s *SalesOffersService) Create(ctx context.Context, attributes SalesOfferInput, relationships *SalesOfferRelationships) (*SalesOffer, error) {
 	return create[SalesOffer](s.client, ctx, "/sales_offers", "sales_offers", attributes, relationships)
 }
 
-func (s *SalesOffersService) Update(ctx context.Context, id string, attributes SalesOfferAttributes, relationships *SalesOfferRelationships) (*SalesOffer, error) {
+func (s *SalesOffersService) Update(ctx context.Context, id string, attributes SalesOfferInput, relationships *SalesOfferRelationships) (*SalesOffer, error) {
 	return update[SalesOffer](s.client, ctx, fmt.Sprintf("/sales_offers/%s", id), id, "sales_offers", attributes, relationships)
 }
 
@@ -776,7 +1105,10 @@ func (s *SalesOffersService) GetDetails(ctx context.Context, id string) (*SalesO
 	return get[SalesOffer](s.client, ctx, fmt.Sprintf("/sales_offers/%s/details", id))
 }
 
-func (s *SalesOffersService) UpdateStatus(ctx context.Context, id string, status string) (*SalesOffer, error) {
+func (s *SalesOffersService) UpdateStatus(ctx context.Context, id string, status SalesOfferStatus) (*SalesOffer, error) {
+	if !status.Valid() {
+		return nil, fmt.Errorf("geçersiz teklif durumu %q", status)
+	}
 	body := map[string]interface{}{
 		"data": map[string]interface{}{
 			"id":   id,
@@ -789,6 +1121,28 @@ func (s *SalesOffersService) UpdateStatus(ctx context.Context, id string, status
 	return action[SalesOffer](s.client, ctx, fmt.Sprintf("/sales_offers/%s/update_status", id), body)
 }
 
+// resolveSalesOfferIncluded included kaynaklarından teklif kalemlerini doldurur
+func resolveSalesOfferIncluded(offer *SalesOffer, included []IncludedResource) error {
+	if len(included) == 0 {
+		return nil
+	}
+
+	offer.Details = nil
+	for _, ref := range offer.Relationships.Details {
+		resource := findIncluded(included, ref)
+		if resource == nil {
+			continue
+		}
+		var detail SalesOfferDetail
+		if err := decodeIncluded(resource, &detail); err != nil {
+			return err
+		}
+		offer.Details = append(offer.Details, detail)
+	}
+
+	return nil
+}
+
 // SharingsService Paylaşımlar servisi
 type SharingsService struct {
 	client *Client
@@ -798,7 +1152,7 @@ func (s *SharingsService) List(ctx context.Context, params *ListParams) ([]Shari
 	return list[Sharing](s.client, ctx, "/sharings", params)
 }
 
-func (s *SharingsService) Create(ctx context.Context, attributes SharingAttributes) (*Sharing, error) {
+func (s *SharingsService) Create(ctx context.Context, attributes SharingInput) (*Sharing, error) {
 	return create[Sharing](s.client, ctx, "/sharings", "sharings", attributes, nil)
 }
 
@@ -815,11 +1169,11 @@ func (s *ShipmentDocumentsService) Get(ctx context.Context, id string) (*Shipmen
 	return get[ShipmentDocument](s.client, ctx, fmt.Sprintf("/shipment_documents/%s", id))
 }
 
-func (s *ShipmentDocumentsService) Create(ctx context.Context, attributes ShipmentDocumentAttributes) (*ShipmentDocument, error) {
+func (s *ShipmentDocumentsService) Create(ctx context.Context, attributes ShipmentDocumentInput) (*ShipmentDocument, error) {
 	return create[ShipmentDocument](s.client, ctx, "/shipment_documents", "shipment_documents", attributes, nil)
 }
 
-func (s *ShipmentDocumentsService) Update(ctx context.Context, id string, attributes ShipmentDocumentAttributes) (*ShipmentDocument, error) {
+func (s *ShipmentDocumentsService) Update(ctx context.Context, id string, attributes ShipmentDocumentInput) (*ShipmentDocument, error) {
 	return update[ShipmentDocument](s.client, ctx, fmt.Sprintf("/shipment_documents/%s", id), id, "shipment_documents", attributes, nil)
 }
 
@@ -832,7 +1186,7 @@ type StockUpdatesService struct {
 	client *Client
 }
 
-func (s *StockUpdatesService) Create(ctx context.Context, attributes StockUpdateAttributes) (*StockUpdate, error) {
+func (s *StockUpdatesService) Create(ctx context.Context, attributes StockUpdateInput) (*StockUpdate, error) {
 	return create[StockUpdate](s.client, ctx, "/stock_updates", "stock_updates", attributes, nil)
 }
 
@@ -854,7 +1208,7 @@ func (s *TransactionsService) Get(ctx context.Context, id string) (*Transaction,
 	return get[Transaction](s.client, ctx, fmt.Sprintf("/transactions/%s", id))
 }
 
-func (s *TransactionsService) Update(ctx context.Context, id string, attributes TransactionAttributes) (*Transaction, error) {
+func (s *TransactionsService) Update(ctx context.Context, id string, attributes TransactionInput) (*Transaction, error) {
 	return update[Transaction](s.client, ctx, fmt.Sprintf("/transactions/%s", id), id, "transactions", attributes, nil)
 }
 
@@ -306,6 +306,32 @@ func TestGenericArchive(t *testing.T) {
 	}
 }
 
+func TestClient_ListResources(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/123/sales_invoices" || r.URL.Query().Get("include") != "details" {
+			t.Errorf("İstek = %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(`{
+			"data": [{"id": "1", "type": "sales_invoices", "attributes": {"net_total": "100.0", "yeni_alan": true},
+				"relationships": {"details": {"data": [{"id": "5", "type": "sales_invoice_details"}]}}}],
+			"included": [{"id": "5", "type": "sales_invoice_details", "attributes": {"quantity": "1.0"}}],
+			"meta": {"current_page": 1, "total_pages": 1}
+		}`))
+	})
+
+	resources, included, meta, err := client.ListResources(context.Background(), "/sales_invoices", &ListParams{Include: "details"})
+	if err != nil {
+		t.Fatalf("ListResources hata döndü: %v", err)
+	}
+
+	if len(resources) != 1 || resources[0].Type != "sales_invoices" || !strings.Contains(string(resources[0].Attributes), `"yeni_alan": true`) {
+		t.Errorf("Kaynaklar = %+v", resources)
+	}
+	if len(included) != 1 || included[0].ID != "5" || meta.TotalPages != 1 {
+		t.Errorf("Included = %+v, meta = %+v", included, meta)
+	}
+}
+
 // Servis testleri
 
 func TestAccountsService_List(t *testing.T) {
@@ -415,7 +441,7 @@ func TestAccountsService_Create(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	attributes := AccountAttributes{
+	attributes := AccountInput{
 		Name:        "New Account",
 		Currency:    "TRL",
 		AccountType: "cash",
@@ -573,7 +599,7 @@ func TestSalesInvoicesService_Create(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	attributes := SalesInvoiceAttributes{
+	attributes := SalesInvoiceInput{
 		Description: "Test Invoice",
 		IssueDate:   "2023-01-01",
 	}
@@ -625,9 +651,11 @@ func TestSalesInvoicesService_CreatePayment(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	attributes := PaymentAttributes{
-		Date:   "2023-01-01",
-		Amount: 100.0,
+	attributes := PaymentInput{
+		AccountID:    "5",
+		Date:         "2023-01-01",
+		Amount:       100.0,
+		ExchangeRate: 1,
 	}
 
 	payment, err := client.SalesInvoices.CreatePayment(ctx, "1", attributes)
@@ -705,7 +733,7 @@ func TestWebhooksService_CRUD(t *testing.T) {
 		})
 
 		ctx := context.Background()
-		attributes := WebhookAttributes{
+		attributes := WebhookInput{
 			URL:      "https://example.com/webhook",
 			Event:    "sales_invoice.created",
 			IsActive: true,
@@ -748,7 +776,7 @@ func TestWebhooksService_CRUD(t *testing.T) {
 		})
 
 		ctx := context.Background()
-		attributes := WebhookAttributes{
+		attributes := WebhookInput{
 			URL:      "https://updated.com/webhook",
 			Event:    "sales_invoice.updated",
 			IsActive: false,
@@ -796,10 +824,12 @@ func TestCreatePayment(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	attributes := PaymentAttributes{
-		Date:     "2023-01-01",
-		Amount:   500.0,
-		Currency: "TRL",
+	attributes := PaymentInput{
+		AccountID:    "5",
+		Date:         "2023-01-01",
+		Amount:       500.0,
+		ExchangeRate: 1,
+		Currency:     "TRL",
 	}
 
 	payment, err := createPayment(client, ctx, "/test-endpoint", attributes)
@@ -812,3 +842,248 @@ func TestCreatePayment(t *testing.T) {
 		t.Errorf("Payment amount = %f, beklenen 500.0", payment.Attributes.Amount)
 	}
 }
+
+func TestContactsService_GetWithContactPeople(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "contact_people,category" {
+			t.Errorf("include = %s, beklenen contact_people,category", r.URL.Query().Get("include"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"data": {
+				"id": "1",
+				"type": "contacts",
+				"attributes": {"name": "Test Müşteri", "contact_type": "company"},
+				"relationships": {
+					"category": {"data": {"id": "5", "type": "item_categories"}},
+					"contact_people": {"data": [
+						{"id": "10", "type": "contact_people"},
+						{"id": "11", "type": "contact_people"}
+					]}
+				}
+			},
+			"included": [
+				{"id": "10", "type": "contact_people", "attributes": {"name": "Ayşe", "email": "ayse@example.com"}},
+				{"id": "11", "type": "contact_people", "attributes": {"name": "Mehmet", "phone": "+905551234567"}},
+				{"id": "5", "type": "item_categories", "attributes": {"name": "Bayiler"}}
+			]
+		}`))
+	})
+
+	ctx := context.Background()
+	contact, err := client.Contacts.Get(ctx, "1", "contact_people", "category")
+	if err != nil {
+		t.Fatalf("Contacts.Get hata döndü: %v", err)
+	}
+
+	if len(contact.ContactPeople) != 2 {
+		t.Fatalf("ContactPeople sayısı = %d, beklenen 2", len(contact.ContactPeople))
+	}
+
+	if contact.ContactPeople[0].Attributes.Email != "ayse@example.com" {
+		t.Errorf("ContactPeople[0] email = %s, beklenen ayse@example.com", contact.ContactPeople[0].Attributes.Email)
+	}
+
+	if contact.Category == nil || contact.Category.Attributes.Name != "Bayiler" {
+		t.Errorf("Category çözümlenmedi: %+v", contact.Category)
+	}
+}
+
+func TestContactsService_CreateWithContactPeople(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		var requestBody struct {
+			Data struct {
+				Relationships struct {
+					Category struct {
+						Data RelationshipData `json:"data"`
+					} `json:"category"`
+					ContactPeople struct {
+						Data []struct {
+							ID         string                 `json:"id"`
+							Type       string                 `json:"type"`
+							Attributes map[string]interface{} `json:"attributes"`
+						} `json:"data"`
+					} `json:"contact_people"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("Request body decode edilemedi: %v", err)
+		}
+
+		people := requestBody.Data.Relationships.ContactPeople.Data
+		if len(people) != 2 {
+			t.Fatalf("contact_people sayısı = %d, beklenen 2", len(people))
+		}
+
+		if people[0].Type != "contact_people" || people[0].ID != "" {
+			t.Errorf("Yeni kişi = %+v, id olmamalı", people[0])
+		}
+
+		if people[1].ID != "10" {
+			t.Errorf("Mevcut kişi id = %s, beklenen 10", people[1].ID)
+		}
+
+		if people[0].Attributes["name"] != "Ayşe" {
+			t.Errorf("Kişi adı = %v, beklenen Ayşe", people[0].Attributes["name"])
+		}
+
+		if requestBody.Data.Relationships.Category.Data.ID != "5" {
+			t.Errorf("Kategori id = %s, beklenen 5", requestBody.Data.Relationships.Category.Data.ID)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"id": "1", "type": "contacts", "attributes": {"name": "Test Müşteri"}}}`))
+	})
+
+	ctx := context.Background()
+	_, err := client.Contacts.Create(ctx, ContactInput{
+		Name:        "Test Müşteri",
+		ContactType: "company",
+	}, &ContactRelationshipsInput{
+		Category: &RelationshipData{ID: "5", Type: "item_categories"},
+		ContactPeople: []ContactPersonInput{
+			{Name: "Ayşe", Email: "ayse@example.com"},
+			{ID: "10", Name: "Mehmet"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Contacts.Create hata döndü: %v", err)
+	}
+}
+
+func TestContactsService_CreateWithoutRelationships(t *testing.T) {
+	var bodies []map[string]interface{}
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body["data"].(map[string]interface{}))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": "1", "type": "contacts", "attributes": {"name": "Test"}}}`))
+	})
+
+	ctx := context.Background()
+	if _, err := client.Contacts.Create(ctx, ContactInput{Name: "Test"}, nil); err != nil {
+		t.Fatalf("Contacts.Create hata döndü: %v", err)
+	}
+	if _, err := client.Contacts.Update(ctx, "1", ContactInput{Name: "Test"}, nil); err != nil {
+		t.Fatalf("Contacts.Update hata döndü: %v", err)
+	}
+	for i, data := range bodies {
+		if _, ok := data["relationships"]; ok {
+			t.Errorf("%d. istekte relationships gönderilmemeli: %v", i, data)
+		}
+	}
+}
+
+func TestSalesInvoicesService_GetWithDetails(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "details,payments" {
+			t.Errorf("include = %s, beklenen details,payments", r.URL.Query().Get("include"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"data": {
+				"id": "1",
+				"type": "sales_invoices",
+				"attributes": {"item_type": "invoice", "issue_date": "2024-05-01", "net_total": 118},
+				"relationships": {
+					"details": {"data": [{"id": "10", "type": "sales_invoice_details"}]},
+					"payments": {"data": [{"id": "20", "type": "payments"}]}
+				}
+			},
+			"included": [
+				{"id": "10", "type": "sales_invoice_details", "attributes": {"quantity": 2, "unit_price": 50, "vat_rate": 18},
+					"relationships": {"product": {"data": {"id": "7", "type": "products"}}}},
+				{"id": "20", "type": "payments", "attributes": {"date": "2024-05-02", "amount": 118}}
+			]
+		}`))
+	})
+
+	invoice, err := client.SalesInvoices.Get(context.Background(), "1", "details", "payments")
+	if err != nil {
+		t.Fatalf("SalesInvoices.Get hata döndü: %v", err)
+	}
+
+	if len(invoice.Details) != 1 || invoice.Details[0].Attributes.UnitPrice != 50 || invoice.Details[0].Relationships.Product.ID != "7" {
+		t.Errorf("Details çözümlenmedi: %+v", invoice.Details)
+	}
+	if len(invoice.Payments) != 1 || invoice.Payments[0].Attributes.Amount != 118 {
+		t.Errorf("Payments çözümlenmedi: %+v", invoice.Payments)
+	}
+}
+
+func TestPaymentsService_List(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/123/purchase_bills/3" || r.URL.Query().Get("include") != "payments,payments.transaction" {
+			t.Errorf("İstek = %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"data": {"id": "3", "type": "purchase_bills", "attributes": {},
+				"relationships": {"payments": {"data": [{"id": "10", "type": "payments"}, {"id": "11", "type": "payments"}]}}},
+			"included": [
+				{"id": "10", "type": "payments", "attributes": {"amount": 40, "date": "2024-05-01"},
+					"relationships": {"payable": {"data": {"id": "3", "type": "purchase_bills"}}, "transaction": {"data": {"id": "20", "type": "transactions"}}}},
+				{"id": "11", "type": "payments", "attributes": {"amount": 60, "date": "2024-05-02"}},
+				{"id": "20", "type": "transactions", "attributes": {"amount": 40, "date": "2024-05-01"}}
+			]
+		}`))
+	})
+
+	payments, err := client.Payments.List(context.Background(), "purchase_bills", "3")
+	if err != nil {
+		t.Fatalf("Payments.List hata döndü: %v", err)
+	}
+	if len(payments) != 2 || payments[0].ID != "10" || payments[1].Attributes.Amount != 60 {
+		t.Fatalf("Ödemeler = %+v", payments)
+	}
+	if payments[0].Relationships.Payable == nil || payments[0].Relationships.Payable.Type != "purchase_bills" {
+		t.Errorf("Payable = %+v", payments[0].Relationships.Payable)
+	}
+	if payments[0].Transaction == nil || payments[0].Transaction.ID != "20" || payments[0].Transaction.Attributes.Amount != 40 {
+		t.Errorf("Transaction = %+v", payments[0].Transaction)
+	}
+	if payments[1].Transaction != nil {
+		t.Errorf("İkinci ödemenin hareketi olmamalı: %+v", payments[1].Transaction)
+	}
+}
+
+func TestPaymentsService_GetAndDelete(t *testing.T) {
+	var methods []string
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.URL.Query().Get("include") != "transaction" {
+			t.Errorf("include = %s", r.URL.Query().Get("include"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"data": {"id": "10", "type": "payments", "attributes": {"amount": 40, "exchange_rate": 1},
+				"relationships": {"transaction": {"data": {"id": "20", "type": "transactions"}}}},
+			"included": [{"id": "20", "type": "transactions", "attributes": {"amount": 40}}]
+		}`))
+	})
+	ctx := context.Background()
+
+	payment, err := client.Payments.Get(ctx, "10")
+	if err != nil {
+		t.Fatalf("Payments.Get hata döndü: %v", err)
+	}
+	if payment.Attributes.ExchangeRate != 1 || payment.Transaction == nil || payment.Transaction.ID != "20" {
+		t.Errorf("Ödeme = %+v", payment)
+	}
+
+	if err := client.Payments.Delete(ctx, "10"); err != nil {
+		t.Fatalf("Payments.Delete hata döndü: %v", err)
+	}
+	if len(methods) != 2 || methods[0] != "GET /v4/123/payments/10" || methods[1] != "DELETE /v4/123/payments/10" {
+		t.Errorf("İstekler = %v", methods)
+	}
+}
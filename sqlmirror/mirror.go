@@ -0,0 +1,333 @@
+// Package sqlmirror Paraşüt verisini SQL veritabanına aynalar. models.go'daki
+// modeller ilişkisel tablolara (contacts, products, sales_invoices,
+// sales_invoice_details, payments, tags ve bağlantı tabloları) eşlenir;
+// nitelik sütunları modellerin JSON adlarını taşır, RelationshipData
+// ilişkileri yabancı anahtar olarak saklanır.
+//
+// Paket herhangi bir veritabanı sürücüsü içermez; database/sql ile açılmış
+// SQLite veya Postgres bağlantısıyla çalışır:
+//
+//	db, _ := sql.Open("sqlite3", "parasut.db") // veya "pgx", "postgres"
+//	mirror := sqlmirror.New(db, sqlmirror.SQLite)
+//	mirror.CreateSchema(ctx)
+//	result, err := mirror.Sync(ctx, client)
+package sqlmirror
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// Mirror veritabanı aynası
+type Mirror struct {
+	DB      *sql.DB
+	Dialect Dialect
+}
+
+// New verilen bağlantı ve lehçe için Mirror oluşturur
+func New(db *sql.DB, dialect Dialect) *Mirror {
+	return &Mirror{DB: db, Dialect: dialect}
+}
+
+// execer *sql.DB ve *sql.Tx'in ortak metodu
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// CreateSchema tabloları ve indeksleri yoksa oluşturur
+func (m *Mirror) CreateSchema(ctx context.Context) error {
+	return m.inTx(ctx, func(tx execer) error {
+		for _, statement := range Schema(m.Dialect) {
+			if _, err := tx.ExecContext(ctx, statement); err != nil {
+				return fmt.Errorf("şema oluşturulamadı: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// SyncResult Sync ile tablolara yazılan kayıt sayıları
+type SyncResult map[string]int
+
+// Sync etiketleri, müşterileri, ürünleri ve kalemleri/ödemeleriyle satış
+// faturalarını servislerden çekip tek bir işlemde aynaya yazar
+func (m *Mirror) Sync(ctx context.Context, client *parasut.Client) (SyncResult, error) {
+	tags, err := parasut.ListAll(ctx, client.Tags.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("etiketler alınamadı: %w", err)
+	}
+	contacts, err := parasut.ListAll(ctx, client.Contacts.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("müşteriler alınamadı: %w", err)
+	}
+	products, err := parasut.ListAll(ctx, client.Products.List, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ürünler alınamadı: %w", err)
+	}
+	invoices, err := parasut.ListAll(ctx, client.SalesInvoices.List, &parasut.ListParams{Include: "details,payments"})
+	if err != nil {
+		return nil, fmt.Errorf("satış faturaları alınamadı: %w", err)
+	}
+
+	result := SyncResult{}
+	err = m.inTx(ctx, func(tx execer) error {
+		for _, tag := range tags {
+			if err := m.upsertRow(ctx, tx, "tags", tag.ID, tag.Attributes, nil); err != nil {
+				return err
+			}
+		}
+		for _, contact := range contacts {
+			if err := m.upsertRow(ctx, tx, "contacts", contact.ID, contact.Attributes, nil); err != nil {
+				return err
+			}
+		}
+		for _, product := range products {
+			if err := m.upsertRow(ctx, tx, "products", product.ID, product.Attributes, nil); err != nil {
+				return err
+			}
+		}
+		for _, invoice := range invoices {
+			if err := m.upsertSalesInvoice(ctx, tx, invoice, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result["tags"] = len(tags)
+	result["contacts"] = len(contacts)
+	result["products"] = len(products)
+	result["sales_invoices"] = len(invoices)
+	return result, nil
+}
+
+// UpsertTags etiketleri ekler veya günceller
+func (m *Mirror) UpsertTags(ctx context.Context, tags ...parasut.Tag) error {
+	return m.inTx(ctx, func(tx execer) error {
+		for _, tag := range tags {
+			if err := m.upsertRow(ctx, tx, "tags", tag.ID, tag.Attributes, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpsertContacts müşteri/tedarikçileri ekler veya günceller
+func (m *Mirror) UpsertContacts(ctx context.Context, contacts ...parasut.Contact) error {
+	return m.inTx(ctx, func(tx execer) error {
+		for _, contact := range contacts {
+			if err := m.upsertRow(ctx, tx, "contacts", contact.ID, contact.Attributes, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpsertProducts ürünleri ekler veya günceller
+func (m *Mirror) UpsertProducts(ctx context.Context, products ...parasut.Product) error {
+	return m.inTx(ctx, func(tx execer) error {
+		for _, product := range products {
+			if err := m.upsertRow(ctx, tx, "products", product.ID, product.Attributes, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpsertPayments ödemeleri ekler veya günceller
+func (m *Mirror) UpsertPayments(ctx context.Context, payments ...parasut.Payment) error {
+	return m.inTx(ctx, func(tx execer) error {
+		for _, payment := range payments {
+			if err := m.upsertRow(ctx, tx, "payments", payment.ID, payment.Attributes, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpsertSalesInvoices satış faturalarını ekler veya günceller. Fatura
+// include=details ile alındıysa kalemleri, include=payments ile alındıysa
+// ödemeleri yenilenir; etiket bağlantıları Relationships.Tags'ten yazılır.
+// Başvurulan müşteri, ürün ve etiketlerin önceden aynalanmış olması gerekir.
+func (m *Mirror) UpsertSalesInvoices(ctx context.Context, invoices ...parasut.SalesInvoice) error {
+	return m.inTx(ctx, func(tx execer) error {
+		for _, invoice := range invoices {
+			if err := m.upsertSalesInvoice(ctx, tx, invoice, SyncResult{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (m *Mirror) upsertSalesInvoice(ctx context.Context, tx execer, invoice parasut.SalesInvoice, result SyncResult) error {
+	if err := m.upsertRow(ctx, tx, "sales_invoices", invoice.ID, invoice.Attributes, map[string]*string{
+		"contact_id": relationshipID(invoice.Relationships.Contact),
+	}); err != nil {
+		return err
+	}
+
+	if invoice.Details != nil {
+		if err := m.deleteWhere(ctx, tx, "sales_invoice_details", "sales_invoice_id", invoice.ID); err != nil {
+			return err
+		}
+		for _, detail := range invoice.Details {
+			if err := m.upsertRow(ctx, tx, "sales_invoice_details", detail.ID, detail.Attributes, map[string]*string{
+				"sales_invoice_id": &invoice.ID,
+				"product_id":       relationshipID(detail.Relationships.Product),
+			}); err != nil {
+				return err
+			}
+		}
+		result["sales_invoice_details"] += len(invoice.Details)
+	}
+
+	if invoice.Payments != nil {
+		if err := m.deleteWhere(ctx, tx, "sales_invoice_payments", "sales_invoice_id", invoice.ID); err != nil {
+			return err
+		}
+		for _, payment := range invoice.Payments {
+			if err := m.upsertRow(ctx, tx, "payments", payment.ID, payment.Attributes, nil); err != nil {
+				return err
+			}
+			if err := m.link(ctx, tx, "sales_invoice_payments", invoice.ID, payment.ID); err != nil {
+				return err
+			}
+		}
+		result["payments"] += len(invoice.Payments)
+	}
+
+	if invoice.Relationships.Tags != nil {
+		if err := m.deleteWhere(ctx, tx, "sales_invoice_tags", "sales_invoice_id", invoice.ID); err != nil {
+			return err
+		}
+		for _, tag := range invoice.Relationships.Tags {
+			if err := m.link(ctx, tx, "sales_invoice_tags", invoice.ID, tag.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// upsertRow nitelik struct'ını ve yabancı anahtarları id ile ekler veya günceller
+func (m *Mirror) upsertRow(ctx context.Context, tx execer, tableName, id string, attributes interface{}, foreignKeys map[string]*string) error {
+	t := tableByName(tableName)
+	v := reflect.ValueOf(attributes)
+
+	columns := []string{"id"}
+	args := []interface{}{id}
+	for _, c := range t.columns {
+		value, err := columnValue(v.Field(c.index), c.kind)
+		if err != nil {
+			return fmt.Errorf("%s/%s %s: %w", tableName, id, c.name, err)
+		}
+		columns = append(columns, c.name)
+		args = append(args, value)
+	}
+	for _, fk := range t.foreignKeys {
+		columns = append(columns, fk.column)
+		if value := foreignKeys[fk.column]; value != nil {
+			args = append(args, *value)
+		} else {
+			args = append(args, nil)
+		}
+	}
+
+	quoted := make([]string, len(columns))
+	placeholders := make([]string, len(columns))
+	var updates []string
+	for i, name := range columns {
+		quoted[i] = quote(name)
+		placeholders[i] = m.Dialect.placeholder(i + 1)
+		if name != "id" {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", quoted[i], quoted[i]))
+		}
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s) ON CONFLICT ("id") DO UPDATE SET %s`,
+		quote(tableName), strings.Join(quoted, ", "), strings.Join(placeholders, ", "), strings.Join(updates, ", "))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s/%s yazılamadı: %w", tableName, id, err)
+	}
+	return nil
+}
+
+// link bağlantı tablosuna satır ekler
+func (m *Mirror) link(ctx context.Context, tx execer, tableName, left, right string) error {
+	t := tableByName(tableName)
+	query := fmt.Sprintf("INSERT INTO %s (%s, %s) VALUES (%s, %s) ON CONFLICT DO NOTHING",
+		quote(tableName), quote(t.foreignKeys[0].column), quote(t.foreignKeys[1].column),
+		m.Dialect.placeholder(1), m.Dialect.placeholder(2))
+	if _, err := tx.ExecContext(ctx, query, left, right); err != nil {
+		return fmt.Errorf("%s yazılamadı: %w", tableName, err)
+	}
+	return nil
+}
+
+func (m *Mirror) deleteWhere(ctx context.Context, tx execer, tableName, column, value string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s = %s", quote(tableName), quote(column), m.Dialect.placeholder(1))
+	if _, err := tx.ExecContext(ctx, query, value); err != nil {
+		return fmt.Errorf("%s silinemedi: %w", tableName, err)
+	}
+	return nil
+}
+
+// inTx fn'i bir işlem içinde çalıştırır; hata olursa işlem geri alınır
+func (m *Mirror) inTx(ctx context.Context, fn func(tx execer) error) error {
+	tx, err := m.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// columnValue alan değerini veritabanı sürücüsüne verilecek değere çevirir
+func columnValue(v reflect.Value, kind columnKind) (interface{}, error) {
+	switch kind {
+	case kindTimestamp:
+		t, _ := v.Interface().(*time.Time)
+		if t == nil {
+			return nil, nil
+		}
+		return t.UTC(), nil
+	case kindInteger:
+		return v.Int(), nil
+	case kindJSON:
+		if v.IsNil() {
+			return nil, nil
+		}
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+	return v.Interface(), nil
+}
+
+func relationshipID(ref *parasut.RelationshipData) *string {
+	if ref == nil || ref.ID == "" {
+		return nil
+	}
+	return &ref.ID
+}
@@ -0,0 +1,251 @@
+package sqlmirror
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+	"github.com/parevo-lab/parasut/parasuttest"
+)
+
+// statement fake sürücüye gelen bir ifade
+type statement struct {
+	query string
+	args  []driver.Value
+}
+
+// recorder çalıştırılan ifadeleri kaydeden sahte database/sql sürücüsü
+type recorder struct {
+	mu         sync.Mutex
+	statements []statement
+	commits    int
+	rollbacks  int
+	fail       string
+}
+
+func (r *recorder) Connect(ctx context.Context) (driver.Conn, error) { return &conn{r}, nil }
+func (r *recorder) Driver() driver.Driver                            { return nil }
+
+func (r *recorder) find(prefix string) []statement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []statement
+	for _, s := range r.statements {
+		if strings.HasPrefix(s.query, prefix) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+type conn struct{ r *recorder }
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) { return nil, errors.New("desteklenmiyor") }
+func (c *conn) Close() error                              { return nil }
+func (c *conn) Begin() (driver.Tx, error)                 { return &tx{c.r}, nil }
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+
+	if c.r.fail != "" && strings.HasPrefix(query, c.r.fail) {
+		return nil, errors.New("kısıt ihlali")
+	}
+	s := statement{query: query}
+	for _, arg := range args {
+		s.args = append(s.args, arg.Value)
+	}
+	c.r.statements = append(c.r.statements, s)
+	return driver.RowsAffected(1), nil
+}
+
+type tx struct{ r *recorder }
+
+func (t *tx) Commit() error {
+	t.r.mu.Lock()
+	defer t.r.mu.Unlock()
+	t.r.commits++
+	return nil
+}
+
+func (t *tx) Rollback() error {
+	t.r.mu.Lock()
+	defer t.r.mu.Unlock()
+	t.r.rollbacks++
+	return nil
+}
+
+func newMirror(t *testing.T, dialect Dialect) (*Mirror, *recorder) {
+	t.Helper()
+	r := &recorder{}
+	db := sql.OpenDB(r)
+	t.Cleanup(func() { db.Close() })
+	return New(db, dialect), r
+}
+
+func TestMirror_CreateSchema(t *testing.T) {
+	mirror, r := newMirror(t, Postgres)
+	if err := mirror.CreateSchema(context.Background()); err != nil {
+		t.Fatalf("CreateSchema hata döndü: %v", err)
+	}
+	if got := len(r.find("CREATE ")); got != len(Schema(Postgres)) {
+		t.Errorf("Çalıştırılan ifade sayısı = %d", got)
+	}
+	if r.commits != 1 {
+		t.Errorf("Commit sayısı = %d", r.commits)
+	}
+}
+
+func TestMirror_UpsertContacts(t *testing.T) {
+	mirror, r := newMirror(t, Postgres)
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("TRT", 3*60*60))
+	err := mirror.UpsertContacts(context.Background(), parasut.Contact{
+		ID:         "7",
+		Attributes: parasut.ContactAttributes{Name: "Acme", Email: "info@acme.com", CreatedAt: &created},
+	})
+	if err != nil {
+		t.Fatalf("UpsertContacts hata döndü: %v", err)
+	}
+
+	inserts := r.find(`INSERT INTO "contacts"`)
+	if len(inserts) != 1 {
+		t.Fatalf("INSERT sayısı = %d", len(inserts))
+	}
+	s := inserts[0]
+	if !strings.Contains(s.query, `VALUES ($1, $2,`) || !strings.Contains(s.query, `ON CONFLICT ("id") DO UPDATE SET "email" = excluded."email", "name" = excluded."name"`) {
+		t.Errorf("Sorgu = %s", s.query)
+	}
+	if strings.Contains(s.query, `"id" = excluded`) {
+		t.Error("id güncellenmemeli")
+	}
+
+	values := map[string]driver.Value{}
+	columns := s.query[strings.Index(s.query, "(")+1 : strings.Index(s.query, ")")]
+	for i, name := range strings.Split(columns, ", ") {
+		values[strings.Trim(name, `"`)] = s.args[i]
+	}
+	if values["id"] != "7" || values["name"] != "Acme" || values["email"] != "info@acme.com" {
+		t.Errorf("Değerler = %v", values)
+	}
+	if got, ok := values["created_at"].(time.Time); !ok || !got.Equal(created) || got.Location() != time.UTC {
+		t.Errorf("created_at = %v", values["created_at"])
+	}
+	if values["updated_at"] != nil {
+		t.Errorf("updated_at = %v, want nil", values["updated_at"])
+	}
+}
+
+func TestMirror_UpsertSalesInvoices(t *testing.T) {
+	mirror, r := newMirror(t, SQLite)
+	invoice := parasut.SalesInvoice{
+		ID:         "10",
+		Attributes: parasut.SalesInvoiceAttributes{IssueDate: "2024-05-01", NetTotal: 100},
+		Relationships: parasut.SalesInvoiceRelationships{
+			Contact: &parasut.RelationshipData{ID: "7", Type: "contacts"},
+			Tags:    []parasut.RelationshipData{{ID: "3", Type: "tags"}},
+		},
+		Details: []parasut.SalesInvoiceDetail{{
+			ID:            "20",
+			Attributes:    parasut.SalesInvoiceDetailAttributes{Quantity: 2, UnitPrice: 50},
+			Relationships: parasut.SalesInvoiceDetailRelationships{Product: &parasut.RelationshipData{ID: "5", Type: "products"}},
+		}},
+	}
+	if err := mirror.UpsertSalesInvoices(context.Background(), invoice); err != nil {
+		t.Fatalf("UpsertSalesInvoices hata döndü: %v", err)
+	}
+
+	invoices := r.find(`INSERT INTO "sales_invoices"`)
+	if len(invoices) != 1 || !strings.Contains(invoices[0].query, `VALUES (?, ?,`) {
+		t.Fatalf("Fatura INSERT = %+v", invoices)
+	}
+	if args := invoices[0].args; args[len(args)-1] != "7" {
+		t.Errorf("contact_id = %v", args[len(args)-1])
+	}
+
+	details := r.find(`INSERT INTO "sales_invoice_details"`)
+	if len(details) != 1 {
+		t.Fatalf("Kalem INSERT sayısı = %d", len(details))
+	}
+	if args := details[0].args; args[len(args)-2] != "10" || args[len(args)-1] != "5" {
+		t.Errorf("Kalem yabancı anahtarları = %v", args[len(args)-2:])
+	}
+	if deletes := r.find(`DELETE FROM "sales_invoice_details" WHERE "sales_invoice_id" = ?`); len(deletes) != 1 {
+		t.Errorf("Kalem DELETE sayısı = %d", len(deletes))
+	}
+
+	links := r.find(`INSERT INTO "sales_invoice_tags"`)
+	if len(links) != 1 || links[0].args[0] != "10" || links[0].args[1] != "3" || !strings.HasSuffix(links[0].query, "ON CONFLICT DO NOTHING") {
+		t.Errorf("Etiket bağlantıları = %+v", links)
+	}
+
+	// Ödemeler include edilmediyse bağlantılarına dokunulmamalı
+	if got := r.find(`DELETE FROM "sales_invoice_payments"`); len(got) != 0 {
+		t.Errorf("Ödeme bağlantıları silinmemeli: %+v", got)
+	}
+}
+
+func TestMirror_Rollback(t *testing.T) {
+	mirror, r := newMirror(t, SQLite)
+	r.fail = `INSERT INTO "tags"`
+	err := mirror.UpsertTags(context.Background(), parasut.Tag{ID: "1", Attributes: parasut.TagAttributes{Name: "a"}})
+	if err == nil || !strings.Contains(err.Error(), "tags/1") {
+		t.Fatalf("Hata = %v", err)
+	}
+	if r.rollbacks != 1 || r.commits != 0 {
+		t.Errorf("commits = %d, rollbacks = %d", r.commits, r.rollbacks)
+	}
+}
+
+func TestMirror_Sync(t *testing.T) {
+	client, server := parasuttest.NewClient(t)
+	tag := server.Seed("tags", map[string]interface{}{"name": "öncelikli"}, nil)
+	contact := server.Seed("contacts", map[string]interface{}{"name": "Acme"}, nil)
+	product := server.Seed("products", map[string]interface{}{"name": "Vida"}, nil)
+	detail := server.Seed("sales_invoice_details", map[string]interface{}{"quantity": 2, "unit_price": 50}, map[string]interface{}{
+		"product": parasut.RelationshipData{ID: product, Type: "products"},
+	})
+	payment := server.Seed("payments", map[string]interface{}{"date": "2024-05-02", "amount": 100}, nil)
+	invoice := server.Seed("sales_invoices", map[string]interface{}{"issue_date": "2024-05-01", "net_total": 100}, map[string]interface{}{
+		"contact":  parasut.RelationshipData{ID: contact, Type: "contacts"},
+		"details":  []parasut.RelationshipData{{ID: detail, Type: "sales_invoice_details"}},
+		"payments": []parasut.RelationshipData{{ID: payment, Type: "payments"}},
+		"tags":     []parasut.RelationshipData{{ID: tag, Type: "tags"}},
+	})
+
+	mirror, r := newMirror(t, SQLite)
+	result, err := mirror.Sync(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Sync hata döndü: %v", err)
+	}
+
+	want := SyncResult{"tags": 1, "contacts": 1, "products": 1, "sales_invoices": 1, "sales_invoice_details": 1, "payments": 1}
+	for name, count := range want {
+		if result[name] != count {
+			t.Errorf("%s = %d, want %d", name, result[name], count)
+		}
+	}
+	if r.commits != 1 {
+		t.Errorf("Commit sayısı = %d", r.commits)
+	}
+
+	details := r.find(`INSERT INTO "sales_invoice_details"`)
+	if len(details) != 1 || details[0].args[0] != detail {
+		t.Fatalf("Kalemler = %+v", details)
+	}
+	if args := details[0].args; args[len(args)-2] != invoice || args[len(args)-1] != product {
+		t.Errorf("Kalem yabancı anahtarları = %v", args[len(args)-2:])
+	}
+	if links := r.find(`INSERT INTO "sales_invoice_payments"`); len(links) != 1 || links[0].args[1] != payment {
+		t.Errorf("Ödeme bağlantıları = %+v", links)
+	}
+	if links := r.find(`INSERT INTO "sales_invoice_tags"`); len(links) != 1 || links[0].args[1] != tag {
+		t.Errorf("Etiket bağlantıları = %+v", links)
+	}
+}
@@ -0,0 +1,215 @@
+package sqlmirror
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/parevo-lab/parasut"
+)
+
+// Dialect SQL lehçesi
+type Dialect int
+
+const (
+	SQLite Dialect = iota
+	Postgres
+)
+
+// columnKind sütunun mantıksal tipi
+type columnKind int
+
+const (
+	kindText columnKind = iota
+	kindReal
+	kindInteger
+	kindBoolean
+	kindTimestamp
+	kindJSON
+)
+
+// placeholder n. (1 tabanlı) parametre yer tutucusunu döndürür
+func (d Dialect) placeholder(n int) string {
+	if d == Postgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// columnType mantıksal tipin lehçedeki karşılığını döndürür
+func (d Dialect) columnType(kind columnKind) string {
+	postgres := d == Postgres
+	switch kind {
+	case kindReal:
+		if postgres {
+			return "DOUBLE PRECISION"
+		}
+		return "REAL"
+	case kindInteger:
+		if postgres {
+			return "BIGINT"
+		}
+		return "INTEGER"
+	case kindBoolean:
+		return "BOOLEAN"
+	case kindTimestamp:
+		if postgres {
+			return "TIMESTAMPTZ"
+		}
+		return "TIMESTAMP"
+	case kindJSON:
+		if postgres {
+			return "JSONB"
+		}
+		return "TEXT"
+	}
+	return "TEXT"
+}
+
+// column nitelik struct'ındaki bir alana karşılık gelen sütun
+type column struct {
+	name  string
+	kind  columnKind
+	index int
+}
+
+// foreignKey başka tabloya id ile bağlanan sütun
+type foreignKey struct {
+	column     string
+	references string
+}
+
+// table aynalanan tablo tanımı. attributes boşsa tablo yalnızca foreignKeys
+// sütunlarından oluşan bir bağlantı tablosudur.
+type table struct {
+	name        string
+	attributes  reflect.Type
+	foreignKeys []foreignKey
+	columns     []column
+}
+
+// tables şema sırasıyla (başvurulan tablolar önce) aynalanan tablolar
+var tables = []*table{
+	newTable("tags", parasut.TagAttributes{}),
+	newTable("contacts", parasut.ContactAttributes{}),
+	newTable("products", parasut.ProductAttributes{}),
+	newTable("sales_invoices", parasut.SalesInvoiceAttributes{},
+		foreignKey{"contact_id", "contacts"}),
+	newTable("sales_invoice_details", parasut.SalesInvoiceDetailAttributes{},
+		foreignKey{"sales_invoice_id", "sales_invoices"},
+		foreignKey{"product_id", "products"}),
+	newTable("payments", parasut.PaymentAttributes{}),
+	newTable("sales_invoice_payments", nil,
+		foreignKey{"sales_invoice_id", "sales_invoices"},
+		foreignKey{"payment_id", "payments"}),
+	newTable("sales_invoice_tags", nil,
+		foreignKey{"sales_invoice_id", "sales_invoices"},
+		foreignKey{"tag_id", "tags"}),
+}
+
+var timeType = reflect.TypeOf(&time.Time{})
+
+// newTable nitelik struct'ının alanlarından sütunları çıkarır. Sütun adları
+// alanların JSON adlarıdır.
+func newTable(name string, attributes interface{}, foreignKeys ...foreignKey) *table {
+	t := &table{name: name, foreignKeys: foreignKeys}
+	if attributes == nil {
+		return t
+	}
+
+	t.attributes = reflect.TypeOf(attributes)
+	for i := 0; i < t.attributes.NumField(); i++ {
+		field := t.attributes.Field(i)
+		columnName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if columnName == "" || columnName == "-" {
+			continue
+		}
+
+		var kind columnKind
+		switch {
+		case field.Type == timeType:
+			kind = kindTimestamp
+		case field.Type.Kind() == reflect.String:
+			kind = kindText
+		case field.Type.Kind() == reflect.Float64:
+			kind = kindReal
+		case field.Type.Kind() == reflect.Int:
+			kind = kindInteger
+		case field.Type.Kind() == reflect.Bool:
+			kind = kindBoolean
+		case field.Type.Kind() == reflect.Slice, field.Type.Kind() == reflect.Map:
+			kind = kindJSON
+		default:
+			continue
+		}
+		t.columns = append(t.columns, column{name: columnName, kind: kind, index: i})
+	}
+	return t
+}
+
+// tableByName adı verilen tabloyu döndürür
+func tableByName(name string) *table {
+	for _, t := range tables {
+		if t.name == name {
+			return t
+		}
+	}
+	panic("sqlmirror: bilinmeyen tablo " + name)
+}
+
+// createStatement tablonun CREATE TABLE ifadesini üretir. Yabancı anahtarlar
+// işlem sonunda denetlenir; böylece aynı işlemde sırası fark etmeksizin
+// kayıt yazılabilir.
+func (t *table) createStatement(d Dialect) string {
+	var lines []string
+	if t.attributes != nil {
+		lines = append(lines, `"id" TEXT PRIMARY KEY`)
+	}
+	for _, c := range t.columns {
+		lines = append(lines, quote(c.name)+" "+d.columnType(c.kind))
+	}
+
+	var keys []string
+	for _, fk := range t.foreignKeys {
+		definition := fmt.Sprintf(`%s TEXT REFERENCES %s ("id")`, quote(fk.column), quote(fk.references))
+		if t.attributes == nil {
+			definition = fmt.Sprintf(`%s TEXT NOT NULL REFERENCES %s ("id") ON DELETE CASCADE`, quote(fk.column), quote(fk.references))
+		}
+		lines = append(lines, definition+" DEFERRABLE INITIALLY DEFERRED")
+		keys = append(keys, quote(fk.column))
+	}
+	if t.attributes == nil {
+		lines = append(lines, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(keys, ", ")))
+	}
+
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n\t%s\n)", quote(t.name), strings.Join(lines, ",\n\t"))
+}
+
+// indexStatements yabancı anahtar sütunları için indeks ifadelerini üretir
+func (t *table) indexStatements() []string {
+	if t.attributes == nil {
+		return nil
+	}
+	var statements []string
+	for _, fk := range t.foreignKeys {
+		statements = append(statements, fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)",
+			quote(t.name+"_"+fk.column+"_idx"), quote(t.name), quote(fk.column)))
+	}
+	return statements
+}
+
+// Schema lehçe için tüm CREATE TABLE ve CREATE INDEX ifadelerini döndürür
+func Schema(d Dialect) []string {
+	var statements []string
+	for _, t := range tables {
+		statements = append(statements, t.createStatement(d))
+		statements = append(statements, t.indexStatements()...)
+	}
+	return statements
+}
+
+// quote tanımlayıcıyı çift tırnakla korur
+func quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
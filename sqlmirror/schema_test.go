@@ -0,0 +1,52 @@
+package sqlmirror
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	sqlite := strings.Join(Schema(SQLite), ";\n")
+	postgres := strings.Join(Schema(Postgres), ";\n")
+
+	for _, want := range []string{
+		`CREATE TABLE IF NOT EXISTS "contacts"`,
+		`"id" TEXT PRIMARY KEY`,
+		`"net_total" REAL`,
+		`"created_at" TIMESTAMP`,
+		`"contact_id" TEXT REFERENCES "contacts" ("id") DEFERRABLE INITIALLY DEFERRED`,
+		`"payment_id" TEXT NOT NULL REFERENCES "payments" ("id") ON DELETE CASCADE`,
+		`PRIMARY KEY ("sales_invoice_id", "tag_id")`,
+		`CREATE INDEX IF NOT EXISTS "sales_invoice_details_product_id_idx" ON "sales_invoice_details" ("product_id")`,
+	} {
+		if !strings.Contains(sqlite, want) {
+			t.Errorf("SQLite şemasında %q yok", want)
+		}
+	}
+
+	for _, want := range []string{`"net_total" DOUBLE PRECISION`, `"created_at" TIMESTAMPTZ`} {
+		if !strings.Contains(postgres, want) {
+			t.Errorf("Postgres şemasında %q yok", want)
+		}
+	}
+
+	// Başvurulan tablolar başvuranlardan önce oluşturulmalı
+	if strings.Index(sqlite, `TABLE IF NOT EXISTS "sales_invoices"`) > strings.Index(sqlite, `TABLE IF NOT EXISTS "sales_invoice_details"`) ||
+		strings.Index(sqlite, `TABLE IF NOT EXISTS "contacts"`) > strings.Index(sqlite, `TABLE IF NOT EXISTS "sales_invoices"`) {
+		t.Error("Tablolar bağımlılık sırasında değil")
+	}
+}
+
+func TestNewTable(t *testing.T) {
+	details := tableByName("sales_invoice_details")
+	var names []string
+	for _, c := range details.columns {
+		names = append(names, c.name)
+	}
+	if got := strings.Join(names, ","); !strings.HasPrefix(got, "quantity,unit_price,vat_rate,net_total") || !strings.HasSuffix(got, "created_at,updated_at") {
+		t.Errorf("Sütunlar = %s", got)
+	}
+	if len(tableByName("sales_invoice_tags").columns) != 0 {
+		t.Error("Bağlantı tablosunda nitelik sütunu olmamalı")
+	}
+}
@@ -0,0 +1,336 @@
+package parasut
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// StatementEntryKind ekstre satırının kaynağı
+type StatementEntryKind string
+
+const (
+	StatementEntryOpening      StatementEntryKind = "opening"
+	StatementEntrySalesInvoice StatementEntryKind = "sales_invoice"
+	StatementEntryPurchaseBill StatementEntryKind = "purchase_bill"
+	StatementEntryDebit        StatementEntryKind = "debit_transaction"
+	StatementEntryCredit       StatementEntryKind = "credit_transaction"
+)
+
+// StatementEntry ekstre satırı. Balance aynı döviz cinsindeki yürüyen bakiyedir;
+// pozitif bakiye müşterinin/tedarikçinin borçlu olduğunu gösterir.
+type StatementEntry struct {
+	Date        string             `json:"date"`
+	Kind        StatementEntryKind `json:"kind"`
+	ID          string             `json:"id,omitempty"`
+	DocumentNo  string             `json:"document_no,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Currency    string             `json:"currency"`
+	Debit       float64            `json:"debit"`
+	Credit      float64            `json:"credit"`
+	Balance     float64            `json:"balance"`
+}
+
+// StatementBalance döviz bazında açılış, hareket ve kapanış toplamları
+type StatementBalance struct {
+	Currency    string  `json:"currency"`
+	Opening     float64 `json:"opening"`
+	TotalDebit  float64 `json:"total_debit"`
+	TotalCredit float64 `json:"total_credit"`
+	Closing     float64 `json:"closing"`
+}
+
+// Statement müşteri/tedarikçi hesap ekstresi
+type Statement struct {
+	ContactID string             `json:"contact_id"`
+	From      time.Time          `json:"from"`
+	To        time.Time          `json:"to"`
+	Entries   []StatementEntry   `json:"entries"`
+	Balances  []StatementBalance `json:"balances"`
+}
+
+// Balance verilen döviz cinsindeki bakiye özetini döndürür
+func (s *Statement) Balance(currency string) *StatementBalance {
+	for i := range s.Balances {
+		if s.Balances[i].Currency == currency {
+			return &s.Balances[i]
+		}
+	}
+	return nil
+}
+
+// WriteCSV ekstreyi devir satırları dahil CSV olarak yazar
+func (s *Statement) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"Tarih", "Tür", "Belge No", "Açıklama", "Döviz", "Borç", "Alacak", "Bakiye"}); err != nil {
+		return err
+	}
+
+	for _, entry := range s.Entries {
+		record := []string{
+			entry.Date,
+			string(entry.Kind),
+			entry.DocumentNo,
+			entry.Description,
+			entry.Currency,
+			formatAmount(entry.Debit),
+			formatAmount(entry.Credit),
+			formatAmount(entry.Balance),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// Statement müşteri/tedarikçinin from-to aralığındaki ekstresini oluşturur.
+// Satış faturaları borç, alış faturaları alacak olarak ödenecek tutarlarıyla
+// (NetTotal) eklenir. Fatura ödemeleri ayrıca çekilmez: Paraşüt her tahsilat ve
+// ödemeyi müşterinin borç/alacak işlemlerine de yazdığından bunlar manuel
+// hareketlerle birlikte kendi yönleriyle eklenir; fatura ödemelerini ayrıca
+// eklemek aynı tutarı iki kez düşerdi. Tüm sayfalar dolaşılır, from öncesindeki
+// hareketler açılış bakiyesine yansıtılır.
+func (s *ContactsService) Statement(ctx context.Context, contactID string, from, to time.Time) (*Statement, error) {
+	from = TruncateDate(from)
+	to = TruncateDate(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("ekstre bitiş tarihi başlangıç tarihinden önce olamaz")
+	}
+
+	var movements []StatementEntry
+
+	invoices, err := ListAll(ctx, s.client.SalesInvoices.List, &ListParams{
+		Sort:   "issue_date",
+		Filter: map[string]string{"contact_id": contactID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, invoice := range invoices {
+		entry := StatementEntry{
+			Date:        invoice.Attributes.IssueDate,
+			Kind:        StatementEntrySalesInvoice,
+			ID:          invoice.ID,
+			DocumentNo:  documentNo(invoice.Attributes.InvoiceSeries, invoiceNumber(invoice.Attributes.InvoiceID)),
+			Description: invoice.Attributes.Description,
+			Currency:    CurrencyOrDefault(invoice.Attributes.Currency),
+		}
+		switch invoice.Attributes.ItemType {
+		case "invoice":
+			entry.Debit = invoice.Attributes.NetTotal
+		case "refund":
+			entry.Credit = invoice.Attributes.NetTotal
+		default:
+			continue
+		}
+		movements = append(movements, entry)
+	}
+
+	bills, err := ListAll(ctx, s.client.PurchaseBills.List, &ListParams{
+		Sort:   "issue_date",
+		Filter: map[string]string{"supplier_id": contactID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, bill := range bills {
+		entry := StatementEntry{
+			Date:        bill.Attributes.IssueDate,
+			Kind:        StatementEntryPurchaseBill,
+			ID:          bill.ID,
+			DocumentNo:  documentNo(bill.Attributes.InvoiceSeries, bill.Attributes.InvoiceID),
+			Description: bill.Attributes.Description,
+			Currency:    CurrencyOrDefault(bill.Attributes.Currency),
+		}
+		switch bill.Attributes.ItemType {
+		case "bill":
+			entry.Credit = bill.Attributes.NetTotal
+		case "refund":
+			entry.Debit = bill.Attributes.NetTotal
+		default:
+			continue
+		}
+		movements = append(movements, entry)
+	}
+
+	debits, err := ListAll(ctx, func(ctx context.Context, params *ListParams) ([]ContactTransaction, *Meta, error) {
+		return s.GetDebitTransactions(ctx, contactID, params)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, transaction := range debits {
+		movements = append(movements, StatementEntry{
+			Date:        transaction.Attributes.Date,
+			Kind:        StatementEntryDebit,
+			ID:          transaction.ID,
+			Description: transaction.Attributes.Description,
+			Currency:    CurrencyOrDefault(transaction.Attributes.Currency),
+			Debit:       transaction.Attributes.Amount,
+		})
+	}
+
+	credits, err := ListAll(ctx, func(ctx context.Context, params *ListParams) ([]ContactTransaction, *Meta, error) {
+		return s.GetCreditTransactions(ctx, contactID, params)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, transaction := range credits {
+		movements = append(movements, StatementEntry{
+			Date:        transaction.Attributes.Date,
+			Kind:        StatementEntryCredit,
+			ID:          transaction.ID,
+			Description: transaction.Attributes.Description,
+			Currency:    CurrencyOrDefault(transaction.Attributes.Currency),
+			Credit:      transaction.Attributes.Amount,
+		})
+	}
+
+	return buildStatement(contactID, from, to, movements)
+}
+
+// buildStatement hareketleri tarih sırasına dizer, açılış bakiyelerini ve
+// döviz bazında yürüyen bakiyeleri hesaplar
+func buildStatement(contactID string, from, to time.Time, movements []StatementEntry) (*Statement, error) {
+	type datedEntry struct {
+		date  time.Time
+		entry StatementEntry
+	}
+
+	dated := make([]datedEntry, 0, len(movements))
+	for _, entry := range movements {
+		date, err := ParseDate(entry.Date)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s tarihi çözülemedi: %w", entry.Kind, entry.ID, err)
+		}
+		if date.After(to) {
+			continue
+		}
+		dated = append(dated, datedEntry{date: date, entry: entry})
+	}
+
+	sort.SliceStable(dated, func(i, j int) bool {
+		return dated[i].date.Before(dated[j].date)
+	})
+
+	balances := map[string]*StatementBalance{}
+	var currencies []string
+	balanceFor := func(currency string) *StatementBalance {
+		balance, ok := balances[currency]
+		if !ok {
+			balance = &StatementBalance{Currency: currency}
+			balances[currency] = balance
+			currencies = append(currencies, currency)
+		}
+		return balance
+	}
+
+	var entries []StatementEntry
+	for _, item := range dated {
+		balance := balanceFor(item.entry.Currency)
+		if item.date.Before(from) {
+			balance.Opening = RoundAmount(balance.Opening + item.entry.Debit - item.entry.Credit)
+			continue
+		}
+		entries = append(entries, item.entry)
+	}
+
+	sort.Strings(currencies)
+
+	statement := &Statement{
+		ContactID: contactID,
+		From:      from,
+		To:        to,
+	}
+
+	running := map[string]float64{}
+	for _, currency := range currencies {
+		balance := balances[currency]
+		running[currency] = balance.Opening
+		statement.Entries = append(statement.Entries, StatementEntry{
+			Date:     from.Format(dateLayout),
+			Kind:     StatementEntryOpening,
+			Currency: currency,
+			Balance:  balance.Opening,
+		})
+	}
+
+	for _, entry := range entries {
+		balance := balances[entry.Currency]
+		balance.TotalDebit = RoundAmount(balance.TotalDebit + entry.Debit)
+		balance.TotalCredit = RoundAmount(balance.TotalCredit + entry.Credit)
+		running[entry.Currency] = RoundAmount(running[entry.Currency] + entry.Debit - entry.Credit)
+		entry.Balance = running[entry.Currency]
+		statement.Entries = append(statement.Entries, entry)
+	}
+
+	for _, currency := range currencies {
+		balance := balances[currency]
+		balance.Closing = running[currency]
+		statement.Balances = append(statement.Balances, *balance)
+	}
+
+	return statement, nil
+}
+
+// dateLayout API'nin kullandığı tarih biçimi
+const dateLayout = "2006-01-02"
+
+// ParseDate API tarih alanını (2006-01-02 veya RFC3339) gün başlangıcı
+// olarak çözer. Alt paketler de tarih alanlarını bununla okur.
+func ParseDate(value string) (time.Time, error) {
+	if date, err := time.Parse(dateLayout, value); err == nil {
+		return date, nil
+	}
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return TruncateDate(date), nil
+}
+
+// TruncateDate zaman bilgisini atarak günün başlangıcını döndürür
+func TruncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// CurrencyOrDefault boş döviz alanı için TRL döndürür
+func CurrencyOrDefault(currency string) string {
+	if currency == "" {
+		return "TRL"
+	}
+	return currency
+}
+
+// RoundAmount tutarı kuruş hassasiyetine yuvarlar
+func RoundAmount(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// formatAmount tutarı iki ondalık basamakla yazar
+func formatAmount(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+// invoiceNumber fatura numarasını metne çevirir
+func invoiceNumber(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// documentNo seri ve sıra numarasından belge numarası oluşturur
+func documentNo(series, number string) string {
+	return series + number
+}
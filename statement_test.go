@@ -0,0 +1,119 @@
+package parasut
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContactsService_Statement(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/sales_invoices"):
+			if r.URL.Query().Get("filter[contact_id]") != "42" {
+				t.Errorf("filter[contact_id] = %s, beklenen 42", r.URL.Query().Get("filter[contact_id]"))
+			}
+			if r.URL.Query().Get("page[number]") == "1" {
+				w.Write([]byte(`{"data": [
+					{"id": "1", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2023-12-20", "gross_total": 420, "net_total": 500, "currency": "TRL", "invoice_series": "A", "invoice_id": 1}},
+					{"id": "2", "type": "sales_invoices", "attributes": {"item_type": "estimate", "issue_date": "2024-01-05", "net_total": 999, "currency": "TRL"}}
+				], "meta": {"current_page": 1, "total_pages": 2, "total_count": 3}}`))
+				return
+			}
+			w.Write([]byte(`{"data": [
+				{"id": "3", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-01-10", "gross_total": 850, "net_total": 1000, "currency": "TRL", "invoice_series": "A", "invoice_id": 2}},
+				{"id": "4", "type": "sales_invoices", "attributes": {"item_type": "invoice", "issue_date": "2024-01-12", "net_total": 200, "currency": "USD"}}
+			], "meta": {"current_page": 2, "total_pages": 2, "total_count": 3}}`))
+		case strings.HasSuffix(r.URL.Path, "/purchase_bills"):
+			w.Write([]byte(`{"data": [], "meta": {"current_page": 1, "total_pages": 1, "total_count": 0}}`))
+		case strings.HasSuffix(r.URL.Path, "/contact_debit_transactions"):
+			w.Write([]byte(`{"data": [], "meta": {"current_page": 1, "total_pages": 1, "total_count": 0}}`))
+		case strings.HasSuffix(r.URL.Path, "/contact_credit_transactions"):
+			w.Write([]byte(`{"data": [
+				{"id": "20", "type": "transactions", "attributes": {"date": "2024-01-15", "amount": 300, "description": "Tahsilat"}},
+				{"id": "21", "type": "transactions", "attributes": {"date": "2024-02-15", "amount": 100, "description": "Dönem dışı"}}
+			], "meta": {"current_page": 1, "total_pages": 1, "total_count": 2}}`))
+		default:
+			t.Errorf("Beklenmeyen path: %s", r.URL.Path)
+		}
+	})
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	ctx := context.Background()
+	statement, err := client.Contacts.Statement(ctx, "42", from, to)
+	if err != nil {
+		t.Fatalf("Contacts.Statement hata döndü: %v", err)
+	}
+
+	trl := statement.Balance("TRL")
+	if trl == nil {
+		t.Fatal("TRL bakiyesi bulunamadı")
+	}
+
+	if trl.Opening != 500 {
+		t.Errorf("TRL açılış = %f, beklenen 500", trl.Opening)
+	}
+
+	if trl.TotalDebit != 1000 || trl.TotalCredit != 300 {
+		t.Errorf("TRL borç/alacak = %f/%f, beklenen 1000/300", trl.TotalDebit, trl.TotalCredit)
+	}
+
+	if trl.Closing != 1200 {
+		t.Errorf("TRL kapanış = %f, beklenen 1200", trl.Closing)
+	}
+
+	usd := statement.Balance("USD")
+	if usd == nil || usd.Closing != 200 {
+		t.Errorf("USD kapanış = %+v, beklenen 200", usd)
+	}
+
+	// 2 devir satırı + 3 dönem içi hareket
+	if len(statement.Entries) != 5 {
+		t.Fatalf("Satır sayısı = %d, beklenen 5", len(statement.Entries))
+	}
+
+	last := statement.Entries[len(statement.Entries)-1]
+	if last.Kind != StatementEntryCredit || last.Balance != 1200 {
+		t.Errorf("Son satır = %+v, beklenen 1200 bakiyeli tahsilat", last)
+	}
+
+	var buf bytes.Buffer
+	if err := statement.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV hata döndü: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV okunamadı: %v", err)
+	}
+
+	if len(records) != 6 {
+		t.Errorf("CSV satır sayısı = %d, beklenen 6", len(records))
+	}
+
+	if records[3][2] != "A2" || records[3][7] != "1500.00" {
+		t.Errorf("CSV fatura satırı = %v", records[3])
+	}
+}
+
+func TestContactsService_StatementInvalidRange(t *testing.T) {
+	client := createTestClient(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("İstek yapılmamalı: %s", r.URL.Path)
+	})
+
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := client.Contacts.Statement(context.Background(), "42", from, to)
+	if err == nil {
+		t.Error("Geçersiz tarih aralığı için hata bekleniyordu")
+	}
+}
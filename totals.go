@@ -0,0 +1,157 @@
+package parasut
+
+import "fmt"
+
+// InvoiceTotals faturanın Paraşüt kurallarıyla hesaplanan toplamları.
+// Alan adları SalesInvoiceAttributes ile aynıdır.
+type InvoiceTotals struct {
+	// GrossTotal iskontolar düşülmeden önceki miktar × birim fiyat toplamı
+	GrossTotal float64 `json:"gross_total"`
+	// TotalDiscount satır iskontoları toplamı
+	TotalDiscount float64 `json:"total_discount"`
+	// TotalInvoiceDiscount fatura altı iskonto tutarı
+	TotalInvoiceDiscount float64 `json:"total_invoice_discount"`
+	// BeforeTaxesTotal tüm iskontolar düşülmüş vergisiz tutar
+	BeforeTaxesTotal       float64 `json:"before_taxes_total"`
+	TotalExciseDuty        float64 `json:"total_excise_duty"`
+	TotalCommunicationsTax float64 `json:"total_communications_tax"`
+	TotalVat               float64 `json:"total_vat"`
+	// Withholding vergisiz tutar üzerinden stopaj
+	Withholding float64 `json:"withholding"`
+	// VatWithholding KDV üzerinden tevkifat
+	VatWithholding float64 `json:"vat_withholding"`
+	// NetTotal vergiler eklenip stopaj ve tevkifat düşülmüş ödenecek tutar
+	NetTotal float64 `json:"net_total"`
+	// Lines kalem bazında tutarlar; details ile aynı sıradadır
+	Lines []LineTotals `json:"lines"`
+}
+
+// LineTotals fatura kaleminin hesaplanan tutarları
+type LineTotals struct {
+	GrossTotal        float64 `json:"gross_total"`
+	Discount          float64 `json:"discount"`
+	InvoiceDiscount   float64 `json:"invoice_discount"`
+	NetTotal          float64 `json:"net_total"`
+	ExciseDuty        float64 `json:"excise_duty"`
+	CommunicationsTax float64 `json:"communications_tax"`
+	Vat               float64 `json:"vat"`
+}
+
+// CalculateInvoiceTotals fatura toplamlarını fatura oluşturulmadan hesaplar.
+//
+// Her kalemde miktar × birim fiyattan satır iskontosu (yüzde veya tutar)
+// düşülür. Fatura altı iskonto (yüzde veya tutar) kalemlere iskontolu
+// tutarları oranında dağıtılır; yuvarlama farkı son kaleme yazılır. ÖTV
+// (yüzde veya tutar) ve ÖİV iskontolu tutar üzerinden, KDV ise iskontolu tutara
+// ÖTV ve ÖİV eklenerek hesaplanır. Stopaj (WithholdingRate) vergisiz toplamın,
+// tevkifat (VatWithholdingRate) toplam KDV'nin yüzdesidir. Kalem tutarları iki
+// ondalığa yuvarlanıp toplanır.
+func CalculateInvoiceTotals(attributes SalesInvoiceInput, details []SalesInvoiceDetailInput) InvoiceTotals {
+	totals := InvoiceTotals{Lines: make([]LineTotals, len(details))}
+
+	var subtotal float64
+	for i, detail := range details {
+		line := &totals.Lines[i]
+		line.GrossTotal = RoundAmount(detail.Quantity * detail.UnitPrice)
+		line.Discount = RoundAmount(line.GrossTotal - lineSubtotal(detail.Quantity, detail.UnitPrice, detail.DiscountType, detail.DiscountValue))
+		subtotal += line.GrossTotal - line.Discount
+	}
+	subtotal = RoundAmount(subtotal)
+
+	invoiceDiscount := 0.0
+	switch attributes.InvoiceDiscountType {
+	case "percentage":
+		invoiceDiscount = RoundAmount(subtotal * attributes.InvoiceDiscount / 100)
+	case "amount":
+		invoiceDiscount = attributes.InvoiceDiscount
+	}
+	distributed := 0.0
+	for i, detail := range details {
+		line := &totals.Lines[i]
+		net := line.GrossTotal - line.Discount
+		if subtotal != 0 && invoiceDiscount != 0 {
+			if i == len(details)-1 {
+				line.InvoiceDiscount = RoundAmount(invoiceDiscount - distributed)
+			} else {
+				line.InvoiceDiscount = RoundAmount(invoiceDiscount * net / subtotal)
+			}
+			distributed += line.InvoiceDiscount
+		}
+		line.NetTotal = RoundAmount(net - line.InvoiceDiscount)
+
+		switch detail.ExciseDutyType {
+		case "percentage":
+			line.ExciseDuty = RoundAmount(line.NetTotal * detail.ExciseDutyValue / 100)
+		case "amount":
+			line.ExciseDuty = RoundAmount(detail.ExciseDutyValue)
+		}
+		line.CommunicationsTax = RoundAmount(line.NetTotal * detail.CommunicationsTaxRate / 100)
+		line.Vat = RoundAmount((line.NetTotal + line.ExciseDuty + line.CommunicationsTax) * detail.VatRate / 100)
+
+		totals.GrossTotal += line.GrossTotal
+		totals.TotalDiscount += line.Discount
+		totals.TotalInvoiceDiscount += line.InvoiceDiscount
+		totals.BeforeTaxesTotal += line.NetTotal
+		totals.TotalExciseDuty += line.ExciseDuty
+		totals.TotalCommunicationsTax += line.CommunicationsTax
+		totals.TotalVat += line.Vat
+	}
+
+	totals.GrossTotal = RoundAmount(totals.GrossTotal)
+	totals.TotalDiscount = RoundAmount(totals.TotalDiscount)
+	totals.TotalInvoiceDiscount = RoundAmount(totals.TotalInvoiceDiscount)
+	totals.BeforeTaxesTotal = RoundAmount(totals.BeforeTaxesTotal)
+	totals.TotalExciseDuty = RoundAmount(totals.TotalExciseDuty)
+	totals.TotalCommunicationsTax = RoundAmount(totals.TotalCommunicationsTax)
+	totals.TotalVat = RoundAmount(totals.TotalVat)
+	totals.Withholding = RoundAmount(totals.BeforeTaxesTotal * attributes.WithholdingRate / 100)
+	totals.VatWithholding = RoundAmount(totals.TotalVat * attributes.VatWithholdingRate / 100)
+	totals.NetTotal = RoundAmount(totals.BeforeTaxesTotal + totals.TotalExciseDuty + totals.TotalCommunicationsTax +
+		totals.TotalVat - totals.Withholding - totals.VatWithholding)
+	return totals
+}
+
+// ProductDetail ürünün liste fiyatı, KDV, satış ÖTV ve ÖİV oranlarıyla fatura
+// kalemi oluşturur
+func ProductDetail(product *Product, quantity float64) SalesInvoiceDetailInput {
+	detail := SalesInvoiceDetailInput{
+		Quantity:              quantity,
+		UnitPrice:             product.Attributes.ListPrice,
+		VatRate:               product.Attributes.VatRate,
+		CommunicationsTaxRate: product.Attributes.CommunicationsTaxRate,
+		Product:               &RelationshipData{ID: product.ID, Type: "products"},
+	}
+	if product.Attributes.SalesExciseDutyRate != 0 {
+		detail.ExciseDutyType = "percentage"
+		detail.ExciseDutyValue = product.Attributes.SalesExciseDutyRate
+	}
+	return detail
+}
+
+// Compare hesaplanan toplamları Paraşüt'ün döndürdüğü fatura nitelikleriyle
+// karşılaştırır ve iki ondalığa yuvarlandığında farklı olan, yani en az bir
+// kuruş farkı olan alanları döndürür
+func (t InvoiceTotals) Compare(attributes SalesInvoiceAttributes) []string {
+	fields := []struct {
+		name       string
+		want, have float64
+	}{
+		{"gross_total", t.GrossTotal, attributes.GrossTotal},
+		{"total_discount", t.TotalDiscount, attributes.TotalDiscount},
+		{"total_invoice_discount", t.TotalInvoiceDiscount, attributes.TotalInvoiceDiscount},
+		{"before_taxes_total", t.BeforeTaxesTotal, attributes.BeforeTaxesTotal},
+		{"total_excise_duty", t.TotalExciseDuty, attributes.TotalExciseDuty},
+		{"total_communications_tax", t.TotalCommunicationsTax, attributes.TotalCommunicationsTax},
+		{"total_vat", t.TotalVat, attributes.TotalVat},
+		{"withholding", t.Withholding, attributes.Withholding},
+		{"vat_withholding", t.VatWithholding, attributes.VatWithholding},
+		{"net_total", t.NetTotal, attributes.NetTotal},
+	}
+	var mismatches []string
+	for _, f := range fields {
+		if RoundAmount(f.want) != RoundAmount(f.have) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: hesaplanan %s, faturada %s", f.name, formatAmount(f.want), formatAmount(f.have)))
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,74 @@
+package parasut
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCalculateInvoiceTotals(t *testing.T) {
+	attributes := SalesInvoiceInput{InvoiceDiscountType: "amount", InvoiceDiscount: 10, VatWithholdingRate: 50}
+	details := []SalesInvoiceDetailInput{
+		{Quantity: 3, UnitPrice: 100, VatRate: 20, DiscountType: "percentage", DiscountValue: 10},
+		{Quantity: 1, UnitPrice: 50.5, VatRate: 10, CommunicationsTaxRate: 7.5},
+	}
+
+	totals := CalculateInvoiceTotals(attributes, details)
+	want := InvoiceTotals{
+		GrossTotal: 350.5, TotalDiscount: 30, TotalInvoiceDiscount: 10, BeforeTaxesTotal: 310.5,
+		TotalCommunicationsTax: 3.67, TotalVat: 57.58, VatWithholding: 28.79, NetTotal: 342.96,
+	}
+	want.Lines = totals.Lines
+	if totals.GrossTotal != want.GrossTotal || totals.TotalDiscount != want.TotalDiscount ||
+		totals.TotalInvoiceDiscount != want.TotalInvoiceDiscount || totals.BeforeTaxesTotal != want.BeforeTaxesTotal ||
+		totals.TotalCommunicationsTax != want.TotalCommunicationsTax || totals.TotalVat != want.TotalVat ||
+		totals.VatWithholding != want.VatWithholding || totals.Withholding != 0 || totals.NetTotal != want.NetTotal {
+		t.Errorf("CalculateInvoiceTotals() = %+v, beklenen %+v", totals, want)
+	}
+	// Fatura iskontosu 270/320.5 oranında dağıtılır; kalan son kaleme yazılır
+	if totals.Lines[0].InvoiceDiscount != 8.42 || totals.Lines[1].InvoiceDiscount != 1.58 || totals.Lines[1].NetTotal != 48.92 {
+		t.Errorf("Kalemler = %+v", totals.Lines)
+	}
+
+	percentage := CalculateInvoiceTotals(SalesInvoiceInput{InvoiceDiscountType: "percentage", InvoiceDiscount: 10}, details)
+	if percentage.TotalInvoiceDiscount != 32.05 || percentage.BeforeTaxesTotal != 288.45 {
+		t.Errorf("Yüzde iskonto = %+v", percentage)
+	}
+	// Yüzde iskontoda da yuvarlama farkı son kaleme yazılır: 27.00 + 5.05
+	if percentage.Lines[0].InvoiceDiscount != 27 || percentage.Lines[1].InvoiceDiscount != 5.05 {
+		t.Errorf("Yüzde iskonto kalemleri = %+v", percentage.Lines)
+	}
+
+	if empty := CalculateInvoiceTotals(attributes, nil); empty.NetTotal != 0 || empty.TotalInvoiceDiscount != 0 {
+		t.Errorf("Kalemsiz fatura = %+v", empty)
+	}
+}
+
+func TestProductDetail(t *testing.T) {
+	product := &Product{ID: "11", Attributes: ProductAttributes{ListPrice: 200, VatRate: 20, SalesExciseDutyRate: 25}}
+	detail := ProductDetail(product, 2)
+	if detail.ExciseDutyType != "percentage" || detail.ExciseDutyValue != 25 || detail.Product.ID != "11" {
+		t.Errorf("ProductDetail() = %+v", detail)
+	}
+
+	// KDV matrahına ÖTV eklenir: (400 + 100) * %20
+	totals := CalculateInvoiceTotals(SalesInvoiceInput{WithholdingRate: 20}, []SalesInvoiceDetailInput{detail})
+	if totals.TotalExciseDuty != 100 || totals.TotalVat != 100 || totals.Withholding != 80 || totals.NetTotal != 520 {
+		t.Errorf("ÖTV'li toplamlar = %+v", totals)
+	}
+
+	if mismatches := totals.Compare(SalesInvoiceAttributes{
+		GrossTotal: 400, BeforeTaxesTotal: 400, TotalExciseDuty: 100, TotalVat: 100, Withholding: 80, NetTotal: 520.004,
+	}); len(mismatches) != 0 {
+		t.Errorf("Compare() = %v", mismatches)
+	}
+	// Bir kuruşluk fark raporlanır
+	if mismatches := totals.Compare(SalesInvoiceAttributes{
+		GrossTotal: 400, BeforeTaxesTotal: 400, TotalExciseDuty: 100, TotalVat: 100, Withholding: 80, NetTotal: 520.01,
+	}); len(mismatches) != 1 || !strings.HasPrefix(mismatches[0], "net_total") {
+		t.Errorf("Kuruş farkı = %v", mismatches)
+	}
+	mismatches := totals.Compare(SalesInvoiceAttributes{GrossTotal: 400, BeforeTaxesTotal: 400, TotalExciseDuty: 100, TotalVat: 96, Withholding: 80, NetTotal: 516})
+	if len(mismatches) != 2 || !strings.HasPrefix(mismatches[0], "total_vat: hesaplanan 100.00, faturada 96.00") {
+		t.Errorf("Compare() = %v", mismatches)
+	}
+}